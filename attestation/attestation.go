@@ -0,0 +1,342 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package attestation verifies the artifact attestations returned by
+// github.RepositoriesService.ListAttestations and the organization and user
+// variants, offline, with sigstore-go.
+//
+// An attestation is verified against Sigstore trusted roots, such as the one
+// of the public good instance used by GitHub Actions for public
+// repositories, which can be downloaded from
+// https://github.com/sigstore/root-signing/raw/refs/heads/main/targets/trusted_root.json,
+// or those printed by "gh attestation trusted-root", one per line, which
+// include GitHub's instance used for private repositories.
+//
+// Usage:
+//
+//	trustedRoots, err := attestation.LoadTrustedRoots("trusted-root-public-good.json")
+//	if err != nil {
+//		// Handle error.
+//	}
+//	v, err := attestation.NewVerifier(trustedRoots...)
+//	if err != nil {
+//		// Handle error.
+//	}
+//	digest := "sha256:" + hex.EncodeToString(sum[:])
+//	attestations, _, err := client.Repositories.ListAttestations(ctx, owner, repo, digest, nil)
+//	if err != nil {
+//		// Handle error.
+//	}
+//	for _, a := range attestations.Attestations {
+//		statement, err := v.Verify(a, digest, &attestation.Policy{
+//			SANRegex: "^https://github.com/" + owner + "/" + repo + "/",
+//		})
+//		...
+//	}
+package attestation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v75/github"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// GitHubActionsIssuer is the OIDC issuer of the certificates of the
+// attestations made by GitHub Actions.
+const GitHubActionsIssuer = "https://token.actions.githubusercontent.com"
+
+// LoadTrustedRoots loads the Sigstore trusted roots of a JSON file, which
+// holds either a single trusted root, such as
+// "trusted-root-public-good.json", or several, one per line, as printed by
+// "gh attestation trusted-root".
+func LoadTrustedRoots(path string) ([]root.TrustedMaterial, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("attestation: %w", err)
+	}
+	var roots []root.TrustedMaterial
+	dec := json.NewDecoder(bytes.NewReader(b))
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("attestation: %v: %w", path, err)
+		}
+		tr, err := root.NewTrustedRootFromJSON(raw)
+		if err != nil {
+			return nil, fmt.Errorf("attestation: %v: %w", path, err)
+		}
+		roots = append(roots, tr)
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("attestation: %v: no trusted root", path)
+	}
+	return roots, nil
+}
+
+// Policy specifies what a verified attestation must attest, in addition to
+// the digest of the artifact.
+type Policy struct {
+	// Issuer is the OIDC issuer that must have issued the identity token of
+	// the signing certificate. Defaults to GitHubActionsIssuer.
+	Issuer string
+
+	// SAN is the subject alternative name that the signing certificate must
+	// have. For GitHub Actions, it is the URL of the workflow that made the
+	// attestation, followed by its ref, such as
+	// "https://github.com/o/r/.github/workflows/release.yml@refs/tags/v1.0.0".
+	SAN string
+
+	// SANRegex is a regular expression that the subject alternative name
+	// of the signing certificate must match, such as
+	// "^https://github.com/o/r/". Either SAN or SANRegex is required.
+	SANRegex string
+
+	// SourceRepositoryURI, if set, is the repository that the workflow that
+	// made the attestation must have run in, such as "https://github.com/o/r".
+	SourceRepositoryURI string
+
+	// PredicateType, if set, is the predicate type that the in-toto
+	// statement must have, such as "https://slsa.dev/provenance/v1".
+	PredicateType string
+}
+
+// Verifier verifies attestations against trusted roots.
+type Verifier struct {
+	verifiers []*verify.SignedEntityVerifier
+}
+
+// NewVerifier returns a Verifier of the attestations signed with any of the
+// trusted material, such as the trusted roots returned by LoadTrustedRoots.
+// Attestations are verified against each trusted material in turn.
+//
+// If a trusted material has transparency logs, as with the public good
+// instance, attestations must be recorded in one of them, and their
+// certificates must have a signed certificate timestamp if it has
+// certificate transparency logs. Otherwise, as with GitHub's
+// instance, attestations must have a signed timestamp.
+func NewVerifier(trusted ...root.TrustedMaterial) (*Verifier, error) {
+	if len(trusted) == 0 {
+		return nil, errors.New("attestation: no trusted material")
+	}
+	v := new(Verifier)
+	for _, t := range trusted {
+		var opts []verify.VerifierOption
+		if len(t.RekorLogs()) > 0 {
+			opts = append(opts, verify.WithTransparencyLog(1), verify.WithObserverTimestamps(1))
+			if len(t.CTLogs()) > 0 {
+				opts = append(opts, verify.WithSignedCertificateTimestamps(1))
+			}
+		} else {
+			opts = append(opts, verify.WithSignedTimestamps(1))
+		}
+		sev, err := verify.NewSignedEntityVerifier(t, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("attestation: %w", err)
+		}
+		v.verifiers = append(v.verifiers, sev)
+	}
+	return v, nil
+}
+
+// Subject is a subject of an in-toto statement, which is an artifact.
+type Subject struct {
+	Name string
+
+	// Digest maps digest algorithms, such as "sha256", to the hex-encoded
+	// digest of the artifact.
+	Digest map[string]string
+}
+
+// Certificate describes the identity of the signer of an attestation, from
+// its signing certificate.
+type Certificate struct {
+	// Issuer is the OIDC issuer of the identity token of the signer, such
+	// as GitHubActionsIssuer.
+	Issuer string
+
+	// SubjectAlternativeName is the identity of the signer. For GitHub
+	// Actions, it is the URL of the workflow followed by its ref.
+	SubjectAlternativeName string
+
+	// SourceRepositoryURI is the repository the workflow ran in, such as
+	// "https://github.com/o/r".
+	SourceRepositoryURI string
+
+	// SourceRepositoryRef is the ref the workflow ran for, such as
+	// "refs/heads/main".
+	SourceRepositoryRef string
+
+	// SourceRepositoryDigest is the commit the workflow ran for.
+	SourceRepositoryDigest string
+
+	// BuildSignerURI is the URL of the workflow that signed the
+	// attestation, followed by its ref, such as a reusable workflow.
+	BuildSignerURI string
+
+	// RunInvocationURI is the URL of the workflow run that signed the
+	// attestation.
+	RunInvocationURI string
+
+	// BuildTrigger is the event that triggered the workflow run, such as
+	// "push".
+	BuildTrigger string
+}
+
+// VerifiedStatement is the in-toto statement of a verified attestation,
+// along with the identity of its signer.
+type VerifiedStatement struct {
+	// Type is the type of the statement, such as
+	// "https://in-toto.io/Statement/v1".
+	Type string
+
+	// PredicateType is the type of the predicate, such as
+	// "https://slsa.dev/provenance/v1".
+	PredicateType string
+
+	Subjects []*Subject
+
+	// Predicate is the JSON encoding of the predicate, whose format depends
+	// on PredicateType.
+	Predicate json.RawMessage
+
+	Certificate *Certificate
+
+	// Timestamps are the verified times at which the attestation was
+	// signed, from transparency logs or timestamping authorities.
+	Timestamps []time.Time
+
+	// RepositoryID is the ID of the repository the attestation belongs to,
+	// from github.Attestation.
+	RepositoryID int64
+}
+
+// Verify verifies an attestation of the artifact with the given digest, such
+// as "sha256:2ce2e4...", and returns its statement.
+//
+// It checks that the attestation is signed by a certificate issued by the
+// certificate authority of a trusted root to the identity required by
+// policy, that it was signed while the certificate was valid, and that the
+// artifact is a subject of its in-toto statement.
+func (v *Verifier) Verify(a *github.Attestation, digest string, policy *Policy) (*VerifiedStatement, error) {
+	if a == nil || len(a.Bundle) == 0 {
+		return nil, errors.New("attestation: missing bundle")
+	}
+	algorithm, hexDigest, ok := strings.Cut(digest, ":")
+	if !ok {
+		return nil, fmt.Errorf("attestation: digest %q is not of the form algorithm:hex", digest)
+	}
+	rawDigest, err := hex.DecodeString(hexDigest)
+	if err != nil {
+		return nil, fmt.Errorf("attestation: invalid digest %q: %w", digest, err)
+	}
+	if policy == nil {
+		policy = &Policy{}
+	}
+	issuer := policy.Issuer
+	if issuer == "" {
+		issuer = GitHubActionsIssuer
+	}
+	if policy.SAN == "" && policy.SANRegex == "" {
+		return nil, errors.New("attestation: policy requires SAN or SANRegex")
+	}
+
+	sanMatcher, err := verify.NewSANMatcher(policy.SAN, policy.SANRegex)
+	if err != nil {
+		return nil, fmt.Errorf("attestation: %w", err)
+	}
+	issuerMatcher, err := verify.NewIssuerMatcher(issuer, "")
+	if err != nil {
+		return nil, fmt.Errorf("attestation: %w", err)
+	}
+	identity, err := verify.NewCertificateIdentity(sanMatcher, issuerMatcher, certificate.Extensions{
+		SourceRepositoryURI: policy.SourceRepositoryURI,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("attestation: %w", err)
+	}
+
+	b := new(bundle.Bundle)
+	if err := b.UnmarshalJSON(a.Bundle); err != nil {
+		return nil, fmt.Errorf("attestation: invalid bundle: %w", err)
+	}
+	verifyPolicy := verify.NewPolicy(
+		verify.WithArtifactDigest(algorithm, rawDigest),
+		verify.WithCertificateIdentity(identity))
+	var result *verify.VerificationResult
+	var errs []error
+	for _, sev := range v.verifiers {
+		if result, err = sev.Verify(b, verifyPolicy); err == nil {
+			break
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == len(v.verifiers) {
+		return nil, fmt.Errorf("attestation: %w", errors.Join(errs...))
+	}
+
+	statement, err := newVerifiedStatement(result)
+	if err != nil {
+		return nil, err
+	}
+	if policy.PredicateType != "" && statement.PredicateType != policy.PredicateType {
+		return nil, fmt.Errorf("attestation: predicate type %q, want %q", statement.PredicateType, policy.PredicateType)
+	}
+	statement.RepositoryID = a.RepositoryID
+	return statement, nil
+}
+
+// newVerifiedStatement returns the statement of a verification result.
+func newVerifiedStatement(result *verify.VerificationResult) (*VerifiedStatement, error) {
+	st := result.Statement
+	if st == nil {
+		return nil, errors.New("attestation: bundle has no in-toto statement")
+	}
+	s := &VerifiedStatement{
+		Type:          st.GetType(),
+		PredicateType: st.GetPredicateType(),
+	}
+	for _, sub := range st.GetSubject() {
+		s.Subjects = append(s.Subjects, &Subject{Name: sub.GetName(), Digest: sub.GetDigest()})
+	}
+	if st.GetPredicate() != nil {
+		predicate, err := protojson.Marshal(st.GetPredicate())
+		if err != nil {
+			return nil, fmt.Errorf("attestation: %w", err)
+		}
+		s.Predicate = predicate
+	}
+	if result.Signature != nil && result.Signature.Certificate != nil {
+		c := result.Signature.Certificate
+		s.Certificate = &Certificate{
+			Issuer:                 c.Issuer,
+			SubjectAlternativeName: c.SubjectAlternativeName,
+			SourceRepositoryURI:    c.SourceRepositoryURI,
+			SourceRepositoryRef:    c.SourceRepositoryRef,
+			SourceRepositoryDigest: c.SourceRepositoryDigest,
+			BuildSignerURI:         c.BuildSignerURI,
+			RunInvocationURI:       c.RunInvocationURI,
+			BuildTrigger:           c.BuildTrigger,
+		}
+	}
+	for _, ts := range result.VerifiedTimestamps {
+		s.Timestamps = append(s.Timestamps, ts.Timestamp)
+	}
+	return s, nil
+}
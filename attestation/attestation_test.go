@@ -0,0 +1,193 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package attestation
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/go-github/v75/github"
+)
+
+// testDigest is the digest of the subject of testdata/bundle-provenance.json,
+// a provenance attestation of an npm package made by GitHub Actions and
+// recorded in the public good instance of Sigstore.
+const testDigest = "sha512:76176ffa33808b54602c7c35de5c6e9a4deb96066dba6533f50ac234f4f1f4c6b3527515dc17c06fbe2860030f410eee69ea20079bd3a2c6f3dcf3b329b10751"
+
+func setup(t *testing.T) (*Verifier, *github.Attestation) {
+	t.Helper()
+	trustedRoots, err := LoadTrustedRoots("testdata/trusted-root-public-good.json")
+	if err != nil {
+		t.Fatalf("LoadTrustedRoots returned error: %v", err)
+	}
+	v, err := NewVerifier(trustedRoots...)
+	if err != nil {
+		t.Fatalf("NewVerifier returned error: %v", err)
+	}
+	b, err := os.ReadFile("testdata/bundle-provenance.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v, &github.Attestation{Bundle: b, RepositoryID: 495574555}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+	v, a := setup(t)
+
+	got, err := v.Verify(a, testDigest, &Policy{
+		SANRegex:            "^https://github.com/sigstore/sigstore-js/",
+		SourceRepositoryURI: "https://github.com/sigstore/sigstore-js",
+		PredicateType:       "https://slsa.dev/provenance/v0.2",
+	})
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	want := &VerifiedStatement{
+		Type:          "https://in-toto.io/Statement/v0.1",
+		PredicateType: "https://slsa.dev/provenance/v0.2",
+		Subjects: []*Subject{{
+			Name:   "pkg:npm/sigstore@1.3.0",
+			Digest: map[string]string{"sha512": strings.TrimPrefix(testDigest, "sha512:")},
+		}},
+		Certificate: &Certificate{
+			Issuer:                 GitHubActionsIssuer,
+			SubjectAlternativeName: "https://github.com/sigstore/sigstore-js/.github/workflows/release.yml@refs/heads/main",
+			SourceRepositoryURI:    "https://github.com/sigstore/sigstore-js",
+			SourceRepositoryRef:    "refs/heads/main",
+			SourceRepositoryDigest: "dae8bd8eb433a4147b4655c00fe73e0f22bc0fb1",
+			BuildSignerURI:         "https://github.com/sigstore/sigstore-js/.github/workflows/release.yml@refs/heads/main",
+			RunInvocationURI:       "https://github.com/sigstore/sigstore-js/actions/runs/4735384265/attempts/1",
+			BuildTrigger:           "push",
+		},
+		Timestamps:   []time.Time{time.Date(2023, 4, 18, 17, 45, 12, 0, time.UTC)},
+		RepositoryID: 495574555,
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(VerifiedStatement{}, "Predicate"), cmpopts.EquateApproxTime(0)); diff != "" {
+		t.Errorf("Verify returned diff: %v", diff)
+	}
+
+	var predicate struct {
+		BuildType string `json:"buildType"`
+	}
+	if err := json.Unmarshal(got.Predicate, &predicate); err != nil {
+		t.Fatalf("Verify returned invalid predicate: %v", err)
+	}
+	if want := "https://github.com/npm/cli/gha/v2"; predicate.BuildType != want {
+		t.Errorf("Verify returned predicate build type %q, want %q", predicate.BuildType, want)
+	}
+}
+
+func TestVerifier_Verify_rejected(t *testing.T) {
+	t.Parallel()
+	v, a := setup(t)
+	sanRegex := "^https://github.com/sigstore/sigstore-js/"
+
+	tests := []struct {
+		name   string
+		a      *github.Attestation
+		digest string
+		policy *Policy
+	}{
+		{"wrong digest", a, "sha512:" + strings.Repeat("00", 64), &Policy{SANRegex: sanRegex}},
+		{"wrong algorithm", a, "sha256:" + strings.TrimPrefix(testDigest, "sha512:"), &Policy{SANRegex: sanRegex}},
+		{"malformed digest", a, strings.TrimPrefix(testDigest, "sha512:"), &Policy{SANRegex: sanRegex}},
+		{"non-hex digest", a, "sha512:xyz", &Policy{SANRegex: sanRegex}},
+		{"wrong SAN", a, testDigest, &Policy{SAN: "https://github.com/sigstore/sigstore-js/.github/workflows/release.yml@refs/heads/dev"}},
+		{"wrong SAN regex", a, testDigest, &Policy{SANRegex: "^https://github.com/o/r/"}},
+		{"invalid SAN regex", a, testDigest, &Policy{SANRegex: "("}},
+		{"wrong issuer", a, testDigest, &Policy{Issuer: "https://accounts.google.com", SANRegex: sanRegex}},
+		{"wrong source repository", a, testDigest, &Policy{SANRegex: sanRegex, SourceRepositoryURI: "https://github.com/o/r"}},
+		{"wrong predicate type", a, testDigest, &Policy{SANRegex: sanRegex, PredicateType: "https://slsa.dev/provenance/v1"}},
+		{"no identity", a, testDigest, nil},
+		{"no bundle", &github.Attestation{}, testDigest, &Policy{SANRegex: sanRegex}},
+		{"invalid bundle", &github.Attestation{Bundle: json.RawMessage(`{"mediaType":1}`)}, testDigest, &Policy{SANRegex: sanRegex}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if s, err := v.Verify(tt.a, tt.digest, tt.policy); err == nil {
+				t.Errorf("Verify returned %+v, want error", s)
+			}
+		})
+	}
+}
+
+func TestLoadTrustedRoots_lines(t *testing.T) {
+	t.Parallel()
+	b, err := os.ReadFile("testdata/trusted-root-public-good.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A trusted root without certificate authorities, which verifies no
+	// attestation, followed by the public good one, as printed by
+	// "gh attestation trusted-root".
+	var other map[string]any
+	if err := json.Unmarshal(b, &other); err != nil {
+		t.Fatal(err)
+	}
+	delete(other, "certificateAuthorities")
+	var lines bytes.Buffer
+	enc := json.NewEncoder(&lines)
+	for _, v := range []any{other, json.RawMessage(b)} {
+		if err := enc.Encode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := filepath.Join(t.TempDir(), "trusted-roots.jsonl")
+	if err := os.WriteFile(path, lines.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	trustedRoots, err := LoadTrustedRoots(path)
+	if err != nil {
+		t.Fatalf("LoadTrustedRoots returned error: %v", err)
+	}
+	if len(trustedRoots) != 2 {
+		t.Fatalf("LoadTrustedRoots returned %v trusted roots, want 2", len(trustedRoots))
+	}
+	_, a := setup(t)
+	policy := &Policy{SANRegex: "^https://github.com/sigstore/sigstore-js/"}
+	v, err := NewVerifier(trustedRoots[0])
+	if err != nil {
+		t.Fatalf("NewVerifier returned error: %v", err)
+	}
+	if _, err := v.Verify(a, testDigest, policy); err == nil {
+		t.Error("Verify with the first trusted root returned nil error")
+	}
+	v, err = NewVerifier(trustedRoots...)
+	if err != nil {
+		t.Fatalf("NewVerifier returned error: %v", err)
+	}
+	if _, err := v.Verify(a, testDigest, policy); err != nil {
+		t.Errorf("Verify returned error: %v", err)
+	}
+}
+
+func TestLoadTrustedRoots_invalid(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	for name, content := range map[string]string{"empty.json": "", "malformed.json": "{"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, path := range []string{"testdata/missing.json", filepath.Join(dir, "empty.json"), filepath.Join(dir, "malformed.json")} {
+		if _, err := LoadTrustedRoots(path); err == nil {
+			t.Errorf("LoadTrustedRoots(%q) returned nil error", path)
+		}
+	}
+	if _, err := NewVerifier(); err == nil {
+		t.Error("NewVerifier with no trusted material returned nil error")
+	}
+}
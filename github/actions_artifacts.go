@@ -168,6 +168,24 @@ func (s *ActionsService) DownloadArtifact(ctx context.Context, owner, repo strin
 	return s.downloadArtifactWithoutRateLimit(ctx, u, maxRedirects)
 }
 
+// DownloadArtifactArchive downloads the zip archive of an artifact. The
+// archive is fetched from the URL returned by DownloadArtifact, through the
+// transport of the client without its credentials, as for
+// RepositoriesService.DownloadArchive. It is the caller's responsibility to
+// close the Archive.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/artifacts#download-an-artifact
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}
+func (s *ActionsService) DownloadArtifactArchive(ctx context.Context, owner, repo string, artifactID int64, maxRedirects int) (*Archive, *Response, error) {
+	u, resp, err := s.DownloadArtifact(ctx, owner, repo, artifactID, maxRedirects)
+	if err != nil {
+		return nil, resp, err
+	}
+	archive, err := s.client.downloadArchive(ctx, u, Zipball)
+	return archive, resp, err
+}
+
 func (s *ActionsService) downloadArtifactWithoutRateLimit(ctx context.Context, u string, maxRedirects int) (*url.URL, *Response, error) {
 	resp, err := s.client.roundTripWithOptionalFollowRedirect(ctx, u, maxRedirects)
 	if err != nil {
@@ -524,6 +524,60 @@ func TestActionsService_DownloadArtifact_unexpectedCode(t *testing.T) {
 	}
 }
 
+func TestActionsService_DownloadArtifactArchive(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/artifact", http.StatusFound)
+	})
+	mux.HandleFunc("/artifact", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write(testZipball(t, []testArchiveEntry{{name: "report.txt", content: "ok"}}))
+	})
+
+	ctx := t.Context()
+	archive, resp, err := client.Actions.DownloadArtifactArchive(ctx, "o", "r", 1, 1)
+	if err != nil {
+		t.Fatalf("Actions.DownloadArtifactArchive returned error: %v", err)
+	}
+	defer archive.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Errorf("Actions.DownloadArtifactArchive returned status: %v, want %v", resp.StatusCode, http.StatusFound)
+	}
+	if got, want := readArchive(t, archive), []string{"report.txt -rw-r--r-- ok"}; !cmp.Equal(got, want) {
+		t.Errorf("Actions.DownloadArtifactArchive returned entries %v, want %v", got, want)
+	}
+
+	const methodName = "DownloadArtifactArchive"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.DownloadArtifactArchive(ctx, "\n", "\n", -1, 1)
+		return err
+	})
+}
+
+func TestActionsService_DownloadArtifactArchive_downloadError(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/artifacts/1/zip", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/artifact", http.StatusFound)
+	})
+	mux.HandleFunc("/artifact", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, resp, err := client.Actions.DownloadArtifactArchive(t.Context(), "o", "r", 1, 1)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusForbidden {
+		t.Errorf("Actions.DownloadArtifactArchive returned error %v, want 403 *ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusFound {
+		t.Errorf("Actions.DownloadArtifactArchive returned response %v, want the redirect", resp)
+	}
+}
+
 func TestActionsService_DeleteArtifact(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
@@ -0,0 +1,186 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"maps"
+	"strings"
+	"time"
+)
+
+const (
+	defaultDispatchFindInterval = 2 * time.Second
+	defaultDispatchFindTimeout  = time.Minute
+
+	// dispatchClockSkew is how far back runs are looked for, to allow for
+	// the local clock being ahead of GitHub's.
+	dispatchClockSkew = time.Minute
+)
+
+// ErrWorkflowRunNotFound is returned by ActionsService.DispatchWorkflowByID
+// and ActionsService.DispatchWorkflowByFileName when the run created by a
+// dispatch could not be found in time.
+var ErrWorkflowRunNotFound = errors.New("workflow run not found after dispatch")
+
+// DispatchWorkflowOptions specifies parameters to
+// ActionsService.DispatchWorkflowByID and
+// ActionsService.DispatchWorkflowByFileName.
+type DispatchWorkflowOptions struct {
+	// CorrelationInput is the name of a workflow input that is set to an ID
+	// generated for the dispatch. The workflow must declare the input and
+	// include it in its run name, so that the run can be told apart from
+	// others, e.g.:
+	//
+	//	on:
+	//	  workflow_dispatch:
+	//	    inputs:
+	//	      dispatch_id:
+	//	        required: false
+	//	run-name: Deploy ${{ inputs.dispatch_id }}
+	//
+	// If empty, the run is the oldest "workflow_dispatch" run of the workflow
+	// on the ref that did not exist before the dispatch, which may be the run
+	// of another dispatch made at the same time.
+	CorrelationInput string
+
+	// Find specifies how to poll for the run after the dispatch. Its Interval
+	// defaults to 2s and its Timeout to 1m.
+	Find WaitOptions
+
+	// Wait, if set, makes the helpers also wait for the run to complete, as
+	// ActionsService.WaitForWorkflowRun does.
+	Wait *WaitForWorkflowRunOptions
+}
+
+// DispatchWorkflowByID manually triggers a GitHub Actions workflow run, and
+// returns the run it created. If opts.Wait is set, it waits for the run to
+// complete and also returns whether it succeeded, as
+// ActionsService.WaitForWorkflowRun does; otherwise the outcome is empty.
+//
+// If the run is not found before opts.Find.Timeout elapses,
+// ErrWorkflowRunNotFound is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#get-a-workflow-run
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#list-workflow-runs-for-a-workflow
+// GitHub API docs: https://docs.github.com/rest/actions/workflows#create-a-workflow-dispatch-event
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}
+//meta:operation POST /repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches
+//meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs
+func (s *ActionsService) DispatchWorkflowByID(ctx context.Context, owner, repo string, workflowID int64, event CreateWorkflowDispatchEventRequest, opts *DispatchWorkflowOptions) (*WorkflowRun, WaitOutcome, error) {
+	return s.dispatchWorkflow(ctx, owner, repo, event, opts, func(event CreateWorkflowDispatchEventRequest) error {
+		_, err := s.CreateWorkflowDispatchEventByID(ctx, owner, repo, workflowID, event)
+		return err
+	}, func(ctx context.Context, opts *ListWorkflowRunsOptions) (*WorkflowRuns, *Response, error) {
+		return s.ListWorkflowRunsByID(ctx, owner, repo, workflowID, opts)
+	})
+}
+
+// DispatchWorkflowByFileName manually triggers a GitHub Actions workflow run,
+// and returns the run it created. If opts.Wait is set, it waits for the run
+// to complete and also returns whether it succeeded, as
+// ActionsService.WaitForWorkflowRun does; otherwise the outcome is empty.
+//
+// If the run is not found before opts.Find.Timeout elapses,
+// ErrWorkflowRunNotFound is returned.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#get-a-workflow-run
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#list-workflow-runs-for-a-workflow
+// GitHub API docs: https://docs.github.com/rest/actions/workflows#create-a-workflow-dispatch-event
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}
+//meta:operation POST /repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches
+//meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs
+func (s *ActionsService) DispatchWorkflowByFileName(ctx context.Context, owner, repo, workflowFileName string, event CreateWorkflowDispatchEventRequest, opts *DispatchWorkflowOptions) (*WorkflowRun, WaitOutcome, error) {
+	return s.dispatchWorkflow(ctx, owner, repo, event, opts, func(event CreateWorkflowDispatchEventRequest) error {
+		_, err := s.CreateWorkflowDispatchEventByFileName(ctx, owner, repo, workflowFileName, event)
+		return err
+	}, func(ctx context.Context, opts *ListWorkflowRunsOptions) (*WorkflowRuns, *Response, error) {
+		return s.ListWorkflowRunsByFileName(ctx, owner, repo, workflowFileName, opts)
+	})
+}
+
+// dispatchWorkflow dispatches event with dispatch, and finds the run it
+// created among those returned by listRuns.
+func (s *ActionsService) dispatchWorkflow(ctx context.Context, owner, repo string, event CreateWorkflowDispatchEventRequest, opts *DispatchWorkflowOptions, dispatch func(CreateWorkflowDispatchEventRequest) error, listRuns func(context.Context, *ListWorkflowRunsOptions) (*WorkflowRuns, *Response, error)) (*WorkflowRun, WaitOutcome, error) {
+	if opts == nil {
+		opts = &DispatchWorkflowOptions{}
+	}
+	branch := strings.TrimPrefix(strings.TrimPrefix(event.Ref, "refs/heads/"), "refs/tags/")
+	filter := &ListWorkflowRunsOptions{
+		Event:       "workflow_dispatch",
+		Branch:      branch,
+		Created:     ">=" + time.Now().Add(-dispatchClockSkew).UTC().Format(time.RFC3339),
+		ListOptions: ListOptions{PerPage: 100},
+	}
+
+	var isRun func(*WorkflowRun) bool
+	if opts.CorrelationInput != "" {
+		id := rand.Text()
+		event.Inputs = maps.Clone(event.Inputs)
+		if event.Inputs == nil {
+			event.Inputs = make(map[string]any)
+		}
+		event.Inputs[opts.CorrelationInput] = id
+		isRun = func(r *WorkflowRun) bool {
+			return strings.Contains(r.GetDisplayTitle(), id) || strings.Contains(r.GetName(), id)
+		}
+	} else {
+		// Without an ID to look for, the run is one that did not exist
+		// before the dispatch.
+		runs, _, err := listRuns(ctx, filter)
+		if err != nil {
+			return nil, "", err
+		}
+		existing := make(map[int64]bool)
+		for _, r := range runs.WorkflowRuns {
+			existing[r.GetID()] = true
+		}
+		isRun = func(r *WorkflowRun) bool { return !existing[r.GetID()] }
+	}
+
+	if err := dispatch(event); err != nil {
+		return nil, "", err
+	}
+
+	find := opts.Find
+	if find.Interval <= 0 {
+		find.Interval = defaultDispatchFindInterval
+	}
+	if find.Timeout <= 0 {
+		find.Timeout = defaultDispatchFindTimeout
+	}
+	var run *WorkflowRun
+	outcome, err := s.client.waitUntil(ctx, &find, func(ctx context.Context) (WaitOutcome, bool, *Response, error) {
+		runs, resp, err := listRuns(ctx, filter)
+		if err != nil {
+			return "", false, resp, err
+		}
+		// Runs are listed from the most recent, and the oldest matching
+		// run is the most likely to be the one created by the dispatch.
+		for _, r := range runs.WorkflowRuns {
+			if isRun(r) {
+				run = r
+			}
+		}
+		if run == nil {
+			return "", false, resp, nil
+		}
+		return WaitSucceeded, false, resp, nil
+	})
+	switch {
+	case err != nil:
+		return nil, "", err
+	case outcome == WaitTimedOut:
+		return nil, "", ErrWorkflowRunNotFound
+	case opts.Wait == nil:
+		return run, "", nil
+	}
+	return s.WaitForWorkflowRun(ctx, owner, repo, run.GetID(), opts.Wait)
+}
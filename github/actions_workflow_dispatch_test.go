@@ -0,0 +1,142 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestActionsService_DispatchWorkflowByFileName(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var id atomic.Value
+	mux.HandleFunc("/repos/o/r/actions/workflows/main.yml/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var event CreateWorkflowDispatchEventRequest
+		assertNilError(t, json.NewDecoder(r.Body).Decode(&event))
+		if event.Ref != "refs/heads/main" || event.Inputs["env"] != "prod" {
+			t.Errorf("Request body = %+v, want ref and inputs", event)
+		}
+		id.Store(event.Inputs["dispatch_id"])
+		w.WriteHeader(http.StatusNoContent)
+	})
+	var lists atomic.Int32
+	mux.HandleFunc("/repos/o/r/actions/workflows/main.yml/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.FormValue("event") != "workflow_dispatch" || r.FormValue("branch") != "main" || !strings.HasPrefix(r.FormValue("created"), ">=") {
+			t.Errorf("Request query = %v, want event, branch and created filters", r.URL.RawQuery)
+		}
+		runs := `{"id":1,"display_title":"Deploy other"}`
+		if lists.Add(1) > 1 {
+			runs = fmt.Sprintf(`{"id":3,"display_title":"Deploy %v"},{"id":2,"display_title":"Deploy other"},%v`, id.Load(), runs)
+		}
+		fmt.Fprintf(w, `{"workflow_runs":[%v]}`, runs)
+	})
+	var gets atomic.Int32
+	mux.HandleFunc("/repos/o/r/actions/runs/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if gets.Add(1) == 1 {
+			fmt.Fprint(w, `{"id":3,"status":"in_progress"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":3,"status":"completed","conclusion":"success"}`)
+	})
+
+	ctx := t.Context()
+	event := CreateWorkflowDispatchEventRequest{Ref: "refs/heads/main", Inputs: map[string]any{"env": "prod"}}
+	opts := &DispatchWorkflowOptions{CorrelationInput: "dispatch_id", Find: fastWait}
+	run, outcome, err := client.Actions.DispatchWorkflowByFileName(ctx, "o", "r", "main.yml", event, opts)
+	if err != nil {
+		t.Fatalf("Actions.DispatchWorkflowByFileName returned error: %v", err)
+	}
+	if run.GetID() != 3 || outcome != "" {
+		t.Errorf("Actions.DispatchWorkflowByFileName returned %v, %q, want run 3", run, outcome)
+	}
+	if len(event.Inputs) != 1 {
+		t.Errorf("Actions.DispatchWorkflowByFileName modified the inputs of event: %v", event.Inputs)
+	}
+	if gets.Load() != 0 {
+		t.Error("Actions.DispatchWorkflowByFileName waited for the run")
+	}
+
+	opts.Wait = &WaitForWorkflowRunOptions{WaitOptions: fastWait}
+	run, outcome, err = client.Actions.DispatchWorkflowByFileName(ctx, "o", "r", "main.yml", event, opts)
+	if err != nil {
+		t.Fatalf("Actions.DispatchWorkflowByFileName returned error: %v", err)
+	}
+	if run.GetConclusion() != "success" || outcome != WaitSucceeded {
+		t.Errorf("Actions.DispatchWorkflowByFileName returned %v, %q, want successful run", run, outcome)
+	}
+}
+
+func TestActionsService_DispatchWorkflowByID(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/workflows/72844/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"ref":"v1"}`+"\n")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	var lists atomic.Int32
+	mux.HandleFunc("/repos/o/r/actions/workflows/72844/runs", func(w http.ResponseWriter, _ *http.Request) {
+		// Without a correlation input, the oldest run that did not exist
+		// before the dispatch is returned.
+		switch lists.Add(1) {
+		case 1:
+			fmt.Fprint(w, `{"workflow_runs":[{"id":1}]}`)
+		case 2:
+			fmt.Fprint(w, `{"workflow_runs":[{"id":1}]}`)
+		default:
+			fmt.Fprint(w, `{"workflow_runs":[{"id":3},{"id":2},{"id":1}]}`)
+		}
+	})
+
+	event := CreateWorkflowDispatchEventRequest{Ref: "v1"}
+	run, _, err := client.Actions.DispatchWorkflowByID(t.Context(), "o", "r", 72844, event, &DispatchWorkflowOptions{Find: fastWait})
+	if err != nil {
+		t.Fatalf("Actions.DispatchWorkflowByID returned error: %v", err)
+	}
+	if run.GetID() != 2 {
+		t.Errorf("Actions.DispatchWorkflowByID returned run %v, want 2", run.GetID())
+	}
+}
+
+func TestActionsService_DispatchWorkflowByFileName_notFound(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/workflows/main.yml/dispatches", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/o/r/actions/workflows/main.yml/runs", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"workflow_runs":[{"id":1,"display_title":"other"}]}`)
+	})
+
+	ctx := t.Context()
+	event := CreateWorkflowDispatchEventRequest{Ref: "main"}
+	opts := &DispatchWorkflowOptions{CorrelationInput: "dispatch_id", Find: fastWait}
+	opts.Find.Timeout = 20 * time.Millisecond
+	_, _, err := client.Actions.DispatchWorkflowByFileName(ctx, "o", "r", "main.yml", event, opts)
+	if !errors.Is(err, ErrWorkflowRunNotFound) {
+		t.Errorf("Actions.DispatchWorkflowByFileName returned error %v, want %v", err, ErrWorkflowRunNotFound)
+	}
+
+	// Dispatch errors are returned.
+	_, _, err = client.Actions.DispatchWorkflowByFileName(ctx, "o", "r", "missing.yml", event, opts)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Actions.DispatchWorkflowByFileName returned error %v, want *ErrorResponse", err)
+	}
+}
@@ -0,0 +1,357 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WorkflowRunLogs are the logs of a workflow run, split by job and step, as
+// returned by ActionsService.DownloadWorkflowRunLogs.
+//
+// The logs are read from the downloaded archive when they are iterated over,
+// until Close is called.
+type WorkflowRunLogs struct {
+	// Jobs are the logs of each job, in the order of the archive.
+	Jobs []*WorkflowJobLog
+
+	archive *Archive
+}
+
+// WorkflowJobLog is the log of a job of a workflow run.
+type WorkflowJobLog struct {
+	// Name is the name of the job in the log archive.
+	Name string
+
+	// Job is the job the log belongs to, or nil if it could not be found.
+	Job *WorkflowJob
+
+	// Steps are the logs of each step, in the order of their numbers.
+	Steps []*WorkflowStepLog
+
+	entry *ArchiveEntry // The full log of the job, if any.
+}
+
+// WorkflowStepLog is the log of a step of a job.
+type WorkflowStepLog struct {
+	// Number is the number of the step in the job.
+	Number int64
+
+	// Name is the name of the step in the log archive, which may be
+	// shortened.
+	Name string
+
+	// Step is the step the log belongs to, or nil if it could not be found.
+	Step *TaskStep
+
+	entry *ArchiveEntry
+}
+
+// WorkflowLogLine is a line of a workflow log.
+type WorkflowLogLine struct {
+	// Time is the time the line was logged at, or the zero time if the line
+	// has no timestamp.
+	Time time.Time
+
+	// Command is the workflow command the line starts with, such as "group",
+	// "endgroup", "error", "warning", "notice", "debug" or "command" for a
+	// line written as "##[error]message". It is empty for other lines.
+	Command string
+
+	// Text is the text of the line, without the timestamp and the command.
+	Text string
+
+	// Group is the title of the group the line is in, as started by a
+	// "##[group]title" line, or empty if the line is not in a group.
+	Group string
+}
+
+// ParseWorkflowLogLine parses a line of a workflow log, such as
+// "2025-01-02T15:04:05.1234567Z ##[error]Process completed with exit code 1.".
+// The Group of the returned line is always empty.
+func ParseWorkflowLogLine(line string) *WorkflowLogLine {
+	l := &WorkflowLogLine{Text: strings.TrimRight(line, "\r\n")}
+	if ts, text, ok := strings.Cut(l.Text, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			l.Time, l.Text = t, text
+		}
+	}
+	if rest, ok := strings.CutPrefix(l.Text, "##["); ok {
+		if command, text, ok := strings.Cut(rest, "]"); ok {
+			l.Command, l.Text = command, text
+		}
+	}
+	return l
+}
+
+// Lines returns an iterator over the lines of the full log of the job. If
+// the archive has no full log for the job, the lines of its steps are
+// returned instead.
+func (j *WorkflowJobLog) Lines() iter.Seq2[*WorkflowLogLine, error] {
+	if j.entry != nil {
+		return logLines(j.entry)
+	}
+	return func(yield func(*WorkflowLogLine, error) bool) {
+		for _, s := range j.Steps {
+			for l, err := range s.Lines() {
+				if !yield(l, err) || err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Lines returns an iterator over the lines of the log of the step.
+func (s *WorkflowStepLog) Lines() iter.Seq2[*WorkflowLogLine, error] {
+	return logLines(s.entry)
+}
+
+// Errors returns the lines of the log of the step that are errors.
+func (s *WorkflowStepLog) Errors() ([]*WorkflowLogLine, error) {
+	var errs []*WorkflowLogLine
+	for l, err := range s.Lines() {
+		if err != nil {
+			return nil, err
+		}
+		if l.Command == "error" {
+			errs = append(errs, l)
+		}
+	}
+	return errs, nil
+}
+
+// logLines returns an iterator over the parsed lines of a log file.
+func logLines(e *ArchiveEntry) iter.Seq2[*WorkflowLogLine, error] {
+	return func(yield func(*WorkflowLogLine, error) bool) {
+		r, err := e.Open()
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer r.Close()
+
+		br := bufio.NewReader(r)
+		var group string
+		for first := true; ; first = false {
+			line, err := br.ReadString('\n')
+			if line != "" {
+				if first {
+					line = strings.TrimPrefix(line, "\ufeff")
+				}
+				l := ParseWorkflowLogLine(line)
+				switch l.Command {
+				case "group":
+					group = l.Text
+				case "endgroup":
+					group = ""
+				default:
+					l.Group = group
+				}
+				if !yield(l, nil) {
+					return
+				}
+			}
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+		}
+	}
+}
+
+// FailedSteps returns the logs of the steps whose conclusion is "failure".
+func (l *WorkflowRunLogs) FailedSteps() []*WorkflowStepLog {
+	var steps []*WorkflowStepLog
+	for _, j := range l.Jobs {
+		for _, s := range j.Steps {
+			if s.Step.GetConclusion() == "failure" {
+				steps = append(steps, s)
+			}
+		}
+	}
+	return steps
+}
+
+// Close releases the downloaded archive of the logs.
+func (l *WorkflowRunLogs) Close() error {
+	return l.archive.Close()
+}
+
+// DownloadWorkflowRunLogs downloads the logs of the latest attempt of a
+// workflow run, and maps them to the jobs of the run and their steps. It is
+// the caller's responsibility to close the WorkflowRunLogs.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-jobs#list-jobs-for-a-workflow-run
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#download-workflow-run-logs
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/jobs
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/logs
+func (s *ActionsService) DownloadWorkflowRunLogs(ctx context.Context, owner, repo string, runID int64, maxRedirects int) (*WorkflowRunLogs, *Response, error) {
+	return s.downloadWorkflowRunLogs(ctx, func() (*url.URL, *Response, error) {
+		return s.GetWorkflowRunLogs(ctx, owner, repo, runID, maxRedirects)
+	}, func(opts ListOptions) (*Jobs, *Response, error) {
+		return s.ListWorkflowJobs(ctx, owner, repo, runID, &ListWorkflowJobsOptions{ListOptions: opts})
+	})
+}
+
+// DownloadWorkflowRunAttemptLogs downloads the logs of an attempt of a
+// workflow run, and maps them to the jobs of the attempt and their steps. It
+// is the caller's responsibility to close the WorkflowRunLogs.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-jobs#list-jobs-for-a-workflow-run-attempt
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#download-workflow-run-attempt-logs
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/jobs
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/logs
+func (s *ActionsService) DownloadWorkflowRunAttemptLogs(ctx context.Context, owner, repo string, runID int64, attemptNumber, maxRedirects int) (*WorkflowRunLogs, *Response, error) {
+	return s.downloadWorkflowRunLogs(ctx, func() (*url.URL, *Response, error) {
+		return s.GetWorkflowRunAttemptLogs(ctx, owner, repo, runID, attemptNumber, maxRedirects)
+	}, func(opts ListOptions) (*Jobs, *Response, error) {
+		return s.ListWorkflowJobsAttempt(ctx, owner, repo, runID, int64(attemptNumber), &opts)
+	})
+}
+
+// downloadWorkflowRunLogs downloads the log archive at the URL returned by
+// getURL, and maps it to the jobs returned by listJobs.
+func (s *ActionsService) downloadWorkflowRunLogs(ctx context.Context, getURL func() (*url.URL, *Response, error), listJobs func(ListOptions) (*Jobs, *Response, error)) (*WorkflowRunLogs, *Response, error) {
+	var jobs []*WorkflowJob
+	opts := ListOptions{PerPage: 100}
+	for {
+		page, resp, err := listJobs(opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		jobs = append(jobs, page.Jobs...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	u, resp, err := getURL()
+	if err != nil {
+		return nil, resp, err
+	}
+	archive, err := s.client.downloadArchive(ctx, u, Zipball)
+	if err != nil {
+		return nil, resp, err
+	}
+	logs, err := parseWorkflowRunLogs(archive, jobs)
+	if err != nil {
+		_ = archive.Close()
+		return nil, resp, err
+	}
+	return logs, resp, nil
+}
+
+// parseWorkflowRunLogs maps the files of a log archive to jobs.
+//
+// The archive holds a directory for each job, named after the job, with a
+// file for each step named "<number>_<name>.txt". It may also hold the full
+// log of each job, in a file named "<index>_<job name>.txt".
+func parseWorkflowRunLogs(archive *Archive, jobs []*WorkflowJob) (*WorkflowRunLogs, error) {
+	logs := &WorkflowRunLogs{archive: archive}
+	byName := make(map[string]*WorkflowJobLog)
+	jobLog := func(name string) *WorkflowJobLog {
+		j, ok := byName[name]
+		if !ok {
+			j = &WorkflowJobLog{Name: name, Job: findLogJob(jobs, name)}
+			byName[name] = j
+			logs.Jobs = append(logs.Jobs, j)
+		}
+		return j
+	}
+
+	for e, err := range archive.Entries() {
+		if err != nil {
+			return nil, err
+		}
+		if !e.Mode.IsRegular() || path.Ext(e.Name) != ".txt" {
+			continue
+		}
+		dir, file := path.Split(e.Name)
+		number, name, ok := parseLogFileName(file)
+		switch {
+		case dir == "" && ok:
+			jobLog(name).entry = e
+		case dir != "" && ok:
+			j := jobLog(strings.TrimSuffix(dir, "/"))
+			step := &WorkflowStepLog{Number: number, Name: name, entry: e}
+			if j.Job != nil {
+				for _, s := range j.Job.Steps {
+					if s.GetNumber() == number {
+						step.Step = s
+					}
+				}
+			}
+			j.Steps = append(j.Steps, step)
+		}
+	}
+
+	if len(logs.Jobs) == 0 {
+		return nil, errors.New("no workflow job logs found in archive")
+	}
+	for _, j := range logs.Jobs {
+		slices.SortFunc(j.Steps, func(a, b *WorkflowStepLog) int {
+			return cmp.Compare(a.Number, b.Number)
+		})
+	}
+	return logs, nil
+}
+
+// parseLogFileName parses the name of a file of a log archive, such as
+// "2_Run tests.txt".
+func parseLogFileName(file string) (int64, string, bool) {
+	prefix, name, ok := strings.Cut(strings.TrimSuffix(file, ".txt"), "_")
+	if !ok {
+		return 0, "", false
+	}
+	number, err := strconv.ParseInt(prefix, 10, 64)
+	return number, name, err == nil
+}
+
+// findLogJob returns the job whose name in log archives is name, or nil.
+// Characters that are not allowed in file names are removed from the names
+// of jobs in log archives.
+func findLogJob(jobs []*WorkflowJob, name string) *WorkflowJob {
+	for _, j := range jobs {
+		if j.GetName() == name {
+			return j
+		}
+	}
+	for _, j := range jobs {
+		if logFileName(j.GetName()) == logFileName(name) {
+			return j
+		}
+	}
+	return nil
+}
+
+// logFileName returns name without the characters that are not allowed in
+// file names.
+func logFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) {
+			return -1
+		}
+		return r
+	}, name)
+}
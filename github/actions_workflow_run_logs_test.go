@@ -0,0 +1,203 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testRunLog = "\ufeff2025-01-02T15:04:05.1234567Z ##[group]Run go test ./...\n" +
+	"2025-01-02T15:04:05.2Z go test ./...\n" +
+	"2025-01-02T15:04:05.3Z ##[endgroup]\n" +
+	"2025-01-02T15:04:06Z --- FAIL: TestX\r\n" +
+	"2025-01-02T15:04:07Z ##[error]Process completed with exit code 1."
+
+// setupWorkflowRunLogs registers handlers serving the jobs and logs of a
+// workflow run at path.
+func setupWorkflowRunLogs(t *testing.T, mux *http.ServeMux, serverURL, path string) {
+	t.Helper()
+	mux.HandleFunc(path+"/jobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.FormValue("page") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%v%v%v/jobs?page=2>; rel="next"`, serverURL, baseURLPath, path))
+			fmt.Fprint(w, `{"jobs":[{"id":1,"name":"build","steps":[
+				{"number":1,"name":"Set up job","conclusion":"success"},
+				{"number":2,"name":"Run go test ./...","conclusion":"failure"}
+			]}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"jobs":[{"id":2,"name":"lint / vet","steps":[
+			{"number":1,"name":"Set up job","conclusion":"success"}
+		]}]}`)
+	})
+	mux.HandleFunc(path+"/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/logs.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/logs.zip", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(testZipball(t, []testArchiveEntry{
+			{name: "0_build.txt", content: "2025-01-02T15:04:00Z full log\n"},
+			{name: "build/"},
+			{name: "build/2_Run go test.txt", content: testRunLog},
+			{name: "build/1_Set up job.txt", content: "2025-01-02T15:04:00Z Runner\n"},
+			{name: "build/system.txt", content: "ignored"},
+			{name: "lint  vet/"},
+			{name: "lint  vet/1_Set up job.txt", content: "Runner\n"},
+		}))
+	})
+}
+
+func TestActionsService_DownloadWorkflowRunLogs(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+	setupWorkflowRunLogs(t, mux, serverURL, "/repos/o/r/actions/runs/1")
+
+	ctx := t.Context()
+	logs, _, err := client.Actions.DownloadWorkflowRunLogs(ctx, "o", "r", 1, 1)
+	if err != nil {
+		t.Fatalf("Actions.DownloadWorkflowRunLogs returned error: %v", err)
+	}
+	defer logs.Close()
+
+	type step struct {
+		Number int64
+		Name   string
+		StepOK bool
+	}
+	type job struct {
+		Name  string
+		JobID int64
+		Steps []step
+	}
+	var got []job
+	for _, j := range logs.Jobs {
+		gj := job{Name: j.Name, JobID: j.Job.GetID()}
+		for _, s := range j.Steps {
+			gj.Steps = append(gj.Steps, step{s.Number, s.Name, s.Step != nil && s.Step.GetNumber() == s.Number})
+		}
+		got = append(got, gj)
+	}
+	want := []job{
+		{"build", 1, []step{{1, "Set up job", true}, {2, "Run go test", true}}},
+		{"lint  vet", 2, []step{{1, "Set up job", true}}},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Actions.DownloadWorkflowRunLogs returned jobs %+v, want %+v", got, want)
+	}
+
+	failed := logs.FailedSteps()
+	if len(failed) != 1 || failed[0].Name != "Run go test" {
+		t.Fatalf("FailedSteps = %v, want the Run go test step", failed)
+	}
+	var lines []WorkflowLogLine
+	for l, err := range failed[0].Lines() {
+		if err != nil {
+			t.Fatalf("Lines returned error: %v", err)
+		}
+		lines = append(lines, *l)
+	}
+	at := func(s string) time.Time {
+		t, _ := time.Parse(time.RFC3339Nano, s)
+		return t
+	}
+	wantLines := []WorkflowLogLine{
+		{Time: at("2025-01-02T15:04:05.1234567Z"), Command: "group", Text: "Run go test ./..."},
+		{Time: at("2025-01-02T15:04:05.2Z"), Text: "go test ./...", Group: "Run go test ./..."},
+		{Time: at("2025-01-02T15:04:05.3Z"), Command: "endgroup"},
+		{Time: at("2025-01-02T15:04:06Z"), Text: "--- FAIL: TestX"},
+		{Time: at("2025-01-02T15:04:07Z"), Command: "error", Text: "Process completed with exit code 1."},
+	}
+	if !cmp.Equal(lines, wantLines) {
+		t.Errorf("Lines = %+v, want %+v", lines, wantLines)
+	}
+	errs, err := failed[0].Errors()
+	if err != nil {
+		t.Fatalf("Errors returned error: %v", err)
+	}
+	if len(errs) != 1 || errs[0].Text != "Process completed with exit code 1." {
+		t.Errorf("Errors = %v, want the exit code error", errs)
+	}
+
+	// The full log of a job is used if there is one, and the logs of its
+	// steps otherwise.
+	for i, want := range []int{1, 1} {
+		var n int
+		for _, err := range logs.Jobs[i].Lines() {
+			if err != nil {
+				t.Fatalf("Lines returned error: %v", err)
+			}
+			n++
+		}
+		if n != want {
+			t.Errorf("job %v has %v lines, want %v", logs.Jobs[i].Name, n, want)
+		}
+	}
+
+	const methodName = "DownloadWorkflowRunLogs"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.DownloadWorkflowRunLogs(ctx, "\n", "\n", -1, 1)
+		return err
+	})
+}
+
+func TestActionsService_DownloadWorkflowRunAttemptLogs(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+	setupWorkflowRunLogs(t, mux, serverURL, "/repos/o/r/actions/runs/1/attempts/2")
+
+	logs, _, err := client.Actions.DownloadWorkflowRunAttemptLogs(t.Context(), "o", "r", 1, 2, 1)
+	if err != nil {
+		t.Fatalf("Actions.DownloadWorkflowRunAttemptLogs returned error: %v", err)
+	}
+	defer logs.Close()
+	if len(logs.Jobs) != 2 || logs.Jobs[1].Job.GetID() != 2 {
+		t.Errorf("Actions.DownloadWorkflowRunAttemptLogs returned jobs %v, want 2 jobs", logs.Jobs)
+	}
+}
+
+func TestActionsService_DownloadWorkflowRunLogs_noLogs(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+	mux.HandleFunc("/repos/o/r/actions/runs/1/jobs", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"jobs":[]}`)
+	})
+	mux.HandleFunc("/repos/o/r/actions/runs/1/logs", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, serverURL+baseURLPath+"/logs.zip", http.StatusFound)
+	})
+	mux.HandleFunc("/logs.zip", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(testZipball(t, []testArchiveEntry{{name: "README", content: "x"}}))
+	})
+
+	if _, _, err := client.Actions.DownloadWorkflowRunLogs(t.Context(), "o", "r", 1, 1); err == nil {
+		t.Error("Actions.DownloadWorkflowRunLogs returned nil error")
+	}
+}
+
+func TestParseWorkflowLogLine(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		line string
+		want *WorkflowLogLine
+	}{
+		{"", &WorkflowLogLine{}},
+		{"plain text", &WorkflowLogLine{Text: "plain text"}},
+		{"2025-01-02T15:04:05Z text\n", &WorkflowLogLine{Time: ts, Text: "text"}},
+		{"2025-01-02T15:04:05Z ##[warning]careful", &WorkflowLogLine{Time: ts, Command: "warning", Text: "careful"}},
+		{"##[debug]x", &WorkflowLogLine{Command: "debug", Text: "x"}},
+		{"##[unterminated", &WorkflowLogLine{Text: "##[unterminated"}},
+	}
+	for _, tt := range tests {
+		if got := ParseWorkflowLogLine(tt.line); !cmp.Equal(got, tt.want) {
+			t.Errorf("ParseWorkflowLogLine(%q) = %+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
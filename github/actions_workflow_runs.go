@@ -390,6 +390,25 @@ func (s *ActionsService) GetWorkflowRunLogs(ctx context.Context, owner, repo str
 	return s.getWorkflowRunLogsWithoutRateLimit(ctx, u, maxRedirects)
 }
 
+// DownloadWorkflowRunLogsArchive downloads the zip archive of the logs of a
+// workflow run, which holds a file for each job and step. The archive is
+// fetched from the URL returned by GetWorkflowRunLogs, through the transport of
+// the client without its credentials, as for
+// RepositoriesService.DownloadArchive. It is the caller's responsibility to
+// close the Archive.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#download-workflow-run-logs
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/logs
+func (s *ActionsService) DownloadWorkflowRunLogsArchive(ctx context.Context, owner, repo string, runID int64, maxRedirects int) (*Archive, *Response, error) {
+	u, resp, err := s.GetWorkflowRunLogs(ctx, owner, repo, runID, maxRedirects)
+	if err != nil {
+		return nil, resp, err
+	}
+	archive, err := s.client.downloadArchive(ctx, u, Zipball)
+	return archive, resp, err
+}
+
 func (s *ActionsService) getWorkflowRunLogsWithoutRateLimit(ctx context.Context, u string, maxRedirects int) (*url.URL, *Response, error) {
 	resp, err := s.client.roundTripWithOptionalFollowRedirect(ctx, u, maxRedirects)
 	if err != nil {
@@ -643,6 +643,40 @@ func TestActionsService_GetWorkflowRunLogs_StatusMovedPermanently_followRedirect
 	}
 }
 
+func TestActionsService_DownloadWorkflowRunLogsArchive(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/runs/399444496/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/logs", http.StatusFound)
+	})
+	mux.HandleFunc("/logs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write(testZipball(t, []testArchiveEntry{
+			{name: "build/"},
+			{name: "build/1_Set up job.txt", content: "log"},
+		}))
+	})
+
+	ctx := t.Context()
+	archive, _, err := client.Actions.DownloadWorkflowRunLogsArchive(ctx, "o", "r", 399444496, 1)
+	if err != nil {
+		t.Fatalf("Actions.DownloadWorkflowRunLogsArchive returned error: %v", err)
+	}
+	defer archive.Close()
+	want := []string{"build drwxr-xr-x", "build/1_Set up job.txt -rw-r--r-- log"}
+	if got := readArchive(t, archive); !cmp.Equal(got, want) {
+		t.Errorf("Actions.DownloadWorkflowRunLogsArchive returned entries %v, want %v", got, want)
+	}
+
+	const methodName = "DownloadWorkflowRunLogsArchive"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Actions.DownloadWorkflowRunLogsArchive(ctx, "\n", "\n", -1, 1)
+		return err
+	})
+}
+
 func TestActionsService_GetWorkflowRunLogs_unexpectedCode(t *testing.T) {
 	t.Parallel()
 	tcs := []struct {
@@ -0,0 +1,318 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// appJWTLifetime is the lifetime of the JWTs created by AppTransport.
+	// GitHub rejects JWTs that expire more than 10 minutes in the future.
+	appJWTLifetime = 9 * time.Minute
+
+	// appJWTClockSkew is how far in the past the JWTs created by AppTransport
+	// are issued, to allow for clock drift between the client and GitHub.
+	appJWTClockSkew = time.Minute
+
+	// tokenRefreshMargin is how long before their expiration cached JWTs and
+	// installation tokens are refreshed.
+	tokenRefreshMargin = time.Minute
+)
+
+/*
+AppTransport is an http.RoundTripper that authenticates requests as a GitHub
+App, using a JSON Web Token (JWT) signed with RS256 by the app's private key.
+
+Authenticating as an app is only needed for the endpoints that manage the app
+and its installations, such as AppsService.ListInstallations. Use
+InstallationTransport to act on behalf of an installation.
+
+	t, err := github.NewAppTransport(appID, privateKeyPEM)
+	if err != nil { ... }
+	client := github.NewClient(t.Client())
+
+If the private key is held in a key management service, set Signer to a
+crypto.Signer backed by it instead:
+
+	t := &github.AppTransport{Issuer: clientID, Signer: kmsSigner}
+
+The JWT is cached and reused until shortly before it expires.
+
+GitHub API docs: https://docs.github.com/apps/creating-github-apps/authenticating-with-a-github-app/generating-a-json-web-token-jwt-for-a-github-app
+*/
+type AppTransport struct {
+	// Issuer is the "iss" claim of the JWT: the client ID of the app, or its
+	// app ID in decimal.
+	Issuer string
+
+	// Signer signs the JWT. It must hold an RSA private key.
+	Signer crypto.Signer
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu        sync.Mutex
+	jwt       string
+	expiresAt time.Time
+
+	now func() time.Time // For testing.
+}
+
+// NewAppTransport returns an AppTransport for the app with the given ID,
+// using the PEM-encoded RSA private key generated for it in the app settings.
+// Both PKCS #1 and PKCS #8 keys are supported.
+func NewAppTransport(appID int64, privateKeyPEM []byte) (*AppTransport, error) {
+	key, err := parseRSAPrivateKeyPEM(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &AppTransport{
+		Issuer: strconv.FormatInt(appID, 10),
+		Signer: key,
+	}, nil
+}
+
+// parseRSAPrivateKeyPEM parses a PEM-encoded PKCS #1 or PKCS #8 RSA private key.
+func parseRSAPrivateKeyPEM(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("private key is not PEM-encoded")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("private key is a %T, want an RSA key", key)
+		}
+		return rsaKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key PEM block type %q", block.Type)
+	}
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *AppTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	jwt, err := t.JWT()
+	if err != nil {
+		return nil, err
+	}
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", "Bearer "+jwt)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests which are authenticated
+// as the app.
+func (t *AppTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// JWT returns a JWT authenticating as the app. It returns the cached JWT if it
+// does not expire soon, and signs a new one otherwise.
+func (t *AppTransport) JWT() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.clock()
+	if t.jwt != "" && now.Add(tokenRefreshMargin).Before(t.expiresAt) {
+		return t.jwt, nil
+	}
+
+	if t.Issuer == "" {
+		return "", errors.New("t.Issuer is empty")
+	}
+	if t.Signer == nil {
+		return "", errors.New("t.Signer is nil")
+	}
+
+	expiresAt := now.Add(appJWTLifetime)
+	header := `{"alg":"RS256","typ":"JWT"}`
+	claims, err := json.Marshal(struct {
+		IssuedAt  int64  `json:"iat"`
+		ExpiresAt int64  `json:"exp"`
+		Issuer    string `json:"iss"`
+	}{
+		IssuedAt:  now.Add(-appJWTClockSkew).Unix(),
+		ExpiresAt: expiresAt.Unix(),
+		Issuer:    t.Issuer,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	sig, err := t.Signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", fmt.Errorf("signing JWT: %w", err)
+	}
+
+	t.jwt = unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+	t.expiresAt = expiresAt
+	return t.jwt, nil
+}
+
+func (t *AppTransport) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+func (t *AppTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+/*
+InstallationTransport is an http.RoundTripper that authenticates requests as
+an installation of a GitHub App, using installation access tokens.
+
+Installation tokens are created with AppsService.CreateInstallationToken,
+cached, and refreshed shortly before they expire or after a request is
+rejected as unauthorized.
+
+	app, err := github.NewAppTransport(appID, privateKeyPEM)
+	if err != nil { ... }
+	t := github.NewInstallationTransport(app, installationID, nil)
+	client := github.NewClient(t.Client())
+
+For GitHub Enterprise Server, point AppClient at the server too:
+
+	t.AppClient, err = t.AppClient.WithEnterpriseURLs(baseURL, uploadURL)
+
+GitHub API docs: https://docs.github.com/apps/creating-github-apps/authenticating-with-a-github-app/authenticating-as-a-github-app-installation
+*/
+type InstallationTransport struct {
+	// InstallationID is the ID of the installation to authenticate as.
+	InstallationID int64
+
+	// TokenOptions, if non-nil, restricts the repositories and permissions
+	// of the installation tokens.
+	TokenOptions *InstallationTokenOptions
+
+	// AppClient is the client used to create installation tokens. It must be
+	// authenticated as the app, for example with an AppTransport.
+	AppClient *Client
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+
+	now func() time.Time // For testing.
+}
+
+// NewInstallationTransport returns an InstallationTransport for the given
+// installation of the app authenticated by app. opts may be nil.
+func NewInstallationTransport(app *AppTransport, installationID int64, opts *InstallationTokenOptions) *InstallationTransport {
+	return &InstallationTransport{
+		InstallationID: installationID,
+		TokenOptions:   opts,
+		AppClient:      NewClient(app.Client()),
+		Transport:      app.Transport,
+	}
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *InstallationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", "Bearer "+token)
+	resp, err := t.transport().RoundTrip(req2)
+	if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+		t.invalidate(token)
+	}
+	return resp, err
+}
+
+// Client returns an *http.Client that makes requests which are authenticated
+// as the installation.
+func (t *InstallationTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// Token returns an installation access token. It returns the cached token if
+// it does not expire soon, and creates a new one otherwise.
+//
+// The token can also be used to authenticate Git operations over HTTPS, with
+// the username "x-access-token".
+func (t *InstallationTransport) Token(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && t.clock().Add(tokenRefreshMargin).Before(t.expiresAt) {
+		return t.token, nil
+	}
+
+	if t.AppClient == nil {
+		return "", errors.New("t.AppClient is nil")
+	}
+	tok, _, err := t.AppClient.Apps.CreateInstallationToken(ctx, t.InstallationID, t.TokenOptions)
+	if err != nil {
+		return "", fmt.Errorf("creating installation token: %w", err)
+	}
+	if tok.GetToken() == "" {
+		return "", errors.New("created installation token is empty")
+	}
+
+	t.token = tok.GetToken()
+	t.expiresAt = tok.GetExpiresAt().Time
+	return t.token, nil
+}
+
+// invalidate discards the cached token if it is token.
+func (t *InstallationTransport) invalidate(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token == token {
+		t.token = ""
+	}
+}
+
+func (t *InstallationTransport) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+func (t *InstallationTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
@@ -0,0 +1,246 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	testAppKeyOnce sync.Once
+	testAppKey     *rsa.PrivateKey
+)
+
+// appTestKey returns an RSA key shared by the tests of this file.
+func appTestKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	testAppKeyOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			panic(err)
+		}
+		testAppKey = key
+	})
+	return testAppKey
+}
+
+// verifyAppJWT verifies the signature of jwt with key and returns its claims.
+func verifyAppJWT(t *testing.T, jwt string, key *rsa.PublicKey) map[string]any {
+	t.Helper()
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT %q does not have 3 parts", jwt)
+	}
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	assertNilError(t, err)
+	if want := `{"alg":"RS256","typ":"JWT"}`; string(header) != want {
+		t.Errorf("JWT header = %v, want %v", string(header), want)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	assertNilError(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		t.Errorf("JWT signature is invalid: %v", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	assertNilError(t, err)
+	var claims map[string]any
+	assertNilError(t, json.Unmarshal(payload, &claims))
+	return claims
+}
+
+func TestNewAppTransport(t *testing.T) {
+	t.Parallel()
+	key := appTestKey(t)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	assertNilError(t, err)
+
+	keys := map[string][]byte{
+		"PKCS1": pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		"PKCS8": pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+	}
+	for name, data := range keys {
+		tr, err := NewAppTransport(42, data)
+		if err != nil {
+			t.Fatalf("NewAppTransport(%v) returned error: %v", name, err)
+		}
+		if want := "42"; tr.Issuer != want {
+			t.Errorf("NewAppTransport(%v).Issuer = %v, want %v", name, tr.Issuer, want)
+		}
+		if !key.Equal(tr.Signer) {
+			t.Errorf("NewAppTransport(%v).Signer is not the encoded key", name)
+		}
+	}
+
+	invalid := [][]byte{
+		[]byte("not PEM"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte{0}}),
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte{0}}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte{0}}),
+	}
+	for _, data := range invalid {
+		if _, err := NewAppTransport(42, data); err == nil {
+			t.Errorf("NewAppTransport(%q) returned no error", data)
+		}
+	}
+}
+
+func TestAppTransport(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	key := appTestKey(t)
+
+	now := time.Unix(1700000000, 0)
+	tr := &AppTransport{Issuer: "Iv1.abc", Signer: key, now: func() time.Time { return now }}
+
+	var auths []string
+	mux.HandleFunc("/app", func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	appClient := NewClient(tr.Client())
+	appClient.BaseURL = client.BaseURL
+	ctx := t.Context()
+	for range 2 {
+		if _, _, err := appClient.Apps.Get(ctx, ""); err != nil {
+			t.Fatalf("Apps.Get returned error: %v", err)
+		}
+	}
+	now = now.Add(appJWTLifetime)
+	if _, _, err := appClient.Apps.Get(ctx, ""); err != nil {
+		t.Fatalf("Apps.Get returned error: %v", err)
+	}
+
+	if len(auths) != 3 || auths[0] != auths[1] || auths[1] == auths[2] {
+		t.Fatalf("Authorization headers = %v, want a reused JWT and then a new one", auths)
+	}
+	jwt, ok := strings.CutPrefix(auths[0], "Bearer ")
+	if !ok {
+		t.Fatalf("Authorization header = %v, want Bearer", auths[0])
+	}
+	claims := verifyAppJWT(t, jwt, &key.PublicKey)
+	want := map[string]any{
+		"iss": "Iv1.abc",
+		"iat": float64(1700000000 - 60),
+		"exp": float64(1700000000 + 9*60),
+	}
+	if !cmp.Equal(claims, want) {
+		t.Errorf("JWT claims = %v, want %v", claims, want)
+	}
+}
+
+func TestAppTransport_invalid(t *testing.T) {
+	t.Parallel()
+	req, _ := http.NewRequest("GET", "https://api.github.com/app", nil)
+	for _, tr := range []*AppTransport{
+		{Signer: appTestKey(t)},
+		{Issuer: "1"},
+	} {
+		if _, err := tr.RoundTrip(req); err == nil {
+			t.Errorf("RoundTrip with %+v returned no error", tr)
+		}
+	}
+}
+
+func TestInstallationTransport(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	now := time.Now()
+	tokens := 0
+	mux.HandleFunc("/app/installations/7/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		if got := r.Header.Get("Authorization"); !strings.HasPrefix(got, "Bearer ey") {
+			t.Errorf("Authorization header = %v, want app JWT", got)
+		}
+		testBody(t, r, `{"repositories":["r"]}`+"\n")
+		tokens++
+		fmt.Fprintf(w, `{"token":"tok%v","expires_at":%q}`, tokens, now.Add(time.Hour).Format(time.RFC3339))
+	})
+	var auths []string
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		auths = append(auths, auth)
+		if auth == "Bearer tok2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	app := &AppTransport{Issuer: "1", Signer: appTestKey(t)}
+	tr := NewInstallationTransport(app, 7, &InstallationTokenOptions{Repositories: []string{"r"}})
+	tr.AppClient.BaseURL = client.BaseURL
+	tr.now = func() time.Time { return now }
+
+	installationClient := NewClient(tr.Client())
+	installationClient.BaseURL = client.BaseURL
+	ctx := t.Context()
+	get := func() error {
+		_, _, err := installationClient.Repositories.Get(ctx, "o", "r")
+		return err
+	}
+
+	assertNilError(t, get())
+	assertNilError(t, get())
+	// Refreshed before expiring.
+	now = now.Add(time.Hour - tokenRefreshMargin)
+	if err := get(); err == nil {
+		t.Error("Get with rejected token returned no error")
+	}
+	// Refreshed after being rejected.
+	assertNilError(t, get())
+
+	wantAuths := []string{"Bearer tok1", "Bearer tok1", "Bearer tok2", "Bearer tok3"}
+	if !cmp.Equal(auths, wantAuths) {
+		t.Errorf("Authorization headers = %v, want %v", auths, wantAuths)
+	}
+
+	token, err := tr.Token(ctx)
+	assertNilError(t, err)
+	if want := "tok3"; token != want {
+		t.Errorf("Token = %v, want %v", token, want)
+	}
+}
+
+func TestInstallationTransport_tokenError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/app/installations/7/access_tokens", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	tr := NewInstallationTransport(&AppTransport{Issuer: "1", Signer: appTestKey(t)}, 7, nil)
+	tr.AppClient.BaseURL = client.BaseURL
+	if _, err := tr.Token(t.Context()); err == nil {
+		t.Error("Token returned no error")
+	}
+
+	tr.AppClient = nil
+	if _, err := tr.Token(t.Context()); err == nil {
+		t.Error("Token with nil AppClient returned no error")
+	}
+}
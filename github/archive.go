@@ -0,0 +1,423 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"iter"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+/*
+Archive is a zip or gzipped tar archive downloaded from GitHub, such as a
+repository archive, a workflow artifact or the logs of a workflow run. Its
+entries can be iterated over with Entries, or extracted to a directory with
+Extract:
+
+	archive, _, err := client.Actions.DownloadArtifactArchive(ctx, "owner", "repo", artifactID, 1)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+	for entry, err := range archive.Entries() {
+		if err != nil {
+			return err
+		}
+		...
+	}
+
+Tarballs are streamed from the response, so their entries can only be read
+once. Zip archives can only be read from a random access file, so they are
+downloaded to a temporary file first, which is removed by Close.
+
+It is the caller's responsibility to close the Archive.
+*/
+type Archive struct {
+	format ArchiveFormat
+	body   io.ReadCloser // For tarballs.
+	file   *os.File      // For zipballs.
+	size   int64
+	read   bool
+}
+
+// ArchiveEntry is a file, directory or symbolic link in an Archive.
+type ArchiveEntry struct {
+	// Name is the slash-separated path of the entry in the archive.
+	Name string
+
+	// Mode is the mode of the entry. Only regular files, directories and
+	// symbolic links are reported.
+	Mode fs.FileMode
+
+	// Size is the size of a regular file.
+	Size int64
+
+	// ModTime is the modification time of the entry.
+	ModTime time.Time
+
+	// LinkTarget is the target of a symbolic link.
+	LinkTarget string
+
+	open func() (io.ReadCloser, error)
+}
+
+// Open returns a reader of the contents of a regular file. The entries of a
+// tarball can only be read until the next entry is iterated over.
+func (e *ArchiveEntry) Open() (io.ReadCloser, error) {
+	if !e.Mode.IsRegular() || e.open == nil {
+		return nil, fmt.Errorf("archive entry %v is not a regular file", e.Name)
+	}
+	return e.open()
+}
+
+// newArchive returns an Archive reading body in the given format. body is
+// closed by newArchive for zipballs, or by Archive.Close for tarballs.
+func newArchive(body io.ReadCloser, format ArchiveFormat) (*Archive, error) {
+	switch format {
+	case Tarball:
+		return &Archive{format: format, body: body}, nil
+	case Zipball:
+		defer body.Close()
+		f, err := os.CreateTemp("", "go-github-archive-*.zip")
+		if err != nil {
+			return nil, err
+		}
+		size, err := io.Copy(f, body)
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+			return nil, err
+		}
+		return &Archive{format: format, file: f, size: size}, nil
+	default:
+		_ = body.Close()
+		return nil, fmt.Errorf("unsupported archive format %q", format)
+	}
+}
+
+// downloadArchive downloads the archive at u, as returned by the GitHub API in
+// a redirect, using the transport of the client. Other hosts than the one of
+// the API are sent signed URLs, which must not receive the credentials of the
+// client, so they are downloaded without the transports adding them.
+func (c *Client) downloadArchive(ctx context.Context, u *url.URL, format ArchiveFormat) (*Archive, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	caller := c.client
+	if !strings.EqualFold(u.Host, c.BaseURL.Host) {
+		caller = &http.Client{
+			Transport:     withoutCredentials(c.client.Transport),
+			CheckRedirect: c.client.CheckRedirect,
+			Timeout:       c.client.Timeout,
+		}
+	}
+	resp, err := caller.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckResponse(resp); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+	return newArchive(resp.Body, format)
+}
+
+// withoutCredentials returns the transport that rt sends requests with after
+// adding credentials to them, if rt is a transport of Client.WithAuthToken,
+// AppTransport, InstallationTransport or TokenPoolTransport. Other transports
+// are returned as is.
+func withoutCredentials(rt http.RoundTripper) http.RoundTripper {
+	for {
+		switch t := rt.(type) {
+		case *authTokenTransport:
+			rt = t.base
+		case *AppTransport:
+			rt = t.transport()
+		case *InstallationTransport:
+			rt = t.transport()
+		case *TokenPoolTransport:
+			rt = t.transport()
+		default:
+			return rt
+		}
+	}
+}
+
+// Format returns the format of the archive.
+func (a *Archive) Format() ArchiveFormat {
+	return a.format
+}
+
+// Entries returns an iterator over the entries of the archive, in the order
+// they are stored in. Entries other than regular files, directories and
+// symbolic links are skipped.
+func (a *Archive) Entries() iter.Seq2[*ArchiveEntry, error] {
+	if a.format == Zipball {
+		return a.zipEntries
+	}
+	return a.tarEntries
+}
+
+func (a *Archive) tarEntries(yield func(*ArchiveEntry, error) bool) {
+	if a.read {
+		yield(nil, errors.New("the entries of a tarball can only be read once"))
+		return
+	}
+	a.read = true
+
+	gz, err := gzip.NewReader(a.body)
+	if err != nil {
+		yield(nil, err)
+		return
+	}
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		e := &ArchiveEntry{
+			Name:    strings.TrimSuffix(h.Name, "/"),
+			ModTime: h.ModTime,
+		}
+		switch h.Typeflag {
+		case tar.TypeReg:
+			e.Mode = fs.FileMode(h.Mode) & fs.ModePerm
+			e.Size = h.Size
+			e.open = func() (io.ReadCloser, error) { return io.NopCloser(tr), nil }
+		case tar.TypeDir:
+			e.Mode = fs.ModeDir | fs.FileMode(h.Mode)&fs.ModePerm
+		case tar.TypeSymlink:
+			e.Mode = fs.ModeSymlink | fs.ModePerm
+			e.LinkTarget = h.Linkname
+		default:
+			// GitHub tarballs start with a pax global header, holding the
+			// SHA of the commit.
+			continue
+		}
+		if !yield(e, nil) {
+			return
+		}
+	}
+}
+
+func (a *Archive) zipEntries(yield func(*ArchiveEntry, error) bool) {
+	zr, err := zip.NewReader(a.file, a.size)
+	if err != nil {
+		yield(nil, err)
+		return
+	}
+	for _, f := range zr.File {
+		e := &ArchiveEntry{
+			Name:    strings.TrimSuffix(f.Name, "/"),
+			ModTime: f.Modified,
+		}
+		mode := f.Mode()
+		switch {
+		case mode.IsRegular():
+			e.Mode = mode & fs.ModePerm
+			e.Size = int64(f.UncompressedSize64)
+			e.open = f.Open
+		case mode.IsDir():
+			e.Mode = fs.ModeDir | mode&fs.ModePerm
+		case mode&fs.ModeSymlink != 0:
+			// Zip archives store the target of symbolic links as their
+			// contents.
+			target, err := readZipFile(f)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			e.Mode = fs.ModeSymlink | fs.ModePerm
+			e.LinkTarget = target
+		default:
+			continue
+		}
+		if !yield(e, nil) {
+			return
+		}
+	}
+}
+
+// readZipFile returns the contents of a small file in a zip archive.
+func readZipFile(f *zip.File) (string, error) {
+	r, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	b, err := io.ReadAll(io.LimitReader(r, 4096))
+	return string(b), err
+}
+
+// Extract extracts the entries of the archive to dir, creating it if needed.
+// Existing files are overwritten.
+//
+// Entries whose name is not local to dir, such as "../x" or "/x", are
+// rejected with an error, as well as symbolic links whose target is not local
+// to dir, even through the symbolic links extracted before them. Files are
+// created through an os.Root, so they cannot be written outside of dir by
+// following symbolic links.
+func (a *Archive) Extract(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	for e, err := range a.Entries() {
+		if err != nil {
+			return err
+		}
+		name := filepath.FromSlash(e.Name)
+		if e.Name == "" || !filepath.IsLocal(name) {
+			return fmt.Errorf("archive entry %q is outside of the destination directory", e.Name)
+		}
+		if err := mkdirAllInRoot(root, filepath.Dir(name)); err != nil {
+			return err
+		}
+
+		switch {
+		case e.Mode.IsDir():
+			err = mkdirAllInRoot(root, name)
+		case e.Mode&fs.ModeSymlink != 0:
+			err = extractSymlink(root, dir, e)
+		default:
+			err = extractFile(root, name, e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mkdirAllInRoot creates the directory name in root, along with any
+// necessary parents.
+func mkdirAllInRoot(root *os.Root, name string) error {
+	if name == "." {
+		return nil
+	}
+	if err := mkdirAllInRoot(root, filepath.Dir(name)); err != nil {
+		return err
+	}
+	err := root.Mkdir(name, 0o755)
+	if errors.Is(err, fs.ErrExist) {
+		var info fs.FileInfo
+		if info, err = root.Stat(name); err == nil && !info.IsDir() {
+			err = fmt.Errorf("archive entry %q is not a directory", filepath.ToSlash(name))
+		}
+	}
+	return err
+}
+
+// extractFile writes the contents of the regular file e to name in root.
+func extractFile(root *os.Root, name string, e *ArchiveEntry) error {
+	r, err := e.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	perm := fs.FileMode(0o644)
+	if e.Mode&0o111 != 0 {
+		perm = 0o755
+	}
+	f, err := root.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// extractSymlink creates the symbolic link e in dir, which is opened as root.
+func extractSymlink(root *os.Root, dir string, e *ArchiveEntry) error {
+	target := e.LinkTarget
+	if !isLocalLinkTarget(e.Name, target) {
+		return fmt.Errorf("archive entry %q links outside of the destination directory", e.Name)
+	}
+	name := filepath.FromSlash(e.Name)
+	// The link is created by name, as os.Root.Symlink requires Go 1.25, so
+	// its target is only checked against its location if none of its parent
+	// directories is itself a symbolic link.
+	for p := filepath.Dir(name); p != "."; p = filepath.Dir(p) {
+		info, err := root.Lstat(p)
+		if err != nil {
+			return err
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf("archive entry %q is inside symbolic link %q", e.Name, filepath.ToSlash(p))
+		}
+	}
+	if err := root.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return os.Symlink(filepath.FromSlash(target), filepath.Join(dir, name))
+}
+
+// isLocalLinkTarget reports whether the target of the symbolic link name
+// stays within the directory name is relative to. The ".." elements of
+// target must come first: after another element, which may be a symbolic
+// link, they would not be resolved lexically.
+func isLocalLinkTarget(name, target string) bool {
+	if target == "" || path.IsAbs(target) {
+		return false
+	}
+	leading := true
+	for _, elem := range strings.Split(filepath.ToSlash(target), "/") {
+		switch elem {
+		case "..":
+			if !leading {
+				return false
+			}
+		case ".", "":
+		default:
+			leading = false
+		}
+	}
+	return filepath.IsLocal(filepath.FromSlash(path.Join(path.Dir(name), target)))
+}
+
+// Close closes the archive, and removes its temporary file if any.
+func (a *Archive) Close() error {
+	if a.file != nil {
+		err := a.file.Close()
+		if rmErr := os.Remove(a.file.Name()); err == nil {
+			err = rmErr
+		}
+		return err
+	}
+	return a.body.Close()
+}
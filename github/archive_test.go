@@ -0,0 +1,276 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testArchiveEntry is an entry of an archive built by testTarball or
+// testZipball. Names ending with "/" are directories, and entries with a
+// target are symbolic links.
+type testArchiveEntry struct {
+	name    string
+	content string
+	target  string
+	mode    fs.FileMode
+}
+
+var testArchiveEntries = []testArchiveEntry{
+	{name: "repo-sha/"},
+	{name: "repo-sha/README.md", content: "# Repo"},
+	{name: "repo-sha/bin/run.sh", content: "run", mode: 0o755},
+	{name: "repo-sha/link", target: "README.md"},
+	{name: "repo-sha/bin/README.md", target: "../README.md"},
+}
+
+// testTarball returns a gzipped tarball of entries, in the format of GitHub
+// repository archives.
+func testTarball(t *testing.T, entries []testArchiveEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	assertNilError(t, tw.WriteHeader(&tar.Header{
+		Typeflag:   tar.TypeXGlobalHeader,
+		Name:       "pax_global_header",
+		PAXRecords: map[string]string{"comment": "sha"},
+	}))
+	for _, e := range entries {
+		h := &tar.Header{Name: e.name, Mode: int64(e.mode)}
+		switch {
+		case strings.HasSuffix(e.name, "/"):
+			h.Typeflag, h.Mode = tar.TypeDir, 0o755
+		case e.target != "":
+			h.Typeflag, h.Linkname = tar.TypeSymlink, e.target
+		default:
+			h.Typeflag, h.Size = tar.TypeReg, int64(len(e.content))
+			if h.Mode == 0 {
+				h.Mode = 0o644
+			}
+		}
+		assertNilError(t, tw.WriteHeader(h))
+		_, err := tw.Write([]byte(e.content))
+		assertNilError(t, err)
+	}
+	assertNilError(t, tw.Close())
+	assertNilError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// testZipball returns a zip archive of entries.
+func testZipball(t *testing.T, entries []testArchiveEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range entries {
+		h := &zip.FileHeader{Name: e.name}
+		content := e.content
+		switch {
+		case strings.HasSuffix(e.name, "/"):
+			h.SetMode(fs.ModeDir | 0o755)
+		case e.target != "":
+			h.SetMode(fs.ModeSymlink | 0o777)
+			content = e.target
+		case e.mode != 0:
+			h.SetMode(e.mode)
+		default:
+			h.SetMode(0o644)
+		}
+		w, err := zw.CreateHeader(h)
+		assertNilError(t, err)
+		_, err = io.WriteString(w, content)
+		assertNilError(t, err)
+	}
+	assertNilError(t, zw.Close())
+	return buf.Bytes()
+}
+
+// testArchive returns an Archive of entries in the given format.
+func testArchive(t *testing.T, format ArchiveFormat, entries []testArchiveEntry) *Archive {
+	t.Helper()
+	b := testZipball(t, entries)
+	if format == Tarball {
+		b = testTarball(t, entries)
+	}
+	archive, err := newArchive(io.NopCloser(bytes.NewReader(b)), format)
+	if err != nil {
+		t.Fatalf("newArchive returned error: %v", err)
+	}
+	t.Cleanup(func() { _ = archive.Close() })
+	return archive
+}
+
+// readArchive returns a description of each entry of archive.
+func readArchive(t *testing.T, archive *Archive) []string {
+	t.Helper()
+	var got []string
+	for e, err := range archive.Entries() {
+		if err != nil {
+			t.Fatalf("Entries returned error: %v", err)
+		}
+		desc := e.Name + " " + e.Mode.String()
+		switch {
+		case e.Mode.IsRegular():
+			r, err := e.Open()
+			assertNilError(t, err)
+			b, err := io.ReadAll(r)
+			assertNilError(t, err)
+			assertNilError(t, r.Close())
+			if int64(len(b)) != e.Size {
+				t.Errorf("entry %v has size %v, want %v", e.Name, e.Size, len(b))
+			}
+			desc += " " + string(b)
+		case e.Mode&fs.ModeSymlink != 0:
+			desc += " -> " + e.LinkTarget
+		}
+		got = append(got, desc)
+	}
+	return got
+}
+
+func TestArchive_Entries(t *testing.T) {
+	t.Parallel()
+	want := []string{
+		"repo-sha drwxr-xr-x",
+		"repo-sha/README.md -rw-r--r-- # Repo",
+		"repo-sha/bin/run.sh -rwxr-xr-x run",
+		"repo-sha/link Lrwxrwxrwx -> README.md",
+		"repo-sha/bin/README.md Lrwxrwxrwx -> ../README.md",
+	}
+	for _, format := range []ArchiveFormat{Tarball, Zipball} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+			archive := testArchive(t, format, testArchiveEntries)
+			if got := archive.Format(); got != format {
+				t.Errorf("Format = %v, want %v", got, format)
+			}
+			if got := readArchive(t, archive); !cmp.Equal(got, want) {
+				t.Errorf("Entries = %v, want %v", got, want)
+			}
+
+			// Zip archives can be read again, but tarballs are streamed.
+			for _, err := range archive.Entries() {
+				if (err != nil) != (format == Tarball) {
+					t.Errorf("reading Entries again returned error %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestArchive_Extract(t *testing.T) {
+	t.Parallel()
+	for _, format := range []ArchiveFormat{Tarball, Zipball} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+			dir := filepath.Join(t.TempDir(), "out")
+			archive := testArchive(t, format, testArchiveEntries)
+			if err := archive.Extract(dir); err != nil {
+				t.Fatalf("Extract returned error: %v", err)
+			}
+
+			for _, link := range []string{"link", filepath.Join("bin", "README.md")} {
+				b, err := os.ReadFile(filepath.Join(dir, "repo-sha", link))
+				if err != nil {
+					t.Fatalf("reading extracted symbolic link returned error: %v", err)
+				}
+				if string(b) != "# Repo" {
+					t.Errorf("extracted symbolic link %v resolves to %q, want %q", link, b, "# Repo")
+				}
+			}
+			info, err := os.Stat(filepath.Join(dir, "repo-sha", "bin", "run.sh"))
+			if err != nil {
+				t.Fatalf("Stat of extracted file returned error: %v", err)
+			}
+			if info.Mode()&0o100 == 0 {
+				t.Errorf("extracted file has mode %v, want it executable", info.Mode())
+			}
+		})
+	}
+}
+
+func TestArchive_Extract_outside(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		entries []testArchiveEntry
+	}{
+		{"parent", []testArchiveEntry{{name: "../evil", content: "x"}}},
+		{"nested parent", []testArchiveEntry{{name: "a/../../evil", content: "x"}}},
+		{"absolute", []testArchiveEntry{{name: "/tmp/evil", content: "x"}}},
+		{"absolute link", []testArchiveEntry{{name: "link", target: "/etc"}}},
+		{"parent link", []testArchiveEntry{{name: "a/link", target: "../../etc"}}},
+		// x/x/x is the destination directory, so up links three levels above it.
+		{"link in link", []testArchiveEntry{
+			{name: "x", target: "."},
+			{name: "x/x/x/up", target: "../../.."},
+		}},
+		{"parent through link", []testArchiveEntry{
+			{name: "x", target: "."},
+			{name: "up", target: "x/x/x/../.."},
+		}},
+	}
+	for _, tt := range tests {
+		for _, format := range []ArchiveFormat{Tarball, Zipball} {
+			t.Run(tt.name+"/"+string(format), func(t *testing.T) {
+				t.Parallel()
+				parent := t.TempDir()
+				dir := filepath.Join(parent, "out")
+				archive := testArchive(t, format, tt.entries)
+				if err := archive.Extract(dir); err == nil {
+					t.Error("Extract returned nil error")
+				}
+				entries, err := os.ReadDir(parent)
+				assertNilError(t, err)
+				if len(entries) != 1 {
+					t.Errorf("Extract wrote %v entries next to the destination, want none", len(entries)-1)
+				}
+			})
+		}
+	}
+}
+
+func TestNewArchive_unsupportedFormat(t *testing.T) {
+	t.Parallel()
+	if _, err := newArchive(io.NopCloser(strings.NewReader("")), "rar"); err == nil {
+		t.Error("newArchive returned nil error")
+	}
+}
+
+func TestWithoutCredentials(t *testing.T) {
+	t.Parallel()
+	base := &http.Transport{}
+	tests := []struct {
+		name string
+		rt   http.RoundTripper
+		want http.RoundTripper
+	}{
+		{"nil", nil, nil},
+		{"other", base, base},
+		{"WithAuthToken", &authTokenTransport{token: "t", base: base}, base},
+		{"AppTransport", &AppTransport{Transport: base}, base},
+		{"InstallationTransport", &InstallationTransport{Transport: &authTokenTransport{base: base}}, base},
+		{"TokenPoolTransport", &TokenPoolTransport{Transport: base}, base},
+	}
+	for _, tt := range tests {
+		if got := withoutCredentials(tt.rt); got != tt.want {
+			t.Errorf("withoutCredentials(%v) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
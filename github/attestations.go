@@ -10,7 +10,8 @@ import (
 )
 
 // Attestation represents an artifact attestation associated with a repository.
-// The provided bundle can be used to verify the provenance of artifacts.
+// The provided bundle can be used to verify the provenance of artifacts, for
+// instance with the github.com/google/go-github/v75/attestation package.
 //
 // https://docs.github.com/en/actions/security-for-github-actions/using-artifact-attestations/using-artifact-attestations-to-establish-provenance-for-builds
 type Attestation struct {
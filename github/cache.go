@@ -0,0 +1,232 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Cache is a store of HTTP responses used by Client to make conditional
+// requests. When a Client has a Cache, GET responses carrying an ETag or
+// Last-Modified header are stored, and subsequent requests for the same
+// resource are sent with If-None-Match or If-Modified-Since. If GitHub
+// responds with 304 Not Modified, which does not count against the rate
+// limit, the stored response is returned instead.
+//
+// Responses are stored under a key made of the URL and the headers that select
+// their representation. It includes a hash of the Authorization header of
+// the request, if set on the request itself, but not the credentials added by
+// the client's transport, such as with WithAuthToken, AppTransport or
+// TokenPoolTransport. A Cache must therefore not be shared between clients
+// using different credentials, since a response to one credential could be
+// served to another.
+//
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored under key and whether it was found.
+	Get(key string) ([]byte, bool)
+	// Set stores value under key.
+	Set(key string, value []byte)
+	// Delete removes the value stored under key, if any.
+	Delete(key string)
+}
+
+// CacheStatus describes how a Client's Cache was used to serve a Response.
+type CacheStatus string
+
+const (
+	// CacheStatusNone indicates the cache was not used, either because
+	// the client has no Cache or because the request is not cacheable.
+	CacheStatusNone CacheStatus = ""
+	// CacheStatusMiss indicates the response was received from GitHub.
+	CacheStatusMiss CacheStatus = "miss"
+	// CacheStatusHit indicates GitHub responded with 304 Not Modified and the
+	// response was served from the cache.
+	CacheStatusHit CacheStatus = "hit"
+)
+
+// cacheKey returns the key under which the response to req is cached. It
+// includes the headers that select the representation of the resource, and a
+// hash of the credential of the request, if any, which must not be stored in
+// the clear.
+func cacheKey(req *http.Request) string {
+	key := req.URL.String() + "\n" + req.Header.Get("Accept") + "\n" + req.Header.Get(headerAPIVersion)
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		sum := sha256.Sum256([]byte(auth))
+		key += "\n" + hex.EncodeToString(sum[:])
+	}
+	return key
+}
+
+// isCacheable reports whether the response to req may be stored in a Cache.
+// Requests which are already conditional or partial are left alone.
+func isCacheable(req *http.Request) bool {
+	return req.Method == "GET" &&
+		req.Header.Get("If-None-Match") == "" &&
+		req.Header.Get("If-Modified-Since") == "" &&
+		req.Header.Get("Range") == ""
+}
+
+// cachedResponse returns the response to req stored in c, if any.
+func cachedResponse(c Cache, key string, req *http.Request) *http.Response {
+	b, ok := c.Get(key)
+	if !ok {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req)
+	if err != nil {
+		c.Delete(key)
+		return nil
+	}
+	return resp
+}
+
+// withValidators returns a copy of req made conditional on the validators of
+// cached.
+func withValidators(req *http.Request, cached *http.Response) *http.Request {
+	etag := cached.Header.Get("Etag")
+	lastModified := cached.Header.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		return req
+	}
+	req = req.Clone(req.Context())
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+	return req
+}
+
+// updateCache serves resp from cached if GitHub reported it was not modified,
+// and otherwise stores resp in c if it carries validators.
+func updateCache(c Cache, key string, cached, resp *http.Response) (*http.Response, CacheStatus) {
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		// The headers of the 304 response, such as the rate limit, are more
+		// recent than the stored ones.
+		for k, v := range resp.Header {
+			cached.Header[k] = v
+		}
+		cached.Request = resp.Request
+		return cached, CacheStatusHit
+	}
+	if cached != nil {
+		cached.Body.Close()
+	}
+
+	if resp.StatusCode != http.StatusOK || (resp.Header.Get("Etag") == "" && resp.Header.Get("Last-Modified") == "") {
+		c.Delete(key)
+		return resp, CacheStatusMiss
+	}
+	b, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return resp, CacheStatusMiss
+	}
+	c.Set(key, b)
+	return resp, CacheStatusMiss
+}
+
+// MemoryCache is a Cache that stores responses in memory.
+// It must be created with NewMemoryCache.
+type MemoryCache struct {
+	mu    sync.RWMutex
+	items map[string][]byte
+}
+
+// NewMemoryCache returns a new, empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{items: map[string][]byte{}}
+}
+
+// Get implements the Cache interface.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	b, ok := c.items[key]
+	return b, ok
+}
+
+// Set implements the Cache interface.
+func (c *MemoryCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = value
+}
+
+// Delete implements the Cache interface.
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
+// DiskCache is a Cache that stores each response in a file in a directory.
+// Failures to read or write files are treated as cache misses.
+type DiskCache struct {
+	// Dir is the directory holding the cached responses. It is created if
+	// it does not exist.
+	Dir string
+}
+
+// NewDiskCache returns a DiskCache storing responses in dir.
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{Dir: dir}
+}
+
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements the Cache interface.
+func (c *DiskCache) Get(key string) ([]byte, bool) {
+	b, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+// Set implements the Cache interface.
+func (c *DiskCache) Set(key string, value []byte) {
+	if err := os.MkdirAll(c.Dir, 0o700); err != nil {
+		return
+	}
+	// Write to a temporary file first so that concurrent readers never
+	// observe a partially written response.
+	f, err := os.CreateTemp(c.Dir, "tmp-")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(value)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return
+	}
+	if err := os.Rename(f.Name(), c.path(key)); err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+// Delete implements the Cache interface.
+func (c *DiskCache) Delete(key string) {
+	os.Remove(c.path(key))
+}
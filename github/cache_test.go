@@ -0,0 +1,246 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_Cache(t *testing.T) {
+	t.Parallel()
+	for name, cache := range map[string]Cache{
+		"memory": NewMemoryCache(),
+		"disk":   NewDiskCache(t.TempDir()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+			client.Cache = cache
+
+			var calls int
+			mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				calls++
+				w.Header().Set(headerRateLimit, "5000")
+				w.Header().Set(headerRateRemaining, fmt.Sprint(5000-calls))
+				switch calls {
+				case 1:
+					testHeader(t, r, "If-None-Match", "")
+					w.Header().Set("Etag", `"v1"`)
+					fmt.Fprint(w, `{"id":1,"name":"one"}`)
+				case 2:
+					testHeader(t, r, "If-None-Match", `"v1"`)
+					w.WriteHeader(http.StatusNotModified)
+				case 3:
+					testHeader(t, r, "If-None-Match", `"v1"`)
+					w.Header().Set("Etag", `"v2"`)
+					fmt.Fprint(w, `{"id":1,"name":"two"}`)
+				case 4:
+					testHeader(t, r, "If-None-Match", `"v2"`)
+					w.WriteHeader(http.StatusNotModified)
+				}
+			})
+
+			ctx := t.Context()
+			for i, want := range []struct {
+				name   string
+				status CacheStatus
+			}{
+				{"one", CacheStatusMiss},
+				{"one", CacheStatusHit},
+				{"two", CacheStatusMiss},
+				{"two", CacheStatusHit},
+			} {
+				repo, resp, err := client.Repositories.Get(ctx, "o", "r")
+				if err != nil {
+					t.Fatalf("Repositories.Get #%v returned error: %v", i, err)
+				}
+				if got := repo.GetName(); got != want.name {
+					t.Errorf("Repositories.Get #%v returned name %q, want %q", i, got, want.name)
+				}
+				if resp.CacheStatus != want.status {
+					t.Errorf("Repositories.Get #%v returned CacheStatus %q, want %q", i, resp.CacheStatus, want.status)
+				}
+				if resp.StatusCode != http.StatusOK {
+					t.Errorf("Repositories.Get #%v returned status %v, want 200", i, resp.StatusCode)
+				}
+				if want := 5000 - (i + 1); resp.Rate.Remaining != want {
+					t.Errorf("Repositories.Get #%v returned Rate.Remaining %v, want %v", i, resp.Rate.Remaining, want)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_Cache_lastModified(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.Cache = NewMemoryCache()
+
+	const lastModified = "Mon, 02 Jan 2006 15:04:05 GMT"
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := t.Context()
+	for range 2 {
+		if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+			t.Fatalf("Repositories.Get returned error: %v", err)
+		}
+	}
+	_, resp, err := client.Repositories.Get(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if resp.CacheStatus != CacheStatusHit {
+		t.Errorf("Repositories.Get returned CacheStatus %q, want %q", resp.CacheStatus, CacheStatusHit)
+	}
+}
+
+func TestClient_Cache_gzip(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.Cache = NewMemoryCache()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Etag", `"v1"`)
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		fmt.Fprint(gz, `{"id":1,"name":"zipped"}`)
+		gz.Close()
+	})
+
+	ctx := t.Context()
+	for range 2 {
+		repo, _, err := client.Repositories.Get(ctx, "o", "r")
+		if err != nil {
+			t.Fatalf("Repositories.Get returned error: %v", err)
+		}
+		if got, want := repo.GetName(), "zipped"; got != want {
+			t.Errorf("Repositories.Get returned name %q, want %q", got, want)
+		}
+	}
+}
+
+func TestClient_Cache_notCacheable(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	cache := NewMemoryCache()
+	client.Cache = cache
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Etag", `"v1"`)
+		if r.Method == "PATCH" {
+			testHeader(t, r, "If-None-Match", "")
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+	mux.HandleFunc("/repos/o/r/topics", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"names":["go"]}`)
+	})
+
+	ctx := t.Context()
+	_, resp, err := client.Repositories.Edit(ctx, "o", "r", &Repository{})
+	if err != nil {
+		t.Fatalf("Repositories.Edit returned error: %v", err)
+	}
+	if resp.CacheStatus != CacheStatusNone {
+		t.Errorf("Repositories.Edit returned CacheStatus %q, want %q", resp.CacheStatus, CacheStatusNone)
+	}
+
+	// Responses without validators are not stored.
+	_, resp, err = client.Repositories.ListAllTopics(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.ListAllTopics returned error: %v", err)
+	}
+	if resp.CacheStatus != CacheStatusMiss {
+		t.Errorf("Repositories.ListAllTopics returned CacheStatus %q, want %q", resp.CacheStatus, CacheStatusMiss)
+	}
+	if len(cache.items) != 0 {
+		t.Errorf("cache has %v items, want 0", len(cache.items))
+	}
+}
+
+func TestClient_Cache_conditionalRequest(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.Cache = NewMemoryCache()
+
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"mine"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Etag", `"v1"`)
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := t.Context()
+	req, err := client.NewRequest("GET", "repos/o/r", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	req.Header.Set("If-None-Match", `"mine"`)
+	resp, err := client.Do(ctx, req, nil)
+	if resp == nil || resp.StatusCode != http.StatusNotModified {
+		t.Fatalf("Do returned %v, %v, want 304", resp, err)
+	}
+	if resp.CacheStatus != CacheStatusNone {
+		t.Errorf("Do returned CacheStatus %q, want %q", resp.CacheStatus, CacheStatusNone)
+	}
+}
+
+func TestCacheKey_authorization(t *testing.T) {
+	t.Parallel()
+	req := func(auth string) *http.Request {
+		r, _ := http.NewRequest("GET", "https://api.github.com/repos/o/r", nil)
+		if auth != "" {
+			r.Header.Set("Authorization", auth)
+		}
+		return r
+	}
+
+	anonymous, alice, bob := cacheKey(req("")), cacheKey(req("Bearer alice")), cacheKey(req("Bearer bob"))
+	if anonymous == alice || alice == bob {
+		t.Errorf("cacheKey returned %q, %q and %q, want distinct keys per credential", anonymous, alice, bob)
+	}
+	if alice != cacheKey(req("Bearer alice")) {
+		t.Error("cacheKey returned different keys for the same credential")
+	}
+	if strings.Contains(alice, "alice") {
+		t.Errorf("cacheKey returned %q, which contains the credential", alice)
+	}
+}
+
+func TestDiskCache(t *testing.T) {
+	t.Parallel()
+	c := NewDiskCache(t.TempDir() + "/nested")
+
+	if _, ok := c.Get("k"); ok {
+		t.Error("Get on empty cache returned ok")
+	}
+	c.Set("k", []byte("v"))
+	if got, ok := c.Get("k"); !ok || string(got) != "v" {
+		t.Errorf("Get returned %q, %v, want \"v\", true", got, ok)
+	}
+	c.Delete("k")
+	if _, ok := c.Get("k"); ok {
+		t.Error("Get after Delete returned ok")
+	}
+}
@@ -386,6 +386,7 @@ func (s *CodeScanningService) ListAlertInstances(ctx context.Context, owner, rep
 // UploadSarif uploads the result of code scanning job to GitHub.
 //
 // For the parameter sarif, you must first compress your SARIF file using gzip and then translate the contents of the file into a Base64 encoding string.
+// The sarif package of this module provides the types of SARIF files, and encodes them as expected.
 // You must use an access token with the security_events scope to use this endpoint. GitHub Apps must have the security_events
 // write permission to use this endpoint.
 //
@@ -426,6 +427,8 @@ type SARIFUpload struct {
 	ProcessingStatus *string `json:"processing_status,omitempty"`
 	// The REST API URL for getting the analyses associated with the upload.
 	AnalysesURL *string `json:"analyses_url,omitempty"`
+	// The errors that occurred while processing a `failed` upload.
+	Errors []string `json:"errors,omitempty"`
 }
 
 // GetSARIF gets information about a SARIF upload.
@@ -0,0 +1,916 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DiscussionsService handles communication with the repository discussions
+// related methods of the GitHub API.
+//
+// Discussions are only available through the GraphQL API, so the methods of
+// this service send GraphQL requests and convert the results into the same
+// types delivered by DiscussionEvent and DiscussionCommentEvent webhooks.
+// Discussions are identified by repository and number, while comments are
+// identified by their node ID, as in CommentDiscussion.NodeID.
+//
+// Organization discussions are stored in the repository selected as their
+// source in the organization settings, and are accessed through it.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/using-the-graphql-api-for-discussions
+type DiscussionsService service
+
+// DiscussionCursorOptions specifies the pagination parameters to the
+// DiscussionsService List methods.
+type DiscussionCursorOptions struct {
+	// PerPage is the number of results to include per page, up to 100.
+	// Defaults to 30.
+	PerPage int
+
+	// After is the cursor, as given in Response.After, of the page to retrieve.
+	After string
+}
+
+// RepositoryDiscussionListOptions specifies the optional parameters to the
+// DiscussionsService.List method.
+type RepositoryDiscussionListOptions struct {
+	// CategoryID filters discussions by the node ID of their category.
+	CategoryID string
+
+	// State filters discussions by state. Possible values are: "open" and
+	// "closed". Default is to list all discussions.
+	State string
+
+	// Answered filters discussions by whether they have been answered.
+	Answered *bool
+
+	DiscussionCursorOptions
+}
+
+// DiscussionRequest represents a request to create or edit a discussion.
+// Fields left nil are not changed when editing.
+type DiscussionRequest struct {
+	Title *string `json:"title,omitempty"`
+	Body  *string `json:"body,omitempty"`
+	// CategoryID is the node ID of the category of the discussion.
+	CategoryID *string `json:"category_id,omitempty"`
+}
+
+// DiscussionCommentRequest represents a request to create a discussion
+// comment.
+type DiscussionCommentRequest struct {
+	Body *string `json:"body,omitempty"`
+	// ReplyToID is the node ID of the comment to reply to. Replies can only
+	// be made to top-level comments.
+	ReplyToID *string `json:"reply_to_id,omitempty"`
+}
+
+// DiscussionPoll represents a poll in a discussion.
+type DiscussionPoll struct {
+	NodeID         *string                 `json:"node_id,omitempty"`
+	Question       *string                 `json:"question,omitempty"`
+	TotalVoteCount *int                    `json:"total_vote_count,omitempty"`
+	Options        []*DiscussionPollOption `json:"options,omitempty"`
+}
+
+// DiscussionPollOption represents an option of a discussion poll.
+type DiscussionPollOption struct {
+	NodeID         *string `json:"node_id,omitempty"`
+	Option         *string `json:"option,omitempty"`
+	TotalVoteCount *int    `json:"total_vote_count,omitempty"`
+}
+
+// discussionLockReasons maps the lock reasons used by the REST API and
+// webhooks to the values of the GraphQL LockReason enum.
+var discussionLockReasons = map[string]string{
+	"off-topic":  "OFF_TOPIC",
+	"too heated": "TOO_HEATED",
+	"resolved":   "RESOLVED",
+	"spam":       "SPAM",
+}
+
+// The GraphQL API rejects queries defining unused fragments, so each query
+// only includes the fragments it needs.
+const (
+	actorFragment = `
+fragment actorFields on Actor {
+	__typename
+	login
+	avatarUrl
+	url
+	... on User { databaseId id }
+	... on Bot { databaseId id }
+	... on Organization { databaseId id }
+}`
+
+	categoryFragment = `
+fragment categoryFields on DiscussionCategory {
+	id
+	name
+	emoji
+	description
+	slug
+	isAnswerable
+	createdAt
+	updatedAt
+	repository { databaseId }
+}`
+
+	pollFragment = `
+fragment pollFields on DiscussionPoll {
+	id
+	question
+	totalVoteCount
+	options(first: 100) { nodes { id option totalVoteCount } }
+}`
+
+	discussionFragments = `
+fragment discussionFields on Discussion {
+	databaseId
+	id
+	number
+	title
+	body
+	url
+	closed
+	locked
+	activeLockReason
+	authorAssociation
+	createdAt
+	updatedAt
+	answerChosenAt
+	author { ...actorFields }
+	answerChosenBy { login }
+	answer { url }
+	category { ...categoryFields }
+	comments { totalCount }
+	poll { ...pollFields }
+}` + actorFragment + categoryFragment + pollFragment
+
+	commentFragments = `
+fragment commentFields on DiscussionComment {
+	databaseId
+	id
+	body
+	url
+	authorAssociation
+	createdAt
+	updatedAt
+	author { ...actorFields }
+	replyTo { databaseId }
+	discussion { databaseId }
+	replies { totalCount }
+}` + actorFragment
+)
+
+type discussionActorNode struct {
+	Typename   string  `json:"__typename"`
+	Login      *string `json:"login"`
+	AvatarURL  *string `json:"avatarUrl"`
+	URL        *string `json:"url"`
+	DatabaseID *int64  `json:"databaseId"`
+	ID         *string `json:"id"`
+}
+
+func (n *discussionActorNode) user() *User {
+	if n == nil {
+		return nil
+	}
+	u := &User{
+		Login:     n.Login,
+		ID:        n.DatabaseID,
+		NodeID:    n.ID,
+		AvatarURL: n.AvatarURL,
+		HTMLURL:   n.URL,
+	}
+	if n.Typename != "" {
+		u.Type = Ptr(n.Typename)
+	}
+	return u
+}
+
+type discussionCategoryNode struct {
+	ID           *string    `json:"id"`
+	Name         *string    `json:"name"`
+	Emoji        *string    `json:"emoji"`
+	Description  *string    `json:"description"`
+	Slug         *string    `json:"slug"`
+	IsAnswerable *bool      `json:"isAnswerable"`
+	CreatedAt    *Timestamp `json:"createdAt"`
+	UpdatedAt    *Timestamp `json:"updatedAt"`
+	Repository   *struct {
+		DatabaseID *int64 `json:"databaseId"`
+	} `json:"repository"`
+}
+
+func (n *discussionCategoryNode) category() *DiscussionCategory {
+	if n == nil {
+		return nil
+	}
+	c := &DiscussionCategory{
+		NodeID:       n.ID,
+		Name:         n.Name,
+		Emoji:        n.Emoji,
+		Description:  n.Description,
+		Slug:         n.Slug,
+		IsAnswerable: n.IsAnswerable,
+		CreatedAt:    n.CreatedAt,
+		UpdatedAt:    n.UpdatedAt,
+	}
+	if n.Repository != nil {
+		c.RepositoryID = n.Repository.DatabaseID
+	}
+	return c
+}
+
+type discussionPollNode struct {
+	ID             *string `json:"id"`
+	Question       *string `json:"question"`
+	TotalVoteCount *int    `json:"totalVoteCount"`
+	Options        struct {
+		Nodes []*struct {
+			ID             *string `json:"id"`
+			Option         *string `json:"option"`
+			TotalVoteCount *int    `json:"totalVoteCount"`
+		} `json:"nodes"`
+	} `json:"options"`
+}
+
+func (n *discussionPollNode) poll() *DiscussionPoll {
+	if n == nil {
+		return nil
+	}
+	p := &DiscussionPoll{
+		NodeID:         n.ID,
+		Question:       n.Question,
+		TotalVoteCount: n.TotalVoteCount,
+	}
+	for _, o := range n.Options.Nodes {
+		p.Options = append(p.Options, &DiscussionPollOption{
+			NodeID:         o.ID,
+			Option:         o.Option,
+			TotalVoteCount: o.TotalVoteCount,
+		})
+	}
+	return p
+}
+
+type discussionNode struct {
+	DatabaseID        *int64               `json:"databaseId"`
+	ID                *string              `json:"id"`
+	Number            *int                 `json:"number"`
+	Title             *string              `json:"title"`
+	Body              *string              `json:"body"`
+	URL               *string              `json:"url"`
+	Closed            *bool                `json:"closed"`
+	Locked            *bool                `json:"locked"`
+	ActiveLockReason  *string              `json:"activeLockReason"`
+	AuthorAssociation *string              `json:"authorAssociation"`
+	CreatedAt         *Timestamp           `json:"createdAt"`
+	UpdatedAt         *Timestamp           `json:"updatedAt"`
+	AnswerChosenAt    *Timestamp           `json:"answerChosenAt"`
+	Author            *discussionActorNode `json:"author"`
+	AnswerChosenBy    *struct {
+		Login *string `json:"login"`
+	} `json:"answerChosenBy"`
+	Answer *struct {
+		URL *string `json:"url"`
+	} `json:"answer"`
+	Category *discussionCategoryNode `json:"category"`
+	Comments *struct {
+		TotalCount *int `json:"totalCount"`
+	} `json:"comments"`
+	Poll *discussionPollNode `json:"poll"`
+}
+
+func (n *discussionNode) discussion() *Discussion {
+	if n == nil {
+		return nil
+	}
+	d := &Discussion{
+		ID:                 n.DatabaseID,
+		NodeID:             n.ID,
+		Number:             n.Number,
+		Title:              n.Title,
+		Body:               n.Body,
+		HTMLURL:            n.URL,
+		Locked:             n.Locked,
+		AuthorAssociation:  n.AuthorAssociation,
+		CreatedAt:          n.CreatedAt,
+		UpdatedAt:          n.UpdatedAt,
+		AnswerChosenAt:     n.AnswerChosenAt,
+		User:               n.Author.user(),
+		DiscussionCategory: n.Category.category(),
+		Poll:               n.Poll.poll(),
+	}
+	if n.Closed != nil {
+		d.State = Ptr("open")
+		if *n.Closed {
+			d.State = Ptr("closed")
+		}
+	}
+	if n.ActiveLockReason != nil {
+		for reason, value := range discussionLockReasons {
+			if value == *n.ActiveLockReason {
+				d.ActiveLockReason = Ptr(reason)
+			}
+		}
+	}
+	if n.AnswerChosenBy != nil {
+		d.AnswerChosenBy = n.AnswerChosenBy.Login
+	}
+	if n.Answer != nil {
+		d.AnswerHTMLURL = n.Answer.URL
+	}
+	if n.Comments != nil {
+		d.Comments = n.Comments.TotalCount
+	}
+	return d
+}
+
+type discussionCommentNode struct {
+	DatabaseID        *int64               `json:"databaseId"`
+	ID                *string              `json:"id"`
+	Body              *string              `json:"body"`
+	URL               *string              `json:"url"`
+	AuthorAssociation *string              `json:"authorAssociation"`
+	CreatedAt         *Timestamp           `json:"createdAt"`
+	UpdatedAt         *Timestamp           `json:"updatedAt"`
+	Author            *discussionActorNode `json:"author"`
+	ReplyTo           *struct {
+		DatabaseID *int64 `json:"databaseId"`
+	} `json:"replyTo"`
+	Discussion *struct {
+		DatabaseID *int64 `json:"databaseId"`
+	} `json:"discussion"`
+	Replies *struct {
+		TotalCount *int `json:"totalCount"`
+	} `json:"replies"`
+}
+
+func (n *discussionCommentNode) comment() *CommentDiscussion {
+	if n == nil {
+		return nil
+	}
+	c := &CommentDiscussion{
+		ID:                n.DatabaseID,
+		NodeID:            n.ID,
+		Body:              n.Body,
+		HTMLURL:           n.URL,
+		AuthorAssociation: n.AuthorAssociation,
+		CreatedAt:         n.CreatedAt,
+		UpdatedAt:         n.UpdatedAt,
+		User:              n.Author.user(),
+	}
+	if n.ReplyTo != nil {
+		c.ParentID = n.ReplyTo.DatabaseID
+	}
+	if n.Discussion != nil {
+		c.DiscussionID = n.Discussion.DatabaseID
+	}
+	if n.Replies != nil {
+		c.ChildCommentCount = n.Replies.TotalCount
+	}
+	return c
+}
+
+// cursorVariables returns the GraphQL pagination variables for opts.
+func (opts *DiscussionCursorOptions) cursorVariables(vars map[string]any) map[string]any {
+	vars["first"] = 30
+	if opts == nil {
+		return vars
+	}
+	if opts.PerPage > 0 {
+		vars["first"] = opts.PerPage
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	return vars
+}
+
+// setPageInfo sets the pagination fields of resp from the page info of a
+// GraphQL connection.
+func setPageInfo(resp *Response, pageInfo graphQLPageInfo) {
+	if pageInfo.HasNextPage {
+		resp.After = pageInfo.EndCursor
+	}
+}
+
+// nodeID returns the node ID of the discussion with the given number.
+func (s *DiscussionsService) nodeID(ctx context.Context, owner, repo string, number int) (string, *Response, error) {
+	const query = `query($owner: String!, $repo: String!, $number: Int!) {
+	repository(owner: $owner, name: $repo) { discussion(number: $number) { id } }
+}`
+	var data struct {
+		Repository struct {
+			Discussion struct {
+				ID string `json:"id"`
+			} `json:"discussion"`
+		} `json:"repository"`
+	}
+	vars := map[string]any{"owner": owner, "repo": repo, "number": number}
+	resp, err := s.client.GraphQL(ctx, query, vars, &data)
+	if err != nil {
+		return "", resp, err
+	}
+	return data.Repository.Discussion.ID, resp, nil
+}
+
+// ListCategories lists the discussion categories of a repository.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) ListCategories(ctx context.Context, owner, repo string, opts *DiscussionCursorOptions) ([]*DiscussionCategory, *Response, error) {
+	const query = `query($owner: String!, $repo: String!, $first: Int!, $after: String) {
+	repository(owner: $owner, name: $repo) {
+		discussionCategories(first: $first, after: $after) {
+			pageInfo { hasNextPage endCursor }
+			nodes { ...categoryFields }
+		}
+	}
+}` + categoryFragment
+	var data struct {
+		Repository struct {
+			DiscussionCategories struct {
+				PageInfo graphQLPageInfo           `json:"pageInfo"`
+				Nodes    []*discussionCategoryNode `json:"nodes"`
+			} `json:"discussionCategories"`
+		} `json:"repository"`
+	}
+	vars := opts.cursorVariables(map[string]any{"owner": owner, "repo": repo})
+	resp, err := s.client.GraphQL(ctx, query, vars, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	conn := data.Repository.DiscussionCategories
+	setPageInfo(resp, conn.PageInfo)
+	categories := make([]*DiscussionCategory, 0, len(conn.Nodes))
+	for _, n := range conn.Nodes {
+		categories = append(categories, n.category())
+	}
+
+	return categories, resp, nil
+}
+
+// List lists the discussions of a repository.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) List(ctx context.Context, owner, repo string, opts *RepositoryDiscussionListOptions) ([]*Discussion, *Response, error) {
+	const query = `query($owner: String!, $repo: String!, $first: Int!, $after: String, $categoryId: ID, $states: [DiscussionState!], $answered: Boolean) {
+	repository(owner: $owner, name: $repo) {
+		discussions(first: $first, after: $after, categoryId: $categoryId, states: $states, answered: $answered) {
+			pageInfo { hasNextPage endCursor }
+			nodes { ...discussionFields }
+		}
+	}
+}` + discussionFragments
+	var data struct {
+		Repository struct {
+			Discussions struct {
+				PageInfo graphQLPageInfo   `json:"pageInfo"`
+				Nodes    []*discussionNode `json:"nodes"`
+			} `json:"discussions"`
+		} `json:"repository"`
+	}
+	vars := map[string]any{"owner": owner, "repo": repo}
+	var cursor *DiscussionCursorOptions
+	if opts != nil {
+		cursor = &opts.DiscussionCursorOptions
+		if opts.CategoryID != "" {
+			vars["categoryId"] = opts.CategoryID
+		}
+		if opts.State != "" {
+			vars["states"] = []string{strings.ToUpper(opts.State)}
+		}
+		if opts.Answered != nil {
+			vars["answered"] = *opts.Answered
+		}
+	}
+	vars = cursor.cursorVariables(vars)
+	resp, err := s.client.GraphQL(ctx, query, vars, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	conn := data.Repository.Discussions
+	setPageInfo(resp, conn.PageInfo)
+	discussions := make([]*Discussion, 0, len(conn.Nodes))
+	for _, n := range conn.Nodes {
+		discussions = append(discussions, n.discussion())
+	}
+
+	return discussions, resp, nil
+}
+
+// Get gets a discussion of a repository by number.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) Get(ctx context.Context, owner, repo string, number int) (*Discussion, *Response, error) {
+	const query = `query($owner: String!, $repo: String!, $number: Int!) {
+	repository(owner: $owner, name: $repo) {
+		discussion(number: $number) { ...discussionFields }
+	}
+}` + discussionFragments
+	var data struct {
+		Repository struct {
+			Discussion *discussionNode `json:"discussion"`
+		} `json:"repository"`
+	}
+	vars := map[string]any{"owner": owner, "repo": repo, "number": number}
+	resp, err := s.client.GraphQL(ctx, query, vars, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.Repository.Discussion.discussion(), resp, nil
+}
+
+// Create creates a discussion in a repository. Title, Body and CategoryID
+// are required.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) Create(ctx context.Context, owner, repo string, discussion *DiscussionRequest) (*Discussion, *Response, error) {
+	if discussion == nil {
+		return nil, nil, errors.New("discussion must be provided")
+	}
+
+	const repoQuery = `query($owner: String!, $repo: String!) {
+	repository(owner: $owner, name: $repo) { id }
+}`
+	var repoData struct {
+		Repository struct {
+			ID string `json:"id"`
+		} `json:"repository"`
+	}
+	resp, err := s.client.GraphQL(ctx, repoQuery, map[string]any{"owner": owner, "repo": repo}, &repoData)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	const mutation = `mutation($input: CreateDiscussionInput!) {
+	createDiscussion(input: $input) { discussion { ...discussionFields } }
+}` + discussionFragments
+	var data struct {
+		CreateDiscussion struct {
+			Discussion *discussionNode `json:"discussion"`
+		} `json:"createDiscussion"`
+	}
+	input := map[string]any{
+		"repositoryId": repoData.Repository.ID,
+		"title":        discussion.GetTitle(),
+		"body":         discussion.GetBody(),
+		"categoryId":   discussion.GetCategoryID(),
+	}
+	resp, err = s.client.GraphQL(ctx, mutation, map[string]any{"input": input}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.CreateDiscussion.Discussion.discussion(), resp, nil
+}
+
+// Edit edits a discussion of a repository.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) Edit(ctx context.Context, owner, repo string, number int, discussion *DiscussionRequest) (*Discussion, *Response, error) {
+	if discussion == nil {
+		return nil, nil, errors.New("discussion must be provided")
+	}
+
+	id, resp, err := s.nodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	const mutation = `mutation($input: UpdateDiscussionInput!) {
+	updateDiscussion(input: $input) { discussion { ...discussionFields } }
+}` + discussionFragments
+	var data struct {
+		UpdateDiscussion struct {
+			Discussion *discussionNode `json:"discussion"`
+		} `json:"updateDiscussion"`
+	}
+	input := map[string]any{"discussionId": id}
+	if discussion.Title != nil {
+		input["title"] = *discussion.Title
+	}
+	if discussion.Body != nil {
+		input["body"] = *discussion.Body
+	}
+	if discussion.CategoryID != nil {
+		input["categoryId"] = *discussion.CategoryID
+	}
+	resp, err = s.client.GraphQL(ctx, mutation, map[string]any{"input": input}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.UpdateDiscussion.Discussion.discussion(), resp, nil
+}
+
+// Delete deletes a discussion of a repository.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) Delete(ctx context.Context, owner, repo string, number int) (*Response, error) {
+	id, resp, err := s.nodeID(ctx, owner, repo, number)
+	if err != nil {
+		return resp, err
+	}
+
+	const mutation = `mutation($id: ID!) {
+	deleteDiscussion(input: {id: $id}) { clientMutationId }
+}`
+	return s.client.GraphQL(ctx, mutation, map[string]any{"id": id}, nil)
+}
+
+// Lock locks a discussion of a repository.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) Lock(ctx context.Context, owner, repo string, number int, opts *LockIssueOptions) (*Response, error) {
+	var reason string
+	if opts != nil && opts.LockReason != "" {
+		var ok bool
+		if reason, ok = discussionLockReasons[opts.LockReason]; !ok {
+			return nil, fmt.Errorf("unknown lock reason %q", opts.LockReason)
+		}
+	}
+
+	id, resp, err := s.nodeID(ctx, owner, repo, number)
+	if err != nil {
+		return resp, err
+	}
+
+	const mutation = `mutation($input: LockLockableInput!) {
+	lockLockable(input: $input) { clientMutationId }
+}`
+	input := map[string]any{"lockableId": id}
+	if reason != "" {
+		input["lockReason"] = reason
+	}
+	return s.client.GraphQL(ctx, mutation, map[string]any{"input": input}, nil)
+}
+
+// Unlock unlocks a discussion of a repository.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) Unlock(ctx context.Context, owner, repo string, number int) (*Response, error) {
+	id, resp, err := s.nodeID(ctx, owner, repo, number)
+	if err != nil {
+		return resp, err
+	}
+
+	const mutation = `mutation($id: ID!) {
+	unlockLockable(input: {lockableId: $id}) { clientMutationId }
+}`
+	return s.client.GraphQL(ctx, mutation, map[string]any{"id": id}, nil)
+}
+
+// ListComments lists the top-level comments of a discussion. Use
+// ListCommentReplies to list the replies to a comment.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) ListComments(ctx context.Context, owner, repo string, number int, opts *DiscussionCursorOptions) ([]*CommentDiscussion, *Response, error) {
+	const query = `query($owner: String!, $repo: String!, $number: Int!, $first: Int!, $after: String) {
+	repository(owner: $owner, name: $repo) {
+		discussion(number: $number) {
+			comments(first: $first, after: $after) {
+				pageInfo { hasNextPage endCursor }
+				nodes { ...commentFields }
+			}
+		}
+	}
+}` + commentFragments
+	var data struct {
+		Repository struct {
+			Discussion struct {
+				Comments struct {
+					PageInfo graphQLPageInfo          `json:"pageInfo"`
+					Nodes    []*discussionCommentNode `json:"nodes"`
+				} `json:"comments"`
+			} `json:"discussion"`
+		} `json:"repository"`
+	}
+	vars := opts.cursorVariables(map[string]any{"owner": owner, "repo": repo, "number": number})
+	resp, err := s.client.GraphQL(ctx, query, vars, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	conn := data.Repository.Discussion.Comments
+	setPageInfo(resp, conn.PageInfo)
+	comments := make([]*CommentDiscussion, 0, len(conn.Nodes))
+	for _, n := range conn.Nodes {
+		comments = append(comments, n.comment())
+	}
+
+	return comments, resp, nil
+}
+
+// ListCommentReplies lists the replies to a discussion comment, given its
+// node ID.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) ListCommentReplies(ctx context.Context, commentNodeID string, opts *DiscussionCursorOptions) ([]*CommentDiscussion, *Response, error) {
+	const query = `query($id: ID!, $first: Int!, $after: String) {
+	node(id: $id) {
+		... on DiscussionComment {
+			replies(first: $first, after: $after) {
+				pageInfo { hasNextPage endCursor }
+				nodes { ...commentFields }
+			}
+		}
+	}
+}` + commentFragments
+	var data struct {
+		Node struct {
+			Replies struct {
+				PageInfo graphQLPageInfo          `json:"pageInfo"`
+				Nodes    []*discussionCommentNode `json:"nodes"`
+			} `json:"replies"`
+		} `json:"node"`
+	}
+	vars := opts.cursorVariables(map[string]any{"id": commentNodeID})
+	resp, err := s.client.GraphQL(ctx, query, vars, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	conn := data.Node.Replies
+	setPageInfo(resp, conn.PageInfo)
+	comments := make([]*CommentDiscussion, 0, len(conn.Nodes))
+	for _, n := range conn.Nodes {
+		comments = append(comments, n.comment())
+	}
+
+	return comments, resp, nil
+}
+
+// CreateComment creates a comment on a discussion, or a reply to a comment
+// if ReplyToID is set.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) CreateComment(ctx context.Context, owner, repo string, number int, comment *DiscussionCommentRequest) (*CommentDiscussion, *Response, error) {
+	if comment == nil {
+		return nil, nil, errors.New("comment must be provided")
+	}
+
+	id, resp, err := s.nodeID(ctx, owner, repo, number)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	const mutation = `mutation($input: AddDiscussionCommentInput!) {
+	addDiscussionComment(input: $input) { comment { ...commentFields } }
+}` + commentFragments
+	var data struct {
+		AddDiscussionComment struct {
+			Comment *discussionCommentNode `json:"comment"`
+		} `json:"addDiscussionComment"`
+	}
+	input := map[string]any{"discussionId": id, "body": comment.GetBody()}
+	if comment.ReplyToID != nil {
+		input["replyToId"] = *comment.ReplyToID
+	}
+	resp, err = s.client.GraphQL(ctx, mutation, map[string]any{"input": input}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.AddDiscussionComment.Comment.comment(), resp, nil
+}
+
+// EditComment edits the body of a discussion comment, given its node ID.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) EditComment(ctx context.Context, commentNodeID, body string) (*CommentDiscussion, *Response, error) {
+	const mutation = `mutation($id: ID!, $body: String!) {
+	updateDiscussionComment(input: {commentId: $id, body: $body}) { comment { ...commentFields } }
+}` + commentFragments
+	var data struct {
+		UpdateDiscussionComment struct {
+			Comment *discussionCommentNode `json:"comment"`
+		} `json:"updateDiscussionComment"`
+	}
+	resp, err := s.client.GraphQL(ctx, mutation, map[string]any{"id": commentNodeID, "body": body}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.UpdateDiscussionComment.Comment.comment(), resp, nil
+}
+
+// DeleteComment deletes a discussion comment, given its node ID.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) DeleteComment(ctx context.Context, commentNodeID string) (*Response, error) {
+	const mutation = `mutation($id: ID!) {
+	deleteDiscussionComment(input: {id: $id}) { clientMutationId }
+}`
+	return s.client.GraphQL(ctx, mutation, map[string]any{"id": commentNodeID}, nil)
+}
+
+// MarkCommentAsAnswer marks a discussion comment, given its node ID, as the
+// answer to its discussion. The discussion must be in a category that
+// accepts answers.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) MarkCommentAsAnswer(ctx context.Context, commentNodeID string) (*Discussion, *Response, error) {
+	const mutation = `mutation($id: ID!) {
+	markDiscussionCommentAsAnswer(input: {id: $id}) { discussion { ...discussionFields } }
+}` + discussionFragments
+	var data struct {
+		MarkDiscussionCommentAsAnswer struct {
+			Discussion *discussionNode `json:"discussion"`
+		} `json:"markDiscussionCommentAsAnswer"`
+	}
+	resp, err := s.client.GraphQL(ctx, mutation, map[string]any{"id": commentNodeID}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.MarkDiscussionCommentAsAnswer.Discussion.discussion(), resp, nil
+}
+
+// UnmarkCommentAsAnswer unmarks a discussion comment, given its node ID, as
+// the answer to its discussion.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) UnmarkCommentAsAnswer(ctx context.Context, commentNodeID string) (*Discussion, *Response, error) {
+	const mutation = `mutation($id: ID!) {
+	unmarkDiscussionCommentAsAnswer(input: {id: $id}) { discussion { ...discussionFields } }
+}` + discussionFragments
+	var data struct {
+		UnmarkDiscussionCommentAsAnswer struct {
+			Discussion *discussionNode `json:"discussion"`
+		} `json:"unmarkDiscussionCommentAsAnswer"`
+	}
+	resp, err := s.client.GraphQL(ctx, mutation, map[string]any{"id": commentNodeID}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.UnmarkDiscussionCommentAsAnswer.Discussion.discussion(), resp, nil
+}
+
+// VotePoll votes for an option of a discussion poll, given the node ID of
+// the option, and returns the updated poll.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) VotePoll(ctx context.Context, optionNodeID string) (*DiscussionPoll, *Response, error) {
+	const mutation = `mutation($id: ID!) {
+	addDiscussionPollVote(input: {pollOptionId: $id}) { pollOption { poll { ...pollFields } } }
+}` + pollFragment
+	var data struct {
+		AddDiscussionPollVote struct {
+			PollOption struct {
+				Poll *discussionPollNode `json:"poll"`
+			} `json:"pollOption"`
+		} `json:"addDiscussionPollVote"`
+	}
+	resp, err := s.client.GraphQL(ctx, mutation, map[string]any{"id": optionNodeID}, &data)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return data.AddDiscussionPollVote.PollOption.Poll.poll(), resp, nil
+}
@@ -0,0 +1,578 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// handleDiscussionNodeID responds to the lookup of the node ID of discussion
+// o/r#1 and returns the request for any other query.
+func handleDiscussionNodeID(t *testing.T, w http.ResponseWriter, r *http.Request) *graphQLRequest {
+	t.Helper()
+	req := decodeGraphQLRequest(t, r)
+	if strings.Contains(req.Query, "discussion(number: $number) { id }") {
+		want := map[string]any{"owner": "o", "repo": "r", "number": float64(1)}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"discussion":{"id":"D_1"}}}}`)
+		return nil
+	}
+	return req
+}
+
+func TestDiscussionsService_List(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		want := map[string]any{
+			"owner":      "o",
+			"repo":       "r",
+			"first":      float64(2),
+			"after":      "c1",
+			"categoryId": "DC_1",
+			"states":     []any{"OPEN"},
+			"answered":   false,
+		}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"discussions":{
+			"pageInfo":{"hasNextPage":true,"endCursor":"c2"},
+			"nodes":[{"databaseId":1,"number":2,"closed":false}]
+		}}}}`)
+	})
+
+	ctx := t.Context()
+	opts := &RepositoryDiscussionListOptions{
+		CategoryID:              "DC_1",
+		State:                   "open",
+		Answered:                Ptr(false),
+		DiscussionCursorOptions: DiscussionCursorOptions{PerPage: 2, After: "c1"},
+	}
+	discussions, resp, err := client.Discussions.List(ctx, "o", "r", opts)
+	if err != nil {
+		t.Fatalf("Discussions.List returned error: %v", err)
+	}
+
+	want := []*Discussion{{ID: Ptr(int64(1)), Number: Ptr(2), State: Ptr("open")}}
+	if !cmp.Equal(discussions, want) {
+		t.Errorf("Discussions.List returned %+v, want %+v", discussions, want)
+	}
+	if want := "c2"; resp.After != want {
+		t.Errorf("Discussions.List returned After %q, want %q", resp.After, want)
+	}
+
+	const methodName = "List"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Discussions.List(ctx, "o", "r", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDiscussionsService_ListIter(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		switch req.Variables["after"] {
+		case nil:
+			fmt.Fprint(w, `{"data":{"repository":{"discussions":{
+				"pageInfo":{"hasNextPage":true,"endCursor":"c"},
+				"nodes":[{"number":1}]
+			}}}}`)
+		case "c":
+			fmt.Fprint(w, `{"data":{"repository":{"discussions":{
+				"pageInfo":{"hasNextPage":false,"endCursor":"d"},
+				"nodes":[{"number":2}]
+			}}}}`)
+		}
+	})
+
+	ctx := t.Context()
+	var got []int
+	for d, err := range client.Discussions.ListIter(ctx, "o", "r", nil) {
+		if err != nil {
+			t.Fatalf("Discussions.ListIter returned error: %v", err)
+		}
+		got = append(got, d.GetNumber())
+	}
+	if want := []int{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("Discussions.ListIter returned %v, want %v", got, want)
+	}
+}
+
+func TestDiscussionsService_Get(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		want := map[string]any{"owner": "o", "repo": "r", "number": float64(1)}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"discussion":{
+			"databaseId": 10,
+			"id": "D_1",
+			"number": 1,
+			"title": "t",
+			"body": "b",
+			"url": "https://github.com/o/r/discussions/1",
+			"closed": true,
+			"locked": true,
+			"activeLockReason": "TOO_HEATED",
+			"authorAssociation": "OWNER",
+			"createdAt": "2025-01-02T03:04:05Z",
+			"answerChosenAt": "2025-01-03T03:04:05Z",
+			"author": {"__typename": "User", "login": "u", "databaseId": 5, "id": "U_5"},
+			"answerChosenBy": {"login": "m"},
+			"answer": {"url": "https://github.com/o/r/discussions/1#discussioncomment-3"},
+			"category": {"id": "DC_1", "name": "Q&A", "slug": "q-a", "isAnswerable": true, "repository": {"databaseId": 7}},
+			"comments": {"totalCount": 4},
+			"poll": {"id": "P_1", "question": "q", "totalVoteCount": 3, "options": {"nodes": [{"id": "PO_1", "option": "yes", "totalVoteCount": 3}]}}
+		}}}}`)
+	})
+
+	ctx := t.Context()
+	discussion, _, err := client.Discussions.Get(ctx, "o", "r", 1)
+	if err != nil {
+		t.Fatalf("Discussions.Get returned error: %v", err)
+	}
+
+	want := &Discussion{
+		ID:                Ptr(int64(10)),
+		NodeID:            Ptr("D_1"),
+		Number:            Ptr(1),
+		Title:             Ptr("t"),
+		Body:              Ptr("b"),
+		HTMLURL:           Ptr("https://github.com/o/r/discussions/1"),
+		State:             Ptr("closed"),
+		Locked:            Ptr(true),
+		ActiveLockReason:  Ptr("too heated"),
+		AuthorAssociation: Ptr("OWNER"),
+		CreatedAt:         &Timestamp{time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC)},
+		AnswerChosenAt:    &Timestamp{time.Date(2025, time.January, 3, 3, 4, 5, 0, time.UTC)},
+		User:              &User{Login: Ptr("u"), ID: Ptr(int64(5)), NodeID: Ptr("U_5"), Type: Ptr("User")},
+		AnswerChosenBy:    Ptr("m"),
+		AnswerHTMLURL:     Ptr("https://github.com/o/r/discussions/1#discussioncomment-3"),
+		DiscussionCategory: &DiscussionCategory{
+			NodeID:       Ptr("DC_1"),
+			Name:         Ptr("Q&A"),
+			Slug:         Ptr("q-a"),
+			IsAnswerable: Ptr(true),
+			RepositoryID: Ptr(int64(7)),
+		},
+		Comments: Ptr(4),
+		Poll: &DiscussionPoll{
+			NodeID:         Ptr("P_1"),
+			Question:       Ptr("q"),
+			TotalVoteCount: Ptr(3),
+			Options:        []*DiscussionPollOption{{NodeID: Ptr("PO_1"), Option: Ptr("yes"), TotalVoteCount: Ptr(3)}},
+		},
+	}
+	if !cmp.Equal(discussion, want) {
+		t.Errorf("Discussions.Get returned %+v, want %+v", discussion, want)
+	}
+}
+
+func TestDiscussionsService_Create(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		if strings.Contains(req.Query, "repository(owner: $owner, name: $repo) { id }") {
+			fmt.Fprint(w, `{"data":{"repository":{"id":"R_1"}}}`)
+			return
+		}
+		want := map[string]any{"input": map[string]any{
+			"repositoryId": "R_1",
+			"categoryId":   "DC_1",
+			"title":        "t",
+			"body":         "b",
+		}}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"createDiscussion":{"discussion":{"number":3}}}}`)
+	})
+
+	ctx := t.Context()
+	input := &DiscussionRequest{Title: Ptr("t"), Body: Ptr("b"), CategoryID: Ptr("DC_1")}
+	discussion, _, err := client.Discussions.Create(ctx, "o", "r", input)
+	if err != nil {
+		t.Fatalf("Discussions.Create returned error: %v", err)
+	}
+
+	if want := (&Discussion{Number: Ptr(3)}); !cmp.Equal(discussion, want) {
+		t.Errorf("Discussions.Create returned %+v, want %+v", discussion, want)
+	}
+	if _, _, err := client.Discussions.Create(ctx, "o", "r", nil); err == nil {
+		t.Error("Discussions.Create with nil discussion returned nil error")
+	}
+
+	const methodName = "Create"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Discussions.Create(ctx, "o", "r", input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestDiscussionsService_Edit(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := handleDiscussionNodeID(t, w, r)
+		if req == nil {
+			return
+		}
+		want := map[string]any{"input": map[string]any{"discussionId": "D_1", "title": "new"}}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"updateDiscussion":{"discussion":{"title":"new"}}}}`)
+	})
+
+	ctx := t.Context()
+	discussion, _, err := client.Discussions.Edit(ctx, "o", "r", 1, &DiscussionRequest{Title: Ptr("new")})
+	if err != nil {
+		t.Fatalf("Discussions.Edit returned error: %v", err)
+	}
+
+	if want := (&Discussion{Title: Ptr("new")}); !cmp.Equal(discussion, want) {
+		t.Errorf("Discussions.Edit returned %+v, want %+v", discussion, want)
+	}
+
+	if _, _, err := client.Discussions.Edit(ctx, "o", "r", 1, nil); err == nil {
+		t.Error("Discussions.Edit with nil discussion returned nil error")
+	}
+}
+
+func TestDiscussionsService_Delete(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var deleted bool
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := handleDiscussionNodeID(t, w, r)
+		if req == nil {
+			return
+		}
+		if want := map[string]any{"id": "D_1"}; !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		deleted = strings.Contains(req.Query, "deleteDiscussion")
+		fmt.Fprint(w, `{"data":{"deleteDiscussion":{"clientMutationId":null}}}`)
+	})
+
+	ctx := t.Context()
+	if _, err := client.Discussions.Delete(ctx, "o", "r", 1); err != nil {
+		t.Fatalf("Discussions.Delete returned error: %v", err)
+	}
+	if !deleted {
+		t.Error("Discussions.Delete did not send deleteDiscussion mutation")
+	}
+}
+
+func TestDiscussionsService_Lock(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := handleDiscussionNodeID(t, w, r)
+		if req == nil {
+			return
+		}
+		want := map[string]any{"input": map[string]any{"lockableId": "D_1", "lockReason": "OFF_TOPIC"}}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"lockLockable":{"clientMutationId":null}}}`)
+	})
+
+	ctx := t.Context()
+	if _, err := client.Discussions.Lock(ctx, "o", "r", 1, &LockIssueOptions{LockReason: "off-topic"}); err != nil {
+		t.Fatalf("Discussions.Lock returned error: %v", err)
+	}
+
+	if _, err := client.Discussions.Lock(ctx, "o", "r", 1, &LockIssueOptions{LockReason: "bored"}); err == nil {
+		t.Error("Discussions.Lock with unknown reason returned nil error")
+	}
+}
+
+func TestDiscussionsService_Unlock(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := handleDiscussionNodeID(t, w, r)
+		if req == nil {
+			return
+		}
+		if want := map[string]any{"id": "D_1"}; !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"unlockLockable":{"clientMutationId":null}}}`)
+	})
+
+	ctx := t.Context()
+	if _, err := client.Discussions.Unlock(ctx, "o", "r", 1); err != nil {
+		t.Fatalf("Discussions.Unlock returned error: %v", err)
+	}
+}
+
+func TestDiscussionsService_ListCategories(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		if want := map[string]any{"owner": "o", "repo": "r", "first": float64(30)}; !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		if strings.Contains(req.Query, "fragment discussionFields") {
+			t.Error("query defines unused fragments")
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"discussionCategories":{"nodes":[{"id":"DC_1","name":"General"}]}}}}`)
+	})
+
+	ctx := t.Context()
+	categories, resp, err := client.Discussions.ListCategories(ctx, "o", "r", nil)
+	if err != nil {
+		t.Fatalf("Discussions.ListCategories returned error: %v", err)
+	}
+
+	want := []*DiscussionCategory{{NodeID: Ptr("DC_1"), Name: Ptr("General")}}
+	if !cmp.Equal(categories, want) {
+		t.Errorf("Discussions.ListCategories returned %+v, want %+v", categories, want)
+	}
+	if resp.After != "" {
+		t.Errorf("Discussions.ListCategories returned After %q, want empty", resp.After)
+	}
+}
+
+func TestDiscussionsService_ListComments(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		want := map[string]any{"owner": "o", "repo": "r", "number": float64(1), "first": float64(30)}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"repository":{"discussion":{"comments":{"nodes":[{
+			"databaseId": 3,
+			"id": "DC_3",
+			"body": "b",
+			"author": {"__typename": "Bot", "login": "bot", "databaseId": 9, "id": "B_9"},
+			"discussion": {"databaseId": 10},
+			"replies": {"totalCount": 2}
+		}]}}}}}`)
+	})
+
+	ctx := t.Context()
+	comments, _, err := client.Discussions.ListComments(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Discussions.ListComments returned error: %v", err)
+	}
+
+	want := []*CommentDiscussion{{
+		ID:                Ptr(int64(3)),
+		NodeID:            Ptr("DC_3"),
+		Body:              Ptr("b"),
+		User:              &User{Login: Ptr("bot"), ID: Ptr(int64(9)), NodeID: Ptr("B_9"), Type: Ptr("Bot")},
+		DiscussionID:      Ptr(int64(10)),
+		ChildCommentCount: Ptr(2),
+	}}
+	if !cmp.Equal(comments, want) {
+		t.Errorf("Discussions.ListComments returned %+v, want %+v", comments, want)
+	}
+}
+
+func TestDiscussionsService_ListCommentReplies(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		if want := map[string]any{"id": "DC_3", "first": float64(30)}; !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"node":{"replies":{"nodes":[{"databaseId":4,"replyTo":{"databaseId":3}}]}}}}`)
+	})
+
+	ctx := t.Context()
+	comments, _, err := client.Discussions.ListCommentReplies(ctx, "DC_3", nil)
+	if err != nil {
+		t.Fatalf("Discussions.ListCommentReplies returned error: %v", err)
+	}
+
+	want := []*CommentDiscussion{{ID: Ptr(int64(4)), ParentID: Ptr(int64(3))}}
+	if !cmp.Equal(comments, want) {
+		t.Errorf("Discussions.ListCommentReplies returned %+v, want %+v", comments, want)
+	}
+}
+
+func TestDiscussionsService_CreateComment(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := handleDiscussionNodeID(t, w, r)
+		if req == nil {
+			return
+		}
+		want := map[string]any{"input": map[string]any{"discussionId": "D_1", "body": "b", "replyToId": "DC_3"}}
+		if !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"addDiscussionComment":{"comment":{"databaseId":4}}}}`)
+	})
+
+	ctx := t.Context()
+	input := &DiscussionCommentRequest{Body: Ptr("b"), ReplyToID: Ptr("DC_3")}
+	comment, _, err := client.Discussions.CreateComment(ctx, "o", "r", 1, input)
+	if err != nil {
+		t.Fatalf("Discussions.CreateComment returned error: %v", err)
+	}
+
+	if want := (&CommentDiscussion{ID: Ptr(int64(4))}); !cmp.Equal(comment, want) {
+		t.Errorf("Discussions.CreateComment returned %+v, want %+v", comment, want)
+	}
+
+	if _, _, err := client.Discussions.CreateComment(ctx, "o", "r", 1, nil); err == nil {
+		t.Error("Discussions.CreateComment with nil comment returned nil error")
+	}
+}
+
+func TestDiscussionsService_EditComment(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		if want := map[string]any{"id": "DC_3", "body": "b"}; !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"updateDiscussionComment":{"comment":{"body":"b"}}}}`)
+	})
+
+	ctx := t.Context()
+	comment, _, err := client.Discussions.EditComment(ctx, "DC_3", "b")
+	if err != nil {
+		t.Fatalf("Discussions.EditComment returned error: %v", err)
+	}
+
+	if want := (&CommentDiscussion{Body: Ptr("b")}); !cmp.Equal(comment, want) {
+		t.Errorf("Discussions.EditComment returned %+v, want %+v", comment, want)
+	}
+}
+
+func TestDiscussionsService_DeleteComment(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		if want := map[string]any{"id": "DC_3"}; !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"deleteDiscussionComment":{"clientMutationId":null}}}`)
+	})
+
+	ctx := t.Context()
+	if _, err := client.Discussions.DeleteComment(ctx, "DC_3"); err != nil {
+		t.Fatalf("Discussions.DeleteComment returned error: %v", err)
+	}
+}
+
+func TestDiscussionsService_MarkCommentAsAnswer(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		if !strings.Contains(req.Query, "markDiscussionCommentAsAnswer") || strings.Contains(req.Query, "unmark") {
+			t.Errorf("query = %q, want markDiscussionCommentAsAnswer mutation", req.Query)
+		}
+		fmt.Fprint(w, `{"data":{"markDiscussionCommentAsAnswer":{"discussion":{"answer":{"url":"u"}}}}}`)
+	})
+
+	ctx := t.Context()
+	discussion, _, err := client.Discussions.MarkCommentAsAnswer(ctx, "DC_3")
+	if err != nil {
+		t.Fatalf("Discussions.MarkCommentAsAnswer returned error: %v", err)
+	}
+
+	if want := (&Discussion{AnswerHTMLURL: Ptr("u")}); !cmp.Equal(discussion, want) {
+		t.Errorf("Discussions.MarkCommentAsAnswer returned %+v, want %+v", discussion, want)
+	}
+}
+
+func TestDiscussionsService_UnmarkCommentAsAnswer(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		if !strings.Contains(req.Query, "unmarkDiscussionCommentAsAnswer") {
+			t.Errorf("query = %q, want unmarkDiscussionCommentAsAnswer mutation", req.Query)
+		}
+		fmt.Fprint(w, `{"data":{"unmarkDiscussionCommentAsAnswer":{"discussion":{"number":1}}}}`)
+	})
+
+	ctx := t.Context()
+	discussion, _, err := client.Discussions.UnmarkCommentAsAnswer(ctx, "DC_3")
+	if err != nil {
+		t.Fatalf("Discussions.UnmarkCommentAsAnswer returned error: %v", err)
+	}
+
+	if want := (&Discussion{Number: Ptr(1)}); !cmp.Equal(discussion, want) {
+		t.Errorf("Discussions.UnmarkCommentAsAnswer returned %+v, want %+v", discussion, want)
+	}
+}
+
+func TestDiscussionsService_VotePoll(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		if want := map[string]any{"id": "PO_1"}; !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		fmt.Fprint(w, `{"data":{"addDiscussionPollVote":{"pollOption":{"poll":{"id":"P_1","totalVoteCount":1}}}}}`)
+	})
+
+	ctx := t.Context()
+	poll, _, err := client.Discussions.VotePoll(ctx, "PO_1")
+	if err != nil {
+		t.Fatalf("Discussions.VotePoll returned error: %v", err)
+	}
+
+	if want := (&DiscussionPoll{NodeID: Ptr("P_1"), TotalVoteCount: Ptr(1)}); !cmp.Equal(poll, want) {
+		t.Errorf("Discussions.VotePoll returned %+v, want %+v", poll, want)
+	}
+}
@@ -53,44 +53,37 @@ almost never be shared between different users.
 For API methods that require HTTP Basic Authentication, use the
 [BasicAuthTransport].
 
-GitHub Apps authentication can be provided by the
-https://github.com/bradleyfalzon/ghinstallation package.
-It supports both authentication as an installation, using an installation access token,
-and as an app, using a JWT.
+GitHub Apps authentication is provided by [AppTransport] and
+[InstallationTransport]. They support both authentication as an app, using a
+JWT, and as an installation, using an installation access token that is
+refreshed before it expires.
 
 To authenticate as an installation:
 
-	import "github.com/bradleyfalzon/ghinstallation"
-
 	func main() {
-		// Wrap the shared transport for use with the integration ID 1 authenticating with installation ID 99.
-		itr, err := ghinstallation.NewKeyFromFile(http.DefaultTransport, 1, 99, "2016-10-19.private-key.pem")
+		// Authenticate as the app with ID 1, then as its installation with ID 99.
+		key, err := os.ReadFile("2016-10-19.private-key.pem")
 		if err != nil {
 			// Handle error.
 		}
+		atr, err := github.NewAppTransport(1, key)
+		if err != nil {
+			// Handle error.
+		}
+		itr := github.NewInstallationTransport(atr, 99, nil)
 
 		// Use installation transport with client
-		client := github.NewClient(&http.Client{Transport: itr})
+		client := github.NewClient(itr.Client())
 
 		// Use client...
 	}
 
-To authenticate as an app, using a JWT:
-
-	import "github.com/bradleyfalzon/ghinstallation"
+To authenticate as an app, using a JWT, use the [AppTransport] directly:
 
-	func main() {
-		// Wrap the shared transport for use with the application ID 1.
-		atr, err := ghinstallation.NewAppsTransportKeyFromFile(http.DefaultTransport, 1, "2016-10-19.private-key.pem")
-		if err != nil {
-			// Handle error.
-		}
+	client := github.NewClient(atr.Client())
 
-		// Use app transport with client
-		client := github.NewClient(&http.Client{Transport: atr})
-
-		// Use client...
-	}
+Packages such as https://github.com/bradleyfalzon/ghinstallation provide
+alternative implementations.
 
 # Rate Limiting
 
@@ -118,6 +111,25 @@ For secondary rate limits, you can check if its type is *[AbuseRateLimitError]:
 		log.Println("hit secondary rate limit")
 	}
 
+Transient failures, such as 502 Bad Gateway responses, reset connections or
+secondary rate limits, can be retried automatically by setting a [RetryPolicy]:
+
+	client.RetryPolicy = &github.RetryPolicy{MaxAttempts: 4}
+
+Bulk jobs can avoid exhausting the rate limit in a burst by setting a
+[Throttle], which paces requests evenly until the rate limit resets, makes
+them serially and spaces out requests that modify resources. The remaining
+budget of each rate limit category is reported by [Client.RateBudget]:
+
+	client.Throttle = &github.Throttle{}
+	budget := client.RateBudget(github.CoreCategory)
+
+The rate limits of several tokens can be combined with a [TokenPoolTransport],
+which sends each request with the token that has the most remaining requests:
+
+	pool := github.NewTokenPoolTransport(token1, token2)
+	client := github.NewClient(pool.Client())
+
 Learn more about GitHub rate limiting at
 https://docs.github.com/rest/rate-limit .
 
@@ -140,12 +152,23 @@ To detect this condition of error, you can check if its type is
 
 The GitHub REST API has good support for conditional HTTP requests
 via the ETag header which will help prevent you from burning through your
-rate limit, as well as help speed up your application. go-github does not
-handle conditional requests directly, but is instead designed to work with a
-caching [http.Transport].
+rate limit, as well as help speed up your application. Set [Client.Cache]
+to have the client store responses and revalidate them with GitHub; a 304 Not
+Modified response does not count against the rate limit and is served from
+the cache. A cache must not be shared between clients using different
+credentials:
+
+	client := github.NewClient(nil)
+	client.Cache = github.NewMemoryCache() // or github.NewDiskCache(dir)
+
+	repo, resp, err := client.Repositories.Get(ctx, "o", "r")
+	if resp.CacheStatus == github.CacheStatusHit {
+		// repo was served from the cache.
+	}
 
-Typically, an RFC 9111 compliant HTTP cache such as https://github.com/bartventer/httpcache
-is recommended. Alternatively, the https://github.com/bored-engineer/github-conditional-http-transport
+go-github is also designed to work with a caching [http.Transport]. An RFC
+9111 compliant HTTP cache such as https://github.com/bartventer/httpcache
+can be used. Alternatively, the https://github.com/bored-engineer/github-conditional-http-transport
 package relies on (undocumented) GitHub specific cache logic and is
 recommended when making requests using short-lived credentials such as a
 GitHub App installation token.
@@ -196,5 +219,32 @@ embedded type of a more specific list options struct (for example
 		}
 		opt.Page = resp.NextPage
 	}
+
+Each paginated List method also has a companion method with an "Iter" suffix
+which returns an [iter.Seq2] that fetches every page of results on demand:
+
+	for repo, err := range client.Repositories.ListByOrgIter(ctx, "github", opt) {
+		if err != nil {
+			return err
+		}
+		allRepos = append(allRepos, repo)
+	}
+
+# GraphQL
+
+Features only available through the GraphQL API can be used with
+[Client.GraphQL], which shares the client's transport, authentication, base
+URL and rate limit tracking:
+
+	var data struct {
+		Viewer struct {
+			Login string `json:"login"`
+		} `json:"viewer"`
+	}
+	_, err := client.GraphQL(ctx, `query { viewer { login } }`, nil, &data)
+
+Errors reported in the response are returned as a [GraphQLErrorResponse].
+
+GitHub GraphQL API docs: https://docs.github.com/graphql
 */
 package github
@@ -418,6 +418,9 @@ type Discussion struct {
 	AuthorAssociation *string `json:"author_association,omitempty"`
 	ActiveLockReason  *string `json:"active_lock_reason,omitempty"`
 	Body              *string `json:"body,omitempty"`
+
+	// Poll is only populated by the DiscussionsService.
+	Poll *DiscussionPoll `json:"poll,omitempty"`
 }
 
 // DiscussionCategory represents a discussion category in a GitHub DiscussionEvent.
@@ -1127,6 +1130,7 @@ type ProjectV2 struct {
 	Number           *int       `json:"number,omitempty"`
 	ShortDescription *string    `json:"short_description,omitempty"`
 	DeletedBy        *User      `json:"deleted_by,omitempty"`
+	IsTemplate       *bool      `json:"is_template,omitempty"`
 
 	// Fields migrated from the Project (classic) struct:
 	URL                    *string `json:"url,omitempty"`
@@ -1179,15 +1183,24 @@ type FieldValue struct {
 
 // ProjectV2Item represents an item belonging to a project.
 type ProjectV2Item struct {
-	ID            *int64     `json:"id,omitempty"`
-	NodeID        *string    `json:"node_id,omitempty"`
-	ProjectNodeID *string    `json:"project_node_id,omitempty"`
-	ContentNodeID *string    `json:"content_node_id,omitempty"`
-	ContentType   *string    `json:"content_type,omitempty"`
-	Creator       *User      `json:"creator,omitempty"`
-	CreatedAt     *Timestamp `json:"created_at,omitempty"`
-	UpdatedAt     *Timestamp `json:"updated_at,omitempty"`
-	ArchivedAt    *Timestamp `json:"archived_at,omitempty"`
+	ID            *int64  `json:"id,omitempty"`
+	NodeID        *string `json:"node_id,omitempty"`
+	ProjectNodeID *string `json:"project_node_id,omitempty"`
+	ContentNodeID *string `json:"content_node_id,omitempty"`
+	// ContentType is one of "Issue", "PullRequest", or "DraftIssue".
+	ContentType *string    `json:"content_type,omitempty"`
+	Creator     *User      `json:"creator,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
+	ArchivedAt  *Timestamp `json:"archived_at,omitempty"`
+
+	// The following fields are only populated by the ProjectsService.
+	ProjectURL *string `json:"project_url,omitempty"`
+	ItemURL    *string `json:"item_url,omitempty"`
+	// Content is the JSON encoded issue, pull request or draft issue. Use
+	// ParseContent to decode it.
+	Content json.RawMessage            `json:"content,omitempty"`
+	Fields  []*ProjectV2ItemFieldValue `json:"fields,omitempty"`
 }
 
 // PublicEvent is triggered when a private repository is open sourced.
@@ -50,7 +50,8 @@ var (
 	}
 	// skipStructs lists structs to skip.
 	skipStructs = map[string]bool{
-		"Client": true,
+		"Client":                true,
+		"InstallationTransport": true,
 	}
 
 	// whitelistSliceGetters lists "struct.field" to add getter method
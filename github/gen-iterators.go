@@ -0,0 +1,482 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// gen-iterators generates iterator methods for paginated List methods.
+//
+// For every List method whose final parameter is a pointer to an options
+// struct supporting offset, cursor or "after" pagination, a companion
+// method with an "Iter" suffix is generated. It returns an iter.Seq2 that
+// transparently fetches every page of results.
+//
+// It is meant to be used by go-github contributors in conjunction with the
+// go generate tool before sending a PR to GitHub.
+// Please see the CONTRIBUTING.md file for more information.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+const (
+	fileName = "github-iterators.go"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+
+	// skipMethods lists "Service.Method" combos to skip.
+	skipMethods = map[string]bool{}
+
+	// docTailRE matches the trailing doc comment lines maintained by tools/metadata.
+	docTailRE = regexp.MustCompile(`^//(\s*GitHub API docs:|\s*Note: .* uses the undocumented GitHub API endpoint|meta:operation)`)
+)
+
+func logf(fmt string, args ...any) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	for pkgName, pkg := range pkgs {
+		t := &templateData{
+			Year:    2025,
+			Package: pkgName,
+			fset:    fset,
+			structs: map[string]*ast.StructType{},
+			methods: map[string]bool{},
+		}
+		for _, f := range pkg.Files {
+			t.collectTypes(f)
+		}
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			t.processAST(f)
+		}
+		if err := t.dump(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+func sourceFilter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != fileName
+}
+
+type templateData struct {
+	Year      int
+	Package   string
+	Iterators []*iterator
+
+	fset    *token.FileSet
+	structs map[string]*ast.StructType
+	methods map[string]bool // "Service.Method" of every existing method.
+}
+
+type iterator struct {
+	sortVal     string
+	Service     string
+	Method      string
+	Params      string // Parameter list of the generated method, without ctx.
+	Args        string // Arguments passed to Method, without ctx and opts.
+	OptsName    string
+	OptsType    string
+	ElemType    string
+	ZeroValue   string
+	ResultField string // If non-empty, the items are in this field of the result struct.
+	DocTail     []string
+
+	// Paths of the pagination fields within the options struct.
+	PagePath   string // int page number.
+	SincePath  string // int64 "since" value.
+	TokenPath  string // string page token.
+	AfterPath  string
+	CursorPath string
+}
+
+// collectTypes records all struct types and methods declared in f.
+func (t *templateData) collectTypes(f *ast.File) {
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				if st, ok := ts.Type.(*ast.StructType); ok {
+					t.structs[ts.Name.Name] = st
+				}
+			}
+		case *ast.FuncDecl:
+			if recv := receiverName(decl); recv != "" {
+				t.methods[recv+"."+decl.Name.Name] = true
+			}
+		}
+	}
+}
+
+func receiverName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return ""
+	}
+	se, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+	id, ok := se.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return id.Name
+}
+
+func (t *templateData) processAST(f *ast.File) {
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		service := receiverName(fn)
+		if !strings.HasSuffix(service, "Service") || !ast.IsExported(service) {
+			continue
+		}
+		name := fn.Name.Name
+		if !strings.HasPrefix(name, "List") || strings.HasSuffix(name, "Iter") {
+			continue
+		}
+		key := service + "." + name
+		if skipMethods[key] {
+			logf("Method %v is in skip list; skipping.", key)
+			continue
+		}
+		if t.methods[key+"Iter"] {
+			logf("Method %vIter already exists; skipping.", key)
+			continue
+		}
+		it := t.newIterator(service, fn)
+		if it == nil {
+			continue
+		}
+		t.Iterators = append(t.Iterators, it)
+	}
+}
+
+// newIterator returns the iterator description for fn or nil if fn does not
+// support pagination.
+func (t *templateData) newIterator(service string, fn *ast.FuncDecl) *iterator {
+	key := service + "." + fn.Name.Name
+	params := fn.Type.Params.List
+	if len(params) < 2 || fn.Type.Results == nil || len(fn.Type.Results.List) != 3 {
+		logf("%v: unsupported signature; skipping.", key)
+		return nil
+	}
+	if t.expr(params[0].Type) != "context.Context" {
+		logf("%v: first parameter is not a context; skipping.", key)
+		return nil
+	}
+
+	last := params[len(params)-1]
+	if len(last.Names) != 1 {
+		logf("%v: options parameter is grouped; skipping.", key)
+		return nil
+	}
+	optsStar, ok := last.Type.(*ast.StarExpr)
+	if !ok {
+		logf("%v: last parameter is not a pointer; skipping.", key)
+		return nil
+	}
+	optsIdent, ok := optsStar.X.(*ast.Ident)
+	if !ok {
+		logf("%v: last parameter is not a local type; skipping.", key)
+		return nil
+	}
+
+	it := &iterator{
+		sortVal:  key,
+		Service:  service,
+		Method:   fn.Name.Name,
+		OptsName: last.Names[0].Name,
+		OptsType: optsIdent.Name,
+	}
+	t.findPagination(it, optsIdent.Name, "")
+	if it.PagePath == "" && it.SincePath == "" && it.TokenPath == "" && it.AfterPath == "" && it.CursorPath == "" {
+		logf("%v: options type %v does not support pagination; skipping.", key, optsIdent.Name)
+		return nil
+	}
+
+	if !t.findElemType(it, fn.Type.Results.List[0].Type) {
+		logf("%v: unsupported result type; skipping.", key)
+		return nil
+	}
+
+	var ps, args []string
+	for _, p := range params[1:] {
+		var names []string
+		for _, n := range p.Names {
+			names = append(names, n.Name)
+		}
+		ps = append(ps, strings.Join(names, ", ")+" "+t.expr(p.Type))
+		if p != last {
+			args = append(args, names...)
+		}
+	}
+	it.Params = strings.Join(ps, ", ")
+	if len(args) > 0 {
+		it.Args = strings.Join(args, ", ") + ", "
+	}
+
+	if fn.Doc != nil {
+		lines := strings.Split(strings.TrimSpace(commentText(fn.Doc)), "\n")
+		start := len(lines)
+		for i := len(lines) - 1; i >= 0; i-- {
+			if docTailRE.MatchString(lines[i]) || lines[i] == "//" {
+				start = i
+				continue
+			}
+			break
+		}
+		for _, line := range lines[start:] {
+			if line != "//" {
+				it.DocTail = append(it.DocTail, line)
+			}
+		}
+	}
+	return it
+}
+
+func commentText(cg *ast.CommentGroup) string {
+	var lines []string
+	for _, c := range cg.List {
+		lines = append(lines, c.Text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// findPagination fills in the pagination field paths of it by walking the
+// fields of the struct named typeName, including embedded structs.
+func (t *templateData) findPagination(it *iterator, typeName, prefix string) {
+	st, ok := t.structs[typeName]
+	if !ok {
+		return
+	}
+	for _, field := range st.Fields.List {
+		typ := t.expr(field.Type)
+		if len(field.Names) == 0 {
+			// Embedded struct.
+			if id, ok := field.Type.(*ast.Ident); ok {
+				t.findPagination(it, id.Name, prefix+id.Name+".")
+			}
+			continue
+		}
+		for _, n := range field.Names {
+			if n.Name == "ListOptions" || n.Name == "ListCursorOptions" {
+				t.findPagination(it, typ, prefix+n.Name+".")
+				continue
+			}
+			path := prefix + n.Name
+			switch {
+			case n.Name == "Page" && typ == "int" && it.PagePath == "":
+				it.PagePath = path
+			case n.Name == "Page" && typ == "string" && it.TokenPath == "":
+				it.TokenPath = path
+			case n.Name == "Since" && typ == "int64" && it.SincePath == "":
+				it.SincePath = path
+			case n.Name == "After" && typ == "string" && it.AfterPath == "":
+				it.AfterPath = path
+			case n.Name == "Cursor" && typ == "string" && it.CursorPath == "":
+				it.CursorPath = path
+			}
+		}
+	}
+}
+
+// findElemType determines the type of the items returned by a List method.
+// The method must either return a slice or a pointer to a struct holding
+// exactly one slice field.
+func (t *templateData) findElemType(it *iterator, result ast.Expr) bool {
+	switch x := result.(type) {
+	case *ast.ArrayType:
+		it.ElemType = t.expr(x.Elt)
+	case *ast.StarExpr:
+		id, ok := x.X.(*ast.Ident)
+		if !ok {
+			return false
+		}
+		st, ok := t.structs[id.Name]
+		if !ok {
+			return false
+		}
+		var slices []*ast.Field
+		for _, field := range st.Fields.List {
+			if _, ok := field.Type.(*ast.ArrayType); ok && len(field.Names) == 1 && field.Names[0].IsExported() {
+				slices = append(slices, field)
+			}
+		}
+		if len(slices) != 1 {
+			return false
+		}
+		it.ResultField = slices[0].Names[0].Name
+		it.ElemType = t.expr(slices[0].Type.(*ast.ArrayType).Elt)
+	default:
+		return false
+	}
+
+	switch {
+	case strings.HasPrefix(it.ElemType, "*"), strings.HasPrefix(it.ElemType, "[]"), strings.HasPrefix(it.ElemType, "map["):
+		it.ZeroValue = "nil"
+	case it.ElemType == "string":
+		it.ZeroValue = `""`
+	default:
+		it.ZeroValue = it.ElemType + "{}"
+	}
+	return true
+}
+
+func (t *templateData) expr(e ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, t.fset, e); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+func (t *templateData) dump() error {
+	if len(t.Iterators) == 0 {
+		logf("No iterators for %v; skipping.", fileName)
+		return nil
+	}
+
+	slices.SortStableFunc(t.Iterators, func(a, b *iterator) int {
+		return strings.Compare(a.sortVal, b.sortVal)
+	})
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format.Source:\n%v\n%v", buf.String(), err)
+	}
+
+	logf("Writing %v...", fileName)
+	if err := os.Chmod(fileName, 0o644); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("os.Chmod(%q, 0644): %v", fileName, err)
+	}
+	if err := os.WriteFile(fileName, clean, 0o444); err != nil {
+		return err
+	}
+	if err := os.Chmod(fileName, 0o444); err != nil {
+		return fmt.Errorf("os.Chmod(%q, 0444): %v", fileName, err)
+	}
+	return nil
+}
+
+const source = `// Copyright {{.Year}} The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-iterators; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package {{.Package}}
+
+import (
+	"context"
+	"iter"
+)
+{{range .Iterators}}
+// {{.Method}}Iter returns an iterator that paginates through all results of {{.Method}}.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// {{.OptsName}} is copied and is not modified by the iterator.
+{{- if .DocTail}}
+//
+{{- range .DocTail}}
+{{.}}
+{{- end}}
+{{- end}}
+func (s *{{.Service}}) {{.Method}}Iter(ctx context.Context, {{.Params}}) iter.Seq2[{{.ElemType}}, error] {
+	return func(yield func({{.ElemType}}, error) bool) {
+		o := &{{.OptsType}}{}
+		if {{.OptsName}} != nil {
+			*o = *{{.OptsName}}
+		}
+		for {
+			result, resp, err := s.{{.Method}}(ctx, {{.Args}}o)
+			if err != nil {
+				yield({{.ZeroValue}}, err)
+				return
+			}
+			{{- if .ResultField}}
+			if result == nil {
+				return
+			}
+			for _, item := range result.{{.ResultField}} {
+			{{- else}}
+			for _, item := range result {
+			{{- end}}
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			{{- if .CursorPath}}
+			case resp.Cursor != "":
+				o.{{.CursorPath}} = resp.Cursor
+			{{- end}}
+			{{- if .AfterPath}}
+			case resp.After != "":
+				o.{{.AfterPath}} = resp.After
+			{{- end}}
+			{{- if .TokenPath}}
+			case resp.NextPageToken != "":
+				o.{{.TokenPath}} = resp.NextPageToken
+			{{- end}}
+			{{- if .SincePath}}
+			case resp.NextPage != 0:
+				o.{{.SincePath}} = int64(resp.NextPage)
+			{{- else if .PagePath}}
+			case resp.NextPage != 0:
+				o.{{.PagePath}} = resp.NextPage
+			{{- end}}
+			default:
+				return
+			}
+		}
+	}
+}
+{{end}}
+`
@@ -0,0 +1,159 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// gen-operations generates the list of the operations of the GitHub API.
+//
+// The operations are read from ../openapi_operations.yaml, which is
+// maintained by tools/metadata, and are used to find the operation of a
+// request, such as "GET /repos/{owner}/{repo}/issues".
+//
+// The yaml file is scanned line by line rather than parsed, since the github
+// package has no yaml dependency.
+//
+// It is meant to be used by go-github contributors in conjunction with the
+// go generate tool before sending a PR to GitHub.
+// Please see the CONTRIBUTING.md file for more information.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"slices"
+	"text/template"
+)
+
+const (
+	fileName       = "github-operations.go"
+	operationsFile = "../openapi_operations.yaml"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+
+	// nameRE matches the name of an operation in operationsFile, such as
+	// "  - name: GET /repos/{owner}/{repo}".
+	nameRE = regexp.MustCompile(`^\s*- name: ([A-Z]+) (/\S*)$`)
+
+	// idRE matches the ID of the preceding operation in operationsFile,
+	// such as "    operation_id: repos/get".
+	idRE = regexp.MustCompile(`^\s+operation_id: (\S+)$`)
+)
+
+func logf(fmt string, args ...any) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	f, err := os.Open(operationsFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	// An operation may be listed in several sections of the file, not all
+	// of which have its ID.
+	ops := make(map[string]*operation)
+	var last *operation
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if m := nameRE.FindStringSubmatch(sc.Text()); m != nil {
+			name := m[1] + " " + m[2]
+			if ops[name] == nil {
+				ops[name] = &operation{Method: m[1], Path: m[2]}
+			}
+			last = ops[name]
+			continue
+		}
+		if m := idRE.FindStringSubmatch(sc.Text()); m != nil && last != nil {
+			last.ID = m[1]
+		}
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	t := &templateData{Year: 2025, Package: "github"}
+	for _, op := range ops {
+		t.Operations = append(t.Operations, op)
+	}
+	slices.SortFunc(t.Operations, func(a, b *operation) int {
+		return cmp.Or(cmp.Compare(a.Path, b.Path), cmp.Compare(a.Method, b.Method))
+	})
+	logf("Found %v operations.", len(t.Operations))
+
+	if err := t.dump(); err != nil {
+		log.Fatal(err)
+	}
+	logf("Done.")
+}
+
+type operation struct {
+	ID     string
+	Method string
+	Path   string
+}
+
+type templateData struct {
+	Year       int
+	Package    string
+	Operations []*operation
+}
+
+func (t *templateData) dump() error {
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format.Source:\n%v\n%v", buf.String(), err)
+	}
+
+	logf("Writing %v...", fileName)
+	if err := os.Chmod(fileName, 0o644); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("os.Chmod(%q, 0644): %v", fileName, err)
+	}
+	if err := os.WriteFile(fileName, clean, 0o444); err != nil {
+		return err
+	}
+	if err := os.Chmod(fileName, 0o444); err != nil {
+		return fmt.Errorf("os.Chmod(%q, 0444): %v", fileName, err)
+	}
+	return nil
+}
+
+const source = `// Copyright {{.Year}} The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-operations; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package {{.Package}}
+
+// apiOperations are the operations of the GitHub API.
+var apiOperations = []Operation{
+{{- range .Operations}}
+	{ {{- with .ID}}ID: {{printf "%q" .}}, {{end}}Method: {{printf "%q" .Method}}, Path: {{printf "%q" .Path}}},
+{{- end}}
+}
+`
@@ -0,0 +1,256 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// gen-webhook-handlers generates typed callback registration methods for
+// WebhookHandler.
+//
+// For every webhook event type in eventTypeMapping (see messages.go), an
+// "On" method is generated that registers a callback receiving the
+// corresponding event struct. If the event struct has an Action field, the
+// method also accepts a list of actions to filter on.
+//
+// It is meant to be used by go-github contributors in conjunction with the
+// go generate tool before sending a PR to GitHub.
+// Please see the CONTRIBUTING.md file for more information.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	fileName = "github-webhook-handlers.go"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+)
+
+func logf(fmt string, args ...any) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, 0)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	for pkgName, pkg := range pkgs {
+		t := &templateData{
+			Year:    2025,
+			Package: pkgName,
+			structs: map[string]*ast.StructType{},
+		}
+		for _, f := range pkg.Files {
+			t.collectStructs(f)
+		}
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			if err := t.processAST(f); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if err := t.dump(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+func sourceFilter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != fileName
+}
+
+type templateData struct {
+	Year     int
+	Package  string
+	Handlers []*handler
+
+	structs map[string]*ast.StructType
+}
+
+type handler struct {
+	Event     string // Webhook event name, such as "pull_request".
+	Name      string // Method name suffix, such as "PullRequest".
+	Type      string // Event struct type, such as "PullRequestEvent".
+	HasAction bool
+}
+
+// collectStructs records all struct types declared in f.
+func (t *templateData) collectStructs(f *ast.File) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if st, ok := ts.Type.(*ast.StructType); ok {
+				t.structs[ts.Name.Name] = st
+			}
+		}
+	}
+}
+
+// processAST finds the eventTypeMapping declaration in f, if any, and
+// records a handler for each of its entries.
+func (t *templateData) processAST(f *ast.File) error {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				if name.Name != "eventTypeMapping" || i >= len(vs.Values) {
+					continue
+				}
+				lit, ok := vs.Values[i].(*ast.CompositeLit)
+				if !ok {
+					return errors.New("eventTypeMapping is not a composite literal")
+				}
+				for _, elt := range lit.Elts {
+					if err := t.addHandler(elt); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (t *templateData) addHandler(elt ast.Expr) error {
+	kv, ok := elt.(*ast.KeyValueExpr)
+	if !ok {
+		return fmt.Errorf("unexpected eventTypeMapping element %T", elt)
+	}
+	key, ok := kv.Key.(*ast.BasicLit)
+	if !ok {
+		return fmt.Errorf("unexpected eventTypeMapping key %T", kv.Key)
+	}
+	event, err := strconv.Unquote(key.Value)
+	if err != nil {
+		return err
+	}
+	ue, ok := kv.Value.(*ast.UnaryExpr)
+	if !ok {
+		return fmt.Errorf("unexpected eventTypeMapping value for %q", event)
+	}
+	cl, ok := ue.X.(*ast.CompositeLit)
+	if !ok {
+		return fmt.Errorf("unexpected eventTypeMapping value for %q", event)
+	}
+	ident, ok := cl.Type.(*ast.Ident)
+	if !ok {
+		return fmt.Errorf("unexpected eventTypeMapping value for %q", event)
+	}
+
+	h := &handler{
+		Event: event,
+		Name:  strings.TrimSuffix(ident.Name, "Event"),
+		Type:  ident.Name,
+	}
+	if st, ok := t.structs[ident.Name]; ok {
+		for _, field := range st.Fields.List {
+			for _, n := range field.Names {
+				if n.Name == "Action" {
+					h.HasAction = true
+				}
+			}
+		}
+	}
+	logf("Found event %q (%v).", h.Event, h.Type)
+	t.Handlers = append(t.Handlers, h)
+	return nil
+}
+
+func (t *templateData) dump() error {
+	if len(t.Handlers) == 0 {
+		logf("No handlers for %v; skipping.", fileName)
+		return nil
+	}
+
+	slices.SortStableFunc(t.Handlers, func(a, b *handler) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format.Source:\n%v\n%v", buf.String(), err)
+	}
+
+	logf("Writing %v...", fileName)
+	if err := os.Chmod(fileName, 0o644); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("os.Chmod(%q, 0644): %v", fileName, err)
+	}
+	if err := os.WriteFile(fileName, clean, 0o444); err != nil {
+		return err
+	}
+	if err := os.Chmod(fileName, 0o444); err != nil {
+		return fmt.Errorf("os.Chmod(%q, 0444): %v", fileName, err)
+	}
+	return nil
+}
+
+const source = `// Copyright {{.Year}} The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-webhook-handlers; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package {{.Package}}
+
+import "context"
+{{range .Handlers}}
+// On{{.Name}} registers fn to be called for "{{.Event}}" webhook events.
+{{- if .HasAction}}
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) On{{.Name}}(fn func(ctx context.Context, event *{{.Type}}) error, actions ...string) {
+	h.on("{{.Event}}", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*{{.Type}}))
+	})
+}
+{{- else}}
+func (h *WebhookHandler) On{{.Name}}(fn func(ctx context.Context, event *{{.Type}}) error) {
+	h.on("{{.Event}}", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*{{.Type}}))
+	})
+}
+{{- end}}
+{{end}}
+`
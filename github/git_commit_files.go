@@ -0,0 +1,249 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	defaultCommitFilesMaxAttempts = 3
+
+	// fileModeRegular is the Git mode of regular files.
+	fileModeRegular = "100644"
+)
+
+// FileChange describes a change to a file made by GitService.CommitFiles.
+//
+// Set Content to add or modify a file, Delete to delete it, and OldPath to
+// rename it, optionally along with Content. Set Mode alone to change the mode
+// of an existing file.
+type FileChange struct {
+	// Path is the path of the file in the repository.
+	Path string
+
+	// Content is the new content of the file. If nil, the content is kept.
+	Content []byte
+
+	// Delete deletes the file at Path.
+	Delete bool
+
+	// OldPath, if set, is the path the file is renamed from.
+	OldPath string
+
+	// Mode is the new Git mode of the file: "100644" for a regular file,
+	// "100755" for an executable or "120000" for a symbolic link, whose
+	// Content is the link target. If empty, the mode of the existing file is
+	// kept, and new files are regular files.
+	Mode string
+}
+
+// CommitFilesOptions specifies parameters to GitService.CommitFiles.
+type CommitFilesOptions struct {
+	// Message is the commit message. It is required.
+	Message string
+
+	// Author and Committer of the commit. If omitted, they are filled in
+	// with the authenticated user's information and the current date.
+	Author    *CommitAuthor
+	Committer *CommitAuthor
+
+	// Signer, if set, signs the commit. See MessageSigner. Author is required
+	// to sign the commit.
+	Signer MessageSigner
+
+	// MaxAttempts is the maximum number of times the commit is attempted,
+	// if the branch is updated concurrently. Defaults to 3.
+	MaxAttempts int
+}
+
+// CommitFiles creates a single commit that applies changes on top of the
+// head of branch, and updates the branch to point to it. It returns the
+// created commit.
+//
+// The commit is created with the Git database API: the blobs of the new
+// contents, then a tree based on the tree of the head commit, and then the
+// commit itself. If the branch was updated concurrently, so that the update
+// is not a fast-forward, the commit is recreated on top of the new head, up
+// to opts.MaxAttempts times.
+//
+// GitHub API docs: https://docs.github.com/rest/git/blobs#create-a-blob
+// GitHub API docs: https://docs.github.com/rest/git/commits#create-a-commit
+// GitHub API docs: https://docs.github.com/rest/git/commits#get-a-commit-object
+// GitHub API docs: https://docs.github.com/rest/git/refs#get-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/refs#update-a-reference
+// GitHub API docs: https://docs.github.com/rest/git/trees#create-a-tree
+// GitHub API docs: https://docs.github.com/rest/git/trees#get-a-tree
+//
+//meta:operation POST /repos/{owner}/{repo}/git/blobs
+//meta:operation POST /repos/{owner}/{repo}/git/commits
+//meta:operation GET /repos/{owner}/{repo}/git/commits/{commit_sha}
+//meta:operation GET /repos/{owner}/{repo}/git/ref/{ref}
+//meta:operation PATCH /repos/{owner}/{repo}/git/refs/{ref}
+//meta:operation POST /repos/{owner}/{repo}/git/trees
+//meta:operation GET /repos/{owner}/{repo}/git/trees/{tree_sha}
+func (s *GitService) CommitFiles(ctx context.Context, owner, repo, branch string, changes []*FileChange, opts *CommitFilesOptions) (*Commit, *Response, error) {
+	if branch == "" {
+		return nil, nil, errors.New("branch must be provided")
+	}
+	if opts == nil || opts.Message == "" {
+		return nil, nil, errors.New("opts.Message must be provided")
+	}
+	if opts.Signer != nil && opts.Author == nil {
+		return nil, nil, errors.New("opts.Author must be provided to sign the commit")
+	}
+	if err := validateFileChanges(changes); err != nil {
+		return nil, nil, err
+	}
+
+	// The blobs do not depend on the head of the branch, so they are only
+	// created once.
+	blobs := make(map[*FileChange]string)
+	for _, c := range changes {
+		if c.Content == nil {
+			continue
+		}
+		blob, resp, err := s.CreateBlob(ctx, owner, repo, Blob{
+			Content:  Ptr(base64.StdEncoding.EncodeToString(c.Content)),
+			Encoding: Ptr("base64"),
+		})
+		if err != nil {
+			return nil, resp, err
+		}
+		blobs[c] = blob.GetSHA()
+	}
+
+	maxAttempts := opts.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultCommitFilesMaxAttempts
+	}
+	ref := "heads/" + strings.TrimPrefix(branch, "refs/heads/")
+	for attempt := 1; ; attempt++ {
+		commit, resp, err := s.commitFiles(ctx, owner, repo, ref, changes, blobs, opts)
+		if err == nil || attempt >= maxAttempts || !isNotFastForward(err) {
+			return commit, resp, err
+		}
+	}
+}
+
+// validateFileChanges checks that changes are consistent.
+func validateFileChanges(changes []*FileChange) error {
+	if len(changes) == 0 {
+		return errors.New("changes must be provided")
+	}
+	paths := make(map[string]bool)
+	for _, c := range changes {
+		switch {
+		case c.Path == "":
+			return errors.New("change path must be provided")
+		case c.Delete && (c.Content != nil || c.OldPath != "" || c.Mode != ""):
+			return fmt.Errorf("change %v: deleted files cannot have content, old path or mode", c.Path)
+		case !c.Delete && c.Content == nil && c.OldPath == "" && c.Mode == "":
+			return fmt.Errorf("change %v: nothing to change", c.Path)
+		case paths[c.Path] || (c.OldPath != "" && paths[c.OldPath]):
+			return fmt.Errorf("change %v: path changed more than once", c.Path)
+		}
+		paths[c.Path] = true
+		if c.OldPath != "" {
+			paths[c.OldPath] = true
+		}
+	}
+	return nil
+}
+
+// commitFiles makes a single attempt at committing changes on top of the
+// head of ref, using the SHAs of the created blobs.
+func (s *GitService) commitFiles(ctx context.Context, owner, repo, ref string, changes []*FileChange, blobs map[*FileChange]string, opts *CommitFilesOptions) (*Commit, *Response, error) {
+	head, resp, err := s.GetRef(ctx, owner, repo, ref)
+	if err != nil {
+		return nil, resp, err
+	}
+	parent, resp, err := s.GetCommit(ctx, owner, repo, head.GetObject().GetSHA())
+	if err != nil {
+		return nil, resp, err
+	}
+	baseTree := parent.GetTree().GetSHA()
+	tree, resp, err := s.GetTree(ctx, owner, repo, baseTree, true)
+	if err != nil {
+		return nil, resp, err
+	}
+	existing := make(map[string]*TreeEntry)
+	for _, e := range tree.Entries {
+		if e.GetType() == "blob" {
+			existing[e.GetPath()] = e
+		}
+	}
+
+	var entries []*TreeEntry
+	for _, c := range changes {
+		if c.Delete {
+			entries = append(entries, &TreeEntry{Path: Ptr(c.Path), Mode: Ptr(fileModeRegular), Type: Ptr("blob")})
+			continue
+		}
+
+		source := c.Path
+		if c.OldPath != "" {
+			source = c.OldPath
+			entries = append(entries, &TreeEntry{Path: Ptr(c.OldPath), Mode: Ptr(fileModeRegular), Type: Ptr("blob")})
+		}
+		old := existing[source]
+		if old == nil && (c.Content == nil || c.OldPath != "") {
+			if tree.GetTruncated() {
+				return nil, resp, fmt.Errorf("change %v: tree of %v is too large to look up %v", c.Path, ref, source)
+			}
+			return nil, resp, fmt.Errorf("change %v: file %v not found in %v", c.Path, source, ref)
+		}
+
+		entry := &TreeEntry{Path: Ptr(c.Path), Mode: Ptr(fileModeRegular), Type: Ptr("blob")}
+		if sha, ok := blobs[c]; ok {
+			entry.SHA = Ptr(sha)
+		} else {
+			entry.SHA = old.SHA
+		}
+		switch {
+		case c.Mode != "":
+			entry.Mode = Ptr(c.Mode)
+		case old != nil:
+			entry.Mode = old.Mode
+		}
+		entries = append(entries, entry)
+	}
+
+	newTree, resp, err := s.CreateTree(ctx, owner, repo, baseTree, entries)
+	if err != nil {
+		return nil, resp, err
+	}
+	commit, resp, err := s.CreateCommit(ctx, owner, repo, Commit{
+		Message:   Ptr(opts.Message),
+		Author:    opts.Author,
+		Committer: opts.Committer,
+		Tree:      &Tree{SHA: newTree.SHA},
+		Parents:   []*Commit{{SHA: parent.SHA}},
+	}, &CreateCommitOptions{Signer: opts.Signer})
+	if err != nil {
+		return nil, resp, err
+	}
+	_, resp, err = s.UpdateRef(ctx, owner, repo, ref, UpdateRef{SHA: commit.GetSHA()})
+	if err != nil {
+		return nil, resp, err
+	}
+	return commit, resp, nil
+}
+
+// isNotFastForward reports whether err is the error returned by GitHub when
+// a reference update is not a fast-forward.
+func isNotFastForward(err error) bool {
+	var errResp *ErrorResponse
+	return errors.As(err, &errResp) &&
+		errResp.Response != nil &&
+		errResp.Response.StatusCode == http.StatusUnprocessableEntity &&
+		strings.Contains(strings.ToLower(errResp.Message), "fast forward")
+}
@@ -0,0 +1,185 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGitService_CommitFiles(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	// The branch is updated concurrently after the first head is read.
+	heads := []string{"h1", "h2"}
+	var refUpdates int
+	var trees []map[string]any
+	var commits []map[string]any
+
+	mux.HandleFunc("/repos/o/r/git/blobs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var blob Blob
+		assertNilError(t, json.NewDecoder(r.Body).Decode(&blob))
+		if blob.GetEncoding() != "base64" {
+			t.Errorf("blob encoding = %v, want base64", blob.GetEncoding())
+		}
+		fmt.Fprintf(w, `{"sha":"blob-%v"}`, blob.GetContent())
+	})
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"ref":"refs/heads/main","object":{"sha":%q}}`, heads[refUpdates])
+	})
+	for _, head := range heads {
+		mux.HandleFunc("/repos/o/r/git/commits/"+head, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprintf(w, `{"sha":%q,"tree":{"sha":"t-%v"}}`, head, head)
+		})
+		mux.HandleFunc("/repos/o/r/git/trees/t-"+head, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testFormValues(t, r, values{"recursive": "1"})
+			fmt.Fprint(w, `{"tree":[
+				{"path":"old.txt","mode":"100644","type":"blob","sha":"old"},
+				{"path":"run.sh","mode":"100644","type":"blob","sha":"run"},
+				{"path":"bin","mode":"040000","type":"tree","sha":"bin"},
+				{"path":"bin/tool","mode":"100755","type":"blob","sha":"tool"}
+			]}`)
+		})
+	}
+	mux.HandleFunc("/repos/o/r/git/trees", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]any
+		assertNilError(t, json.NewDecoder(r.Body).Decode(&body))
+		trees = append(trees, body)
+		fmt.Fprint(w, `{"sha":"new-tree"}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		var body map[string]any
+		assertNilError(t, json.NewDecoder(r.Body).Decode(&body))
+		commits = append(commits, body)
+		fmt.Fprintf(w, `{"sha":"c%v"}`, len(commits))
+	})
+	mux.HandleFunc("/repos/o/r/git/refs/heads/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, fmt.Sprintf(`{"sha":"c%v"}`+"\n", len(commits)))
+		if refUpdates == 0 {
+			refUpdates++
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Update is not a fast forward"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"ref":"refs/heads/main","object":{"sha":"c%v"}}`, len(commits))
+	})
+
+	changes := []*FileChange{
+		{Path: "new.txt", Content: []byte("a")},
+		{Path: "gone.txt", Delete: true},
+		{Path: "renamed.txt", OldPath: "old.txt"},
+		{Path: "run.sh", Mode: "100755"},
+		{Path: "bin/tool", Content: []byte("b")},
+	}
+	opts := &CommitFilesOptions{
+		Message: "m",
+		Author:  &CommitAuthor{Name: Ptr("n"), Email: Ptr("e"), Date: &Timestamp{referenceTime}},
+		Signer:  mockSigner(t, "sig", nil, ""),
+	}
+	ctx := t.Context()
+	commit, _, err := client.Git.CommitFiles(ctx, "o", "r", "main", changes, opts)
+	if err != nil {
+		t.Fatalf("Git.CommitFiles returned error: %v", err)
+	}
+	if want := (&Commit{SHA: Ptr("c2")}); !cmp.Equal(commit, want) {
+		t.Errorf("Git.CommitFiles returned %+v, want %+v", commit, want)
+	}
+
+	if len(trees) != 2 || len(commits) != 2 {
+		t.Fatalf("created %v trees and %v commits, want 2 of each", len(trees), len(commits))
+	}
+	wantTree := map[string]any{
+		"base_tree": "t-h2",
+		"tree": []any{
+			map[string]any{"path": "new.txt", "mode": "100644", "type": "blob", "sha": "blob-YQ=="},
+			map[string]any{"path": "gone.txt", "mode": "100644", "type": "blob", "sha": nil},
+			map[string]any{"path": "old.txt", "mode": "100644", "type": "blob", "sha": nil},
+			map[string]any{"path": "renamed.txt", "mode": "100644", "type": "blob", "sha": "old"},
+			map[string]any{"path": "run.sh", "mode": "100755", "type": "blob", "sha": "run"},
+			map[string]any{"path": "bin/tool", "mode": "100755", "type": "blob", "sha": "blob-Yg=="},
+		},
+	}
+	if !cmp.Equal(trees[1], wantTree) {
+		t.Errorf("Git.CommitFiles created tree %v, want %v", trees[1], wantTree)
+	}
+	if got := commits[1]["parents"]; !cmp.Equal(got, []any{"h2"}) {
+		t.Errorf("Git.CommitFiles created commit with parents %v, want [h2]", got)
+	}
+	if got := commits[1]["signature"]; got != "sig" {
+		t.Errorf("Git.CommitFiles created commit with signature %v, want sig", got)
+	}
+
+	// The attempts are exhausted before the ref can be updated.
+	refUpdates = 0
+	opts.MaxAttempts = 1
+	_, resp, err := client.Git.CommitFiles(ctx, "o", "r", "refs/heads/main", changes, opts)
+	if err == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Git.CommitFiles returned error %v, want not a fast forward", err)
+	}
+}
+
+func TestGitService_CommitFiles_notFound(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/git/ref/heads/main", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"object":{"sha":"h"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/commits/h", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"sha":"h","tree":{"sha":"t"}}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/t", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"tree":[]}`)
+	})
+
+	changes := []*FileChange{{Path: "b", OldPath: "a"}}
+	_, _, err := client.Git.CommitFiles(t.Context(), "o", "r", "main", changes, &CommitFilesOptions{Message: "m"})
+	if err == nil {
+		t.Error("Git.CommitFiles renaming a missing file returned nil error")
+	}
+}
+
+func TestGitService_CommitFiles_invalid(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	opts := &CommitFilesOptions{Message: "m"}
+	tests := []struct {
+		name    string
+		branch  string
+		changes []*FileChange
+		opts    *CommitFilesOptions
+	}{
+		{"no branch", "", []*FileChange{{Path: "a", Delete: true}}, opts},
+		{"no message", "main", []*FileChange{{Path: "a", Delete: true}}, &CommitFilesOptions{}},
+		{"signer without author", "main", []*FileChange{{Path: "a", Delete: true}}, &CommitFilesOptions{Message: "m", Signer: uncalledSigner(t)}},
+		{"no changes", "main", nil, opts},
+		{"no path", "main", []*FileChange{{Delete: true}}, opts},
+		{"nothing to change", "main", []*FileChange{{Path: "a"}}, opts},
+		{"delete with content", "main", []*FileChange{{Path: "a", Delete: true, Content: []byte("a")}}, opts},
+		{"duplicate path", "main", []*FileChange{{Path: "a", Delete: true}, {Path: "b", OldPath: "a"}}, opts},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, _, err := client.Git.CommitFiles(t.Context(), "o", "r", tt.branch, tt.changes, tt.opts); err == nil {
+				t.Error("Git.CommitFiles returned nil error")
+			}
+		})
+	}
+}
@@ -4230,6 +4230,22 @@ func (c *CommitFile) GetStatus() string {
 	return *c.Status
 }
 
+// GetAuthor returns the Author field.
+func (c *CommitFilesOptions) GetAuthor() *CommitAuthor {
+	if c == nil {
+		return nil
+	}
+	return c.Author
+}
+
+// GetCommitter returns the Committer field.
+func (c *CommitFilesOptions) GetCommitter() *CommitAuthor {
+	if c == nil {
+		return nil
+	}
+	return c.Committer
+}
+
 // GetAuthor returns the Author field.
 func (c *CommitResult) GetAuthor() *User {
 	if c == nil {
@@ -8430,6 +8446,14 @@ func (d *Discussion) GetNumber() int {
 	return *d.Number
 }
 
+// GetPoll returns the Poll field.
+func (d *Discussion) GetPoll() *DiscussionPoll {
+	if d == nil {
+		return nil
+	}
+	return d.Poll
+}
+
 // GetRepositoryURL returns the RepositoryURL field if it's non-nil, zero value otherwise.
 func (d *Discussion) GetRepositoryURL() string {
 	if d == nil || d.RepositoryURL == nil {
@@ -8710,6 +8734,22 @@ func (d *DiscussionCommentEvent) GetSender() *User {
 	return d.Sender
 }
 
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DiscussionCommentRequest) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
+	}
+	return *d.Body
+}
+
+// GetReplyToID returns the ReplyToID field if it's non-nil, zero value otherwise.
+func (d *DiscussionCommentRequest) GetReplyToID() string {
+	if d == nil || d.ReplyToID == nil {
+		return ""
+	}
+	return *d.ReplyToID
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (d *DiscussionEvent) GetAction() string {
 	if d == nil || d.Action == nil {
@@ -8758,6 +8798,78 @@ func (d *DiscussionEvent) GetSender() *User {
 	return d.Sender
 }
 
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DiscussionPoll) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
+		return ""
+	}
+	return *d.NodeID
+}
+
+// GetQuestion returns the Question field if it's non-nil, zero value otherwise.
+func (d *DiscussionPoll) GetQuestion() string {
+	if d == nil || d.Question == nil {
+		return ""
+	}
+	return *d.Question
+}
+
+// GetTotalVoteCount returns the TotalVoteCount field if it's non-nil, zero value otherwise.
+func (d *DiscussionPoll) GetTotalVoteCount() int {
+	if d == nil || d.TotalVoteCount == nil {
+		return 0
+	}
+	return *d.TotalVoteCount
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (d *DiscussionPollOption) GetNodeID() string {
+	if d == nil || d.NodeID == nil {
+		return ""
+	}
+	return *d.NodeID
+}
+
+// GetOption returns the Option field if it's non-nil, zero value otherwise.
+func (d *DiscussionPollOption) GetOption() string {
+	if d == nil || d.Option == nil {
+		return ""
+	}
+	return *d.Option
+}
+
+// GetTotalVoteCount returns the TotalVoteCount field if it's non-nil, zero value otherwise.
+func (d *DiscussionPollOption) GetTotalVoteCount() int {
+	if d == nil || d.TotalVoteCount == nil {
+		return 0
+	}
+	return *d.TotalVoteCount
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (d *DiscussionRequest) GetBody() string {
+	if d == nil || d.Body == nil {
+		return ""
+	}
+	return *d.Body
+}
+
+// GetCategoryID returns the CategoryID field if it's non-nil, zero value otherwise.
+func (d *DiscussionRequest) GetCategoryID() string {
+	if d == nil || d.CategoryID == nil {
+		return ""
+	}
+	return *d.CategoryID
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (d *DiscussionRequest) GetTitle() string {
+	if d == nil || d.Title == nil {
+		return ""
+	}
+	return *d.Title
+}
+
 // GetApps returns the Apps field if it's non-nil, zero value otherwise.
 func (d *DismissalRestrictionsRequest) GetApps() []string {
 	if d == nil || d.Apps == nil {
@@ -8830,6 +8942,14 @@ func (d *DispatchRequestOptions) GetClientPayload() json.RawMessage {
 	return *d.ClientPayload
 }
 
+// GetWait returns the Wait field.
+func (d *DispatchWorkflowOptions) GetWait() *WaitForWorkflowRunOptions {
+	if d == nil {
+		return nil
+	}
+	return d.Wait
+}
+
 // GetBody returns the Body field if it's non-nil, zero value otherwise.
 func (d *DraftReviewComment) GetBody() string {
 	if d == nil || d.Body == nil {
@@ -10358,6 +10478,14 @@ func (g *Grant) GetURL() string {
 	return *g.URL
 }
 
+// GetExtensions returns the Extensions map if it's non-nil, an empty map otherwise.
+func (g *GraphQLError) GetExtensions() map[string]any {
+	if g == nil || g.Extensions == nil {
+		return map[string]any{}
+	}
+	return g.Extensions
+}
+
 // GetAuthor returns the Author field.
 func (h *HeadCommit) GetAuthor() *CommitAuthor {
 	if h == nil {
@@ -18774,6 +18902,14 @@ func (p *ProjectV2) GetID() int64 {
 	return *p.ID
 }
 
+// GetIsTemplate returns the IsTemplate field if it's non-nil, zero value otherwise.
+func (p *ProjectV2) GetIsTemplate() bool {
+	if p == nil || p.IsTemplate == nil {
+		return false
+	}
+	return *p.IsTemplate
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (p *ProjectV2) GetName() string {
 	if p == nil || p.Name == nil {
@@ -18878,6 +19014,62 @@ func (p *ProjectV2) GetURL() string {
 	return *p.URL
 }
 
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (p *ProjectV2DraftIssue) GetBody() string {
+	if p == nil || p.Body == nil {
+		return ""
+	}
+	return *p.Body
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2DraftIssue) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2DraftIssue) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2DraftIssue) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (p *ProjectV2DraftIssue) GetTitle() string {
+	if p == nil || p.Title == nil {
+		return ""
+	}
+	return *p.Title
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2DraftIssue) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetUser returns the User field.
+func (p *ProjectV2DraftIssue) GetUser() *User {
+	if p == nil {
+		return nil
+	}
+	return p.User
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (p *ProjectV2Event) GetAction() string {
 	if p == nil || p.Action == nil {
@@ -18918,6 +19110,150 @@ func (p *ProjectV2Event) GetSender() *User {
 	return p.Sender
 }
 
+// GetConfiguration returns the Configuration field.
+func (p *ProjectV2Field) GetConfiguration() *ProjectV2FieldConfiguration {
+	if p == nil {
+		return nil
+	}
+	return p.Configuration
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetCreatedAt() Timestamp {
+	if p == nil || p.CreatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.CreatedAt
+}
+
+// GetDataType returns the DataType field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetDataType() string {
+	if p == nil || p.DataType == nil {
+		return ""
+	}
+	return *p.DataType
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetNodeID() string {
+	if p == nil || p.NodeID == nil {
+		return ""
+	}
+	return *p.NodeID
+}
+
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
+		return ""
+	}
+	return *p.ProjectURL
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Field) GetUpdatedAt() Timestamp {
+	if p == nil || p.UpdatedAt == nil {
+		return Timestamp{}
+	}
+	return *p.UpdatedAt
+}
+
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldConfiguration) GetDuration() int {
+	if p == nil || p.Duration == nil {
+		return 0
+	}
+	return *p.Duration
+}
+
+// GetStartDay returns the StartDay field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldConfiguration) GetStartDay() int {
+	if p == nil || p.StartDay == nil {
+		return 0
+	}
+	return *p.StartDay
+}
+
+// GetDuration returns the Duration field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldIteration) GetDuration() int {
+	if p == nil || p.Duration == nil {
+		return 0
+	}
+	return *p.Duration
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldIteration) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldIteration) GetStartDate() string {
+	if p == nil || p.StartDate == nil {
+		return ""
+	}
+	return *p.StartDate
+}
+
+// GetTitle returns the Title field.
+func (p *ProjectV2FieldIteration) GetTitle() *ProjectV2TextContent {
+	if p == nil {
+		return nil
+	}
+	return p.Title
+}
+
+// GetColor returns the Color field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldOption) GetColor() string {
+	if p == nil || p.Color == nil {
+		return ""
+	}
+	return *p.Color
+}
+
+// GetDescription returns the Description field.
+func (p *ProjectV2FieldOption) GetDescription() *ProjectV2TextContent {
+	if p == nil {
+		return nil
+	}
+	return p.Description
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2FieldOption) GetID() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field.
+func (p *ProjectV2FieldOption) GetName() *ProjectV2TextContent {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
 // GetArchivedAt returns the ArchivedAt field if it's non-nil, zero value otherwise.
 func (p *ProjectV2Item) GetArchivedAt() Timestamp {
 	if p == nil || p.ArchivedAt == nil {
@@ -18966,6 +19302,14 @@ func (p *ProjectV2Item) GetID() int64 {
 	return *p.ID
 }
 
+// GetItemURL returns the ItemURL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetItemURL() string {
+	if p == nil || p.ItemURL == nil {
+		return ""
+	}
+	return *p.ItemURL
+}
+
 // GetNodeID returns the NodeID field if it's non-nil, zero value otherwise.
 func (p *ProjectV2Item) GetNodeID() string {
 	if p == nil || p.NodeID == nil {
@@ -18982,6 +19326,14 @@ func (p *ProjectV2Item) GetProjectNodeID() string {
 	return *p.ProjectNodeID
 }
 
+// GetProjectURL returns the ProjectURL field if it's non-nil, zero value otherwise.
+func (p *ProjectV2Item) GetProjectURL() string {
+	if p == nil || p.ProjectURL == nil {
+		return ""
+	}
+	return *p.ProjectURL
+}
+
 // GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
 func (p *ProjectV2Item) GetUpdatedAt() Timestamp {
 	if p == nil || p.UpdatedAt == nil {
@@ -19054,6 +19406,46 @@ func (p *ProjectV2ItemEvent) GetSender() *User {
 	return p.Sender
 }
 
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValue) GetID() int64 {
+	if p == nil || p.ID == nil {
+		return 0
+	}
+	return *p.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValue) GetName() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *ProjectV2ItemFieldValue) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// GetHTML returns the HTML field if it's non-nil, zero value otherwise.
+func (p *ProjectV2TextContent) GetHTML() string {
+	if p == nil || p.HTML == nil {
+		return ""
+	}
+	return *p.HTML
+}
+
+// GetRaw returns the Raw field if it's non-nil, zero value otherwise.
+func (p *ProjectV2TextContent) GetRaw() string {
+	if p == nil || p.Raw == nil {
+		return ""
+	}
+	return *p.Raw
+}
+
 // GetAllowDeletions returns the AllowDeletions field.
 func (p *Protection) GetAllowDeletions() *AllowDeletions {
 	if p == nil {
@@ -23462,6 +23854,14 @@ func (r *RepositoryContentResponse) GetContent() *RepositoryContent {
 	return r.Content
 }
 
+// GetAnswered returns the Answered field if it's non-nil, zero value otherwise.
+func (r *RepositoryDiscussionListOptions) GetAnswered() bool {
+	if r == nil || r.Answered == nil {
+		return false
+	}
+	return *r.Answered
+}
+
 // GetAction returns the Action field if it's non-nil, zero value otherwise.
 func (r *RepositoryDispatchEvent) GetAction() string {
 	if r == nil || r.Action == nil {
@@ -30078,6 +30478,14 @@ func (w *WorkflowJobEvent) GetWorkflowJob() *WorkflowJob {
 	return w.WorkflowJob
 }
 
+// GetJob returns the Job field.
+func (w *WorkflowJobLog) GetJob() *WorkflowJob {
+	if w == nil {
+		return nil
+	}
+	return w.Job
+}
+
 // GetConclusion returns the Conclusion field if it's non-nil, zero value otherwise.
 func (w *WorkflowJobRun) GetConclusion() string {
 	if w == nil || w.Conclusion == nil {
@@ -30598,6 +31006,14 @@ func (w *WorkflowsRuleParameters) GetDoNotEnforceOnCreate() bool {
 	return *w.DoNotEnforceOnCreate
 }
 
+// GetStep returns the Step field.
+func (w *WorkflowStepLog) GetStep() *TaskStep {
+	if w == nil {
+		return nil
+	}
+	return w.Step
+}
+
 // GetBillable returns the Billable field.
 func (w *WorkflowUsage) GetBillable() *WorkflowBillMap {
 	if w == nil {
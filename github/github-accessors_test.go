@@ -5518,6 +5518,22 @@ func TestCommitFile_GetStatus(tt *testing.T) {
 	c.GetStatus()
 }
 
+func TestCommitFilesOptions_GetAuthor(tt *testing.T) {
+	tt.Parallel()
+	c := &CommitFilesOptions{}
+	c.GetAuthor()
+	c = nil
+	c.GetAuthor()
+}
+
+func TestCommitFilesOptions_GetCommitter(tt *testing.T) {
+	tt.Parallel()
+	c := &CommitFilesOptions{}
+	c.GetCommitter()
+	c = nil
+	c.GetCommitter()
+}
+
 func TestCommitResult_GetAuthor(tt *testing.T) {
 	tt.Parallel()
 	c := &CommitResult{}
@@ -10933,6 +10949,14 @@ func TestDiscussion_GetNumber(tt *testing.T) {
 	d.GetNumber()
 }
 
+func TestDiscussion_GetPoll(tt *testing.T) {
+	tt.Parallel()
+	d := &Discussion{}
+	d.GetPoll()
+	d = nil
+	d.GetPoll()
+}
+
 func TestDiscussion_GetRepositoryURL(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -11291,6 +11315,28 @@ func TestDiscussionCommentEvent_GetSender(tt *testing.T) {
 	d.GetSender()
 }
 
+func TestDiscussionCommentRequest_GetBody(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DiscussionCommentRequest{Body: &zeroValue}
+	d.GetBody()
+	d = &DiscussionCommentRequest{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
+}
+
+func TestDiscussionCommentRequest_GetReplyToID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DiscussionCommentRequest{ReplyToID: &zeroValue}
+	d.GetReplyToID()
+	d = &DiscussionCommentRequest{}
+	d.GetReplyToID()
+	d = nil
+	d.GetReplyToID()
+}
+
 func TestDiscussionEvent_GetAction(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -11342,6 +11388,105 @@ func TestDiscussionEvent_GetSender(tt *testing.T) {
 	d.GetSender()
 }
 
+func TestDiscussionPoll_GetNodeID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DiscussionPoll{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DiscussionPoll{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDiscussionPoll_GetQuestion(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DiscussionPoll{Question: &zeroValue}
+	d.GetQuestion()
+	d = &DiscussionPoll{}
+	d.GetQuestion()
+	d = nil
+	d.GetQuestion()
+}
+
+func TestDiscussionPoll_GetTotalVoteCount(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	d := &DiscussionPoll{TotalVoteCount: &zeroValue}
+	d.GetTotalVoteCount()
+	d = &DiscussionPoll{}
+	d.GetTotalVoteCount()
+	d = nil
+	d.GetTotalVoteCount()
+}
+
+func TestDiscussionPollOption_GetNodeID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DiscussionPollOption{NodeID: &zeroValue}
+	d.GetNodeID()
+	d = &DiscussionPollOption{}
+	d.GetNodeID()
+	d = nil
+	d.GetNodeID()
+}
+
+func TestDiscussionPollOption_GetOption(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DiscussionPollOption{Option: &zeroValue}
+	d.GetOption()
+	d = &DiscussionPollOption{}
+	d.GetOption()
+	d = nil
+	d.GetOption()
+}
+
+func TestDiscussionPollOption_GetTotalVoteCount(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	d := &DiscussionPollOption{TotalVoteCount: &zeroValue}
+	d.GetTotalVoteCount()
+	d = &DiscussionPollOption{}
+	d.GetTotalVoteCount()
+	d = nil
+	d.GetTotalVoteCount()
+}
+
+func TestDiscussionRequest_GetBody(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DiscussionRequest{Body: &zeroValue}
+	d.GetBody()
+	d = &DiscussionRequest{}
+	d.GetBody()
+	d = nil
+	d.GetBody()
+}
+
+func TestDiscussionRequest_GetCategoryID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DiscussionRequest{CategoryID: &zeroValue}
+	d.GetCategoryID()
+	d = &DiscussionRequest{}
+	d.GetCategoryID()
+	d = nil
+	d.GetCategoryID()
+}
+
+func TestDiscussionRequest_GetTitle(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	d := &DiscussionRequest{Title: &zeroValue}
+	d.GetTitle()
+	d = &DiscussionRequest{}
+	d.GetTitle()
+	d = nil
+	d.GetTitle()
+}
+
 func TestDismissalRestrictionsRequest_GetApps(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue []string
@@ -11441,6 +11586,14 @@ func TestDispatchRequestOptions_GetClientPayload(tt *testing.T) {
 	d.GetClientPayload()
 }
 
+func TestDispatchWorkflowOptions_GetWait(tt *testing.T) {
+	tt.Parallel()
+	d := &DispatchWorkflowOptions{}
+	d.GetWait()
+	d = nil
+	d.GetWait()
+}
+
 func TestDraftReviewComment_GetBody(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -13422,6 +13575,17 @@ func TestGrant_GetURL(tt *testing.T) {
 	g.GetURL()
 }
 
+func TestGraphQLError_GetExtensions(tt *testing.T) {
+	tt.Parallel()
+	zeroValue := map[string]any{}
+	g := &GraphQLError{Extensions: zeroValue}
+	g.GetExtensions()
+	g = &GraphQLError{}
+	g.GetExtensions()
+	g = nil
+	g.GetExtensions()
+}
+
 func TestHeadCommit_GetAuthor(tt *testing.T) {
 	tt.Parallel()
 	h := &HeadCommit{}
@@ -24376,6 +24540,17 @@ func TestProjectV2_GetID(tt *testing.T) {
 	p.GetID()
 }
 
+func TestProjectV2_GetIsTemplate(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue bool
+	p := &ProjectV2{IsTemplate: &zeroValue}
+	p.GetIsTemplate()
+	p = &ProjectV2{}
+	p.GetIsTemplate()
+	p = nil
+	p.GetIsTemplate()
+}
+
 func TestProjectV2_GetName(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -24516,6 +24691,80 @@ func TestProjectV2_GetURL(tt *testing.T) {
 	p.GetURL()
 }
 
+func TestProjectV2DraftIssue_GetBody(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2DraftIssue{Body: &zeroValue}
+	p.GetBody()
+	p = &ProjectV2DraftIssue{}
+	p.GetBody()
+	p = nil
+	p.GetBody()
+}
+
+func TestProjectV2DraftIssue_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	p := &ProjectV2DraftIssue{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectV2DraftIssue{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestProjectV2DraftIssue_GetID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int64
+	p := &ProjectV2DraftIssue{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2DraftIssue{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2DraftIssue_GetNodeID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2DraftIssue{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectV2DraftIssue{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
+}
+
+func TestProjectV2DraftIssue_GetTitle(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2DraftIssue{Title: &zeroValue}
+	p.GetTitle()
+	p = &ProjectV2DraftIssue{}
+	p.GetTitle()
+	p = nil
+	p.GetTitle()
+}
+
+func TestProjectV2DraftIssue_GetUpdatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	p := &ProjectV2DraftIssue{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectV2DraftIssue{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
+}
+
+func TestProjectV2DraftIssue_GetUser(tt *testing.T) {
+	tt.Parallel()
+	p := &ProjectV2DraftIssue{}
+	p.GetUser()
+	p = nil
+	p.GetUser()
+}
+
 func TestProjectV2Event_GetAction(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -24559,6 +24808,192 @@ func TestProjectV2Event_GetSender(tt *testing.T) {
 	p.GetSender()
 }
 
+func TestProjectV2Field_GetConfiguration(tt *testing.T) {
+	tt.Parallel()
+	p := &ProjectV2Field{}
+	p.GetConfiguration()
+	p = nil
+	p.GetConfiguration()
+}
+
+func TestProjectV2Field_GetCreatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	p := &ProjectV2Field{CreatedAt: &zeroValue}
+	p.GetCreatedAt()
+	p = &ProjectV2Field{}
+	p.GetCreatedAt()
+	p = nil
+	p.GetCreatedAt()
+}
+
+func TestProjectV2Field_GetDataType(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2Field{DataType: &zeroValue}
+	p.GetDataType()
+	p = &ProjectV2Field{}
+	p.GetDataType()
+	p = nil
+	p.GetDataType()
+}
+
+func TestProjectV2Field_GetID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int64
+	p := &ProjectV2Field{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2Field{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2Field_GetName(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2Field{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectV2Field{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestProjectV2Field_GetNodeID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2Field{NodeID: &zeroValue}
+	p.GetNodeID()
+	p = &ProjectV2Field{}
+	p.GetNodeID()
+	p = nil
+	p.GetNodeID()
+}
+
+func TestProjectV2Field_GetProjectURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2Field{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectV2Field{}
+	p.GetProjectURL()
+	p = nil
+	p.GetProjectURL()
+}
+
+func TestProjectV2Field_GetUpdatedAt(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue Timestamp
+	p := &ProjectV2Field{UpdatedAt: &zeroValue}
+	p.GetUpdatedAt()
+	p = &ProjectV2Field{}
+	p.GetUpdatedAt()
+	p = nil
+	p.GetUpdatedAt()
+}
+
+func TestProjectV2FieldConfiguration_GetDuration(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	p := &ProjectV2FieldConfiguration{Duration: &zeroValue}
+	p.GetDuration()
+	p = &ProjectV2FieldConfiguration{}
+	p.GetDuration()
+	p = nil
+	p.GetDuration()
+}
+
+func TestProjectV2FieldConfiguration_GetStartDay(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	p := &ProjectV2FieldConfiguration{StartDay: &zeroValue}
+	p.GetStartDay()
+	p = &ProjectV2FieldConfiguration{}
+	p.GetStartDay()
+	p = nil
+	p.GetStartDay()
+}
+
+func TestProjectV2FieldIteration_GetDuration(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int
+	p := &ProjectV2FieldIteration{Duration: &zeroValue}
+	p.GetDuration()
+	p = &ProjectV2FieldIteration{}
+	p.GetDuration()
+	p = nil
+	p.GetDuration()
+}
+
+func TestProjectV2FieldIteration_GetID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2FieldIteration{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2FieldIteration{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2FieldIteration_GetStartDate(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2FieldIteration{StartDate: &zeroValue}
+	p.GetStartDate()
+	p = &ProjectV2FieldIteration{}
+	p.GetStartDate()
+	p = nil
+	p.GetStartDate()
+}
+
+func TestProjectV2FieldIteration_GetTitle(tt *testing.T) {
+	tt.Parallel()
+	p := &ProjectV2FieldIteration{}
+	p.GetTitle()
+	p = nil
+	p.GetTitle()
+}
+
+func TestProjectV2FieldOption_GetColor(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2FieldOption{Color: &zeroValue}
+	p.GetColor()
+	p = &ProjectV2FieldOption{}
+	p.GetColor()
+	p = nil
+	p.GetColor()
+}
+
+func TestProjectV2FieldOption_GetDescription(tt *testing.T) {
+	tt.Parallel()
+	p := &ProjectV2FieldOption{}
+	p.GetDescription()
+	p = nil
+	p.GetDescription()
+}
+
+func TestProjectV2FieldOption_GetID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2FieldOption{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2FieldOption{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2FieldOption_GetName(tt *testing.T) {
+	tt.Parallel()
+	p := &ProjectV2FieldOption{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
 func TestProjectV2Item_GetArchivedAt(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue Timestamp
@@ -24622,6 +25057,17 @@ func TestProjectV2Item_GetID(tt *testing.T) {
 	p.GetID()
 }
 
+func TestProjectV2Item_GetItemURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2Item{ItemURL: &zeroValue}
+	p.GetItemURL()
+	p = &ProjectV2Item{}
+	p.GetItemURL()
+	p = nil
+	p.GetItemURL()
+}
+
 func TestProjectV2Item_GetNodeID(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -24644,6 +25090,17 @@ func TestProjectV2Item_GetProjectNodeID(tt *testing.T) {
 	p.GetProjectNodeID()
 }
 
+func TestProjectV2Item_GetProjectURL(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2Item{ProjectURL: &zeroValue}
+	p.GetProjectURL()
+	p = &ProjectV2Item{}
+	p.GetProjectURL()
+	p = nil
+	p.GetProjectURL()
+}
+
 func TestProjectV2Item_GetUpdatedAt(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue Timestamp
@@ -24722,6 +25179,61 @@ func TestProjectV2ItemEvent_GetSender(tt *testing.T) {
 	p.GetSender()
 }
 
+func TestProjectV2ItemFieldValue_GetID(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue int64
+	p := &ProjectV2ItemFieldValue{ID: &zeroValue}
+	p.GetID()
+	p = &ProjectV2ItemFieldValue{}
+	p.GetID()
+	p = nil
+	p.GetID()
+}
+
+func TestProjectV2ItemFieldValue_GetName(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2ItemFieldValue{Name: &zeroValue}
+	p.GetName()
+	p = &ProjectV2ItemFieldValue{}
+	p.GetName()
+	p = nil
+	p.GetName()
+}
+
+func TestProjectV2ItemFieldValue_GetType(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2ItemFieldValue{Type: &zeroValue}
+	p.GetType()
+	p = &ProjectV2ItemFieldValue{}
+	p.GetType()
+	p = nil
+	p.GetType()
+}
+
+func TestProjectV2TextContent_GetHTML(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2TextContent{HTML: &zeroValue}
+	p.GetHTML()
+	p = &ProjectV2TextContent{}
+	p.GetHTML()
+	p = nil
+	p.GetHTML()
+}
+
+func TestProjectV2TextContent_GetRaw(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue string
+	p := &ProjectV2TextContent{Raw: &zeroValue}
+	p.GetRaw()
+	p = &ProjectV2TextContent{}
+	p.GetRaw()
+	p = nil
+	p.GetRaw()
+}
+
 func TestProtection_GetAllowDeletions(tt *testing.T) {
 	tt.Parallel()
 	p := &Protection{}
@@ -30336,6 +30848,17 @@ func TestRepositoryContentResponse_GetContent(tt *testing.T) {
 	r.GetContent()
 }
 
+func TestRepositoryDiscussionListOptions_GetAnswered(tt *testing.T) {
+	tt.Parallel()
+	var zeroValue bool
+	r := &RepositoryDiscussionListOptions{Answered: &zeroValue}
+	r.GetAnswered()
+	r = &RepositoryDiscussionListOptions{}
+	r.GetAnswered()
+	r = nil
+	r.GetAnswered()
+}
+
 func TestRepositoryDispatchEvent_GetAction(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -38791,6 +39314,14 @@ func TestWorkflowJobEvent_GetWorkflowJob(tt *testing.T) {
 	w.GetWorkflowJob()
 }
 
+func TestWorkflowJobLog_GetJob(tt *testing.T) {
+	tt.Parallel()
+	w := &WorkflowJobLog{}
+	w.GetJob()
+	w = nil
+	w.GetJob()
+}
+
 func TestWorkflowJobRun_GetConclusion(tt *testing.T) {
 	tt.Parallel()
 	var zeroValue string
@@ -39470,6 +40001,14 @@ func TestWorkflowsRuleParameters_GetDoNotEnforceOnCreate(tt *testing.T) {
 	w.GetDoNotEnforceOnCreate()
 }
 
+func TestWorkflowStepLog_GetStep(tt *testing.T) {
+	tt.Parallel()
+	w := &WorkflowStepLog{}
+	w.GetStep()
+	w = nil
+	w.GetStep()
+}
+
 func TestWorkflowUsage_GetBillable(tt *testing.T) {
 	tt.Parallel()
 	w := &WorkflowUsage{}
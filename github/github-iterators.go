@@ -0,0 +1,7843 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-iterators; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package github
+
+import (
+	"context"
+	"iter"
+)
+
+// ListArtifactsIter returns an iterator that paginates through all results of ListArtifacts.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/artifacts#list-artifacts-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/artifacts
+func (s *ActionsService) ListArtifactsIter(ctx context.Context, owner, repo string, opts *ListArtifactsOptions) iter.Seq2[*Artifact, error] {
+	return func(yield func(*Artifact, error) bool) {
+		o := &ListArtifactsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListArtifacts(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Artifacts {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCacheUsageByRepoForOrgIter returns an iterator that paginates through all results of ListCacheUsageByRepoForOrg.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/cache#list-repositories-with-github-actions-cache-usage-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/cache/usage-by-repository
+func (s *ActionsService) ListCacheUsageByRepoForOrgIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*ActionsCacheUsage, error] {
+	return func(yield func(*ActionsCacheUsage, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCacheUsageByRepoForOrg(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.RepoCacheUsage {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCachesIter returns an iterator that paginates through all results of ListCaches.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/cache#list-github-actions-caches-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/caches
+func (s *ActionsService) ListCachesIter(ctx context.Context, owner, repo string, opts *ActionsCacheListOptions) iter.Seq2[*ActionsCache, error] {
+	return func(yield func(*ActionsCache, error) bool) {
+		o := &ActionsCacheListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCaches(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.ActionsCaches {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEnabledOrgsInEnterpriseIter returns an iterator that paginates through all results of ListEnabledOrgsInEnterprise.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/permissions#list-selected-organizations-enabled-for-github-actions-in-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/permissions/organizations
+func (s *ActionsService) ListEnabledOrgsInEnterpriseIter(ctx context.Context, owner string, opts *ListOptions) iter.Seq2[*Organization, error] {
+	return func(yield func(*Organization, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEnabledOrgsInEnterprise(ctx, owner, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Organizations {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEnabledReposInOrgIter returns an iterator that paginates through all results of ListEnabledReposInOrg.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/permissions#list-selected-repositories-enabled-for-github-actions-in-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/permissions/repositories
+func (s *ActionsService) ListEnabledReposInOrgIter(ctx context.Context, owner string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEnabledReposInOrg(ctx, owner, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEnvSecretsIter returns an iterator that paginates through all results of ListEnvSecrets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-server@3.7/rest/actions/secrets#list-environment-secrets
+//
+//meta:operation GET /repositories/{repository_id}/environments/{environment_name}/secrets
+func (s *ActionsService) ListEnvSecretsIter(ctx context.Context, repoID int, env string, opts *ListOptions) iter.Seq2[*Secret, error] {
+	return func(yield func(*Secret, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEnvSecrets(ctx, repoID, env, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Secrets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEnvVariablesIter returns an iterator that paginates through all results of ListEnvVariables.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-environment-variables
+//
+//meta:operation GET /repos/{owner}/{repo}/environments/{environment_name}/variables
+func (s *ActionsService) ListEnvVariablesIter(ctx context.Context, owner, repo, env string, opts *ListOptions) iter.Seq2[*ActionsVariable, error] {
+	return func(yield func(*ActionsVariable, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEnvVariables(ctx, owner, repo, env, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Variables {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListHostedRunnersIter returns an iterator that paginates through all results of ListHostedRunners.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/hosted-runners#list-github-hosted-runners-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/hosted-runners
+func (s *ActionsService) ListHostedRunnersIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*HostedRunner, error] {
+	return func(yield func(*HostedRunner, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListHostedRunners(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Runners {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrgSecretsIter returns an iterator that paginates through all results of ListOrgSecrets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/secrets#list-organization-secrets
+//
+//meta:operation GET /orgs/{org}/actions/secrets
+func (s *ActionsService) ListOrgSecretsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Secret, error] {
+	return func(yield func(*Secret, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrgSecrets(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Secrets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrgVariablesIter returns an iterator that paginates through all results of ListOrgVariables.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-organization-variables
+//
+//meta:operation GET /orgs/{org}/actions/variables
+func (s *ActionsService) ListOrgVariablesIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*ActionsVariable, error] {
+	return func(yield func(*ActionsVariable, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrgVariables(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Variables {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrganizationRunnerGroupsIter returns an iterator that paginates through all results of ListOrganizationRunnerGroups.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/self-hosted-runner-groups#list-self-hosted-runner-groups-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/runner-groups
+func (s *ActionsService) ListOrganizationRunnerGroupsIter(ctx context.Context, org string, opts *ListOrgRunnerGroupOptions) iter.Seq2[*RunnerGroup, error] {
+	return func(yield func(*RunnerGroup, error) bool) {
+		o := &ListOrgRunnerGroupOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrganizationRunnerGroups(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.RunnerGroups {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrganizationRunnersIter returns an iterator that paginates through all results of ListOrganizationRunners.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/self-hosted-runners#list-self-hosted-runners-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/runners
+func (s *ActionsService) ListOrganizationRunnersIter(ctx context.Context, org string, opts *ListRunnersOptions) iter.Seq2[*Runner, error] {
+	return func(yield func(*Runner, error) bool) {
+		o := &ListRunnersOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrganizationRunners(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Runners {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepoOrgSecretsIter returns an iterator that paginates through all results of ListRepoOrgSecrets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/secrets#list-repository-organization-secrets
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/organization-secrets
+func (s *ActionsService) ListRepoOrgSecretsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Secret, error] {
+	return func(yield func(*Secret, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepoOrgSecrets(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Secrets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepoOrgVariablesIter returns an iterator that paginates through all results of ListRepoOrgVariables.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-repository-organization-variables
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/organization-variables
+func (s *ActionsService) ListRepoOrgVariablesIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*ActionsVariable, error] {
+	return func(yield func(*ActionsVariable, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepoOrgVariables(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Variables {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepoSecretsIter returns an iterator that paginates through all results of ListRepoSecrets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/secrets#list-repository-secrets
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/secrets
+func (s *ActionsService) ListRepoSecretsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Secret, error] {
+	return func(yield func(*Secret, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepoSecrets(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Secrets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepoVariablesIter returns an iterator that paginates through all results of ListRepoVariables.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-repository-variables
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/variables
+func (s *ActionsService) ListRepoVariablesIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*ActionsVariable, error] {
+	return func(yield func(*ActionsVariable, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepoVariables(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Variables {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepositoriesSelfHostedRunnersAllowedInOrganizationIter returns an iterator that paginates through all results of ListRepositoriesSelfHostedRunnersAllowedInOrganization.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/permissions#list-repositories-allowed-to-use-self-hosted-runners-in-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/permissions/self-hosted-runners/repositories
+func (s *ActionsService) ListRepositoriesSelfHostedRunnersAllowedInOrganizationIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepositoriesSelfHostedRunnersAllowedInOrganization(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepositoryAccessRunnerGroupIter returns an iterator that paginates through all results of ListRepositoryAccessRunnerGroup.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/self-hosted-runner-groups#list-repository-access-to-a-self-hosted-runner-group-in-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/runner-groups/{runner_group_id}/repositories
+func (s *ActionsService) ListRepositoryAccessRunnerGroupIter(ctx context.Context, org string, groupID int64, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepositoryAccessRunnerGroup(ctx, org, groupID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepositoryWorkflowRunsIter returns an iterator that paginates through all results of ListRepositoryWorkflowRuns.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#list-workflow-runs-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs
+func (s *ActionsService) ListRepositoryWorkflowRunsIter(ctx context.Context, owner, repo string, opts *ListWorkflowRunsOptions) iter.Seq2[*WorkflowRun, error] {
+	return func(yield func(*WorkflowRun, error) bool) {
+		o := &ListWorkflowRunsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepositoryWorkflowRuns(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.WorkflowRuns {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRunnerGroupRunnersIter returns an iterator that paginates through all results of ListRunnerGroupRunners.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/self-hosted-runner-groups#list-self-hosted-runners-in-a-group-for-an-organization
+//
+//meta:operation GET /orgs/{org}/actions/runner-groups/{runner_group_id}/runners
+func (s *ActionsService) ListRunnerGroupRunnersIter(ctx context.Context, org string, groupID int64, opts *ListOptions) iter.Seq2[*Runner, error] {
+	return func(yield func(*Runner, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRunnerGroupRunners(ctx, org, groupID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Runners {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRunnersIter returns an iterator that paginates through all results of ListRunners.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/self-hosted-runners#list-self-hosted-runners-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runners
+func (s *ActionsService) ListRunnersIter(ctx context.Context, owner, repo string, opts *ListRunnersOptions) iter.Seq2[*Runner, error] {
+	return func(yield func(*Runner, error) bool) {
+		o := &ListRunnersOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRunners(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Runners {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListSelectedReposForOrgSecretIter returns an iterator that paginates through all results of ListSelectedReposForOrgSecret.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/secrets#list-selected-repositories-for-an-organization-secret
+//
+//meta:operation GET /orgs/{org}/actions/secrets/{secret_name}/repositories
+func (s *ActionsService) ListSelectedReposForOrgSecretIter(ctx context.Context, org, name string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListSelectedReposForOrgSecret(ctx, org, name, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListSelectedReposForOrgVariableIter returns an iterator that paginates through all results of ListSelectedReposForOrgVariable.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/variables#list-selected-repositories-for-an-organization-variable
+//
+//meta:operation GET /orgs/{org}/actions/variables/{name}/repositories
+func (s *ActionsService) ListSelectedReposForOrgVariableIter(ctx context.Context, org, name string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListSelectedReposForOrgVariable(ctx, org, name, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListWorkflowJobsIter returns an iterator that paginates through all results of ListWorkflowJobs.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-jobs#list-jobs-for-a-workflow-run
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/jobs
+func (s *ActionsService) ListWorkflowJobsIter(ctx context.Context, owner, repo string, runID int64, opts *ListWorkflowJobsOptions) iter.Seq2[*WorkflowJob, error] {
+	return func(yield func(*WorkflowJob, error) bool) {
+		o := &ListWorkflowJobsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListWorkflowJobs(ctx, owner, repo, runID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Jobs {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListWorkflowJobsAttemptIter returns an iterator that paginates through all results of ListWorkflowJobsAttempt.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-jobs#list-jobs-for-a-workflow-run-attempt
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/jobs
+func (s *ActionsService) ListWorkflowJobsAttemptIter(ctx context.Context, owner, repo string, runID, attemptNumber int64, opts *ListOptions) iter.Seq2[*WorkflowJob, error] {
+	return func(yield func(*WorkflowJob, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListWorkflowJobsAttempt(ctx, owner, repo, runID, attemptNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Jobs {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListWorkflowRunArtifactsIter returns an iterator that paginates through all results of ListWorkflowRunArtifacts.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/artifacts#list-workflow-run-artifacts
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}/artifacts
+func (s *ActionsService) ListWorkflowRunArtifactsIter(ctx context.Context, owner, repo string, runID int64, opts *ListOptions) iter.Seq2[*Artifact, error] {
+	return func(yield func(*Artifact, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListWorkflowRunArtifacts(ctx, owner, repo, runID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Artifacts {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListWorkflowRunsByFileNameIter returns an iterator that paginates through all results of ListWorkflowRunsByFileName.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#list-workflow-runs-for-a-workflow
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs
+func (s *ActionsService) ListWorkflowRunsByFileNameIter(ctx context.Context, owner, repo, workflowFileName string, opts *ListWorkflowRunsOptions) iter.Seq2[*WorkflowRun, error] {
+	return func(yield func(*WorkflowRun, error) bool) {
+		o := &ListWorkflowRunsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListWorkflowRunsByFileName(ctx, owner, repo, workflowFileName, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.WorkflowRuns {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListWorkflowRunsByIDIter returns an iterator that paginates through all results of ListWorkflowRunsByID.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#list-workflow-runs-for-a-workflow
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs
+func (s *ActionsService) ListWorkflowRunsByIDIter(ctx context.Context, owner, repo string, workflowID int64, opts *ListWorkflowRunsOptions) iter.Seq2[*WorkflowRun, error] {
+	return func(yield func(*WorkflowRun, error) bool) {
+		o := &ListWorkflowRunsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListWorkflowRunsByID(ctx, owner, repo, workflowID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.WorkflowRuns {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListWorkflowsIter returns an iterator that paginates through all results of ListWorkflows.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflows#list-repository-workflows
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/workflows
+func (s *ActionsService) ListWorkflowsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Workflow, error] {
+	return func(yield func(*Workflow, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListWorkflows(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Workflows {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEventsIter returns an iterator that paginates through all results of ListEvents.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-public-events
+//
+//meta:operation GET /events
+func (s *ActivityService) ListEventsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*Event, error] {
+	return func(yield func(*Event, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEvents(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEventsForOrganizationIter returns an iterator that paginates through all results of ListEventsForOrganization.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-public-organization-events
+//
+//meta:operation GET /orgs/{org}/events
+func (s *ActivityService) ListEventsForOrganizationIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Event, error] {
+	return func(yield func(*Event, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEventsForOrganization(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEventsForRepoNetworkIter returns an iterator that paginates through all results of ListEventsForRepoNetwork.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-public-events-for-a-network-of-repositories
+//
+//meta:operation GET /networks/{owner}/{repo}/events
+func (s *ActivityService) ListEventsForRepoNetworkIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Event, error] {
+	return func(yield func(*Event, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEventsForRepoNetwork(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEventsPerformedByUserIter returns an iterator that paginates through all results of ListEventsPerformedByUser.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-events-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-public-events-for-a-user
+//
+//meta:operation GET /users/{username}/events
+//meta:operation GET /users/{username}/events/public
+func (s *ActivityService) ListEventsPerformedByUserIter(ctx context.Context, user string, publicOnly bool, opts *ListOptions) iter.Seq2[*Event, error] {
+	return func(yield func(*Event, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEventsPerformedByUser(ctx, user, publicOnly, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEventsReceivedByUserIter returns an iterator that paginates through all results of ListEventsReceivedByUser.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-events-received-by-the-authenticated-user
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-public-events-received-by-a-user
+//
+//meta:operation GET /users/{username}/received_events
+//meta:operation GET /users/{username}/received_events/public
+func (s *ActivityService) ListEventsReceivedByUserIter(ctx context.Context, user string, publicOnly bool, opts *ListOptions) iter.Seq2[*Event, error] {
+	return func(yield func(*Event, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEventsReceivedByUser(ctx, user, publicOnly, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIssueEventsForRepositoryIter returns an iterator that paginates through all results of ListIssueEventsForRepository.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/events#list-issue-events-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/events
+func (s *ActivityService) ListIssueEventsForRepositoryIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*IssueEvent, error] {
+	return func(yield func(*IssueEvent, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListIssueEventsForRepository(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListNotificationsIter returns an iterator that paginates through all results of ListNotifications.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#list-notifications-for-the-authenticated-user
+//
+//meta:operation GET /notifications
+func (s *ActivityService) ListNotificationsIter(ctx context.Context, opts *NotificationListOptions) iter.Seq2[*Notification, error] {
+	return func(yield func(*Notification, error) bool) {
+		o := &NotificationListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListNotifications(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepositoryEventsIter returns an iterator that paginates through all results of ListRepositoryEvents.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-repository-events
+//
+//meta:operation GET /repos/{owner}/{repo}/events
+func (s *ActivityService) ListRepositoryEventsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Event, error] {
+	return func(yield func(*Event, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepositoryEvents(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepositoryNotificationsIter returns an iterator that paginates through all results of ListRepositoryNotifications.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/notifications#list-repository-notifications-for-the-authenticated-user
+//
+//meta:operation GET /repos/{owner}/{repo}/notifications
+func (s *ActivityService) ListRepositoryNotificationsIter(ctx context.Context, owner, repo string, opts *NotificationListOptions) iter.Seq2[*Notification, error] {
+	return func(yield func(*Notification, error) bool) {
+		o := &NotificationListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepositoryNotifications(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListStargazersIter returns an iterator that paginates through all results of ListStargazers.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/starring#list-stargazers
+//
+//meta:operation GET /repos/{owner}/{repo}/stargazers
+func (s *ActivityService) ListStargazersIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Stargazer, error] {
+	return func(yield func(*Stargazer, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListStargazers(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListStarredIter returns an iterator that paginates through all results of ListStarred.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/starring#list-repositories-starred-by-a-user
+// GitHub API docs: https://docs.github.com/rest/activity/starring#list-repositories-starred-by-the-authenticated-user
+//
+//meta:operation GET /user/starred
+//meta:operation GET /users/{username}/starred
+func (s *ActivityService) ListStarredIter(ctx context.Context, user string, opts *ActivityListStarredOptions) iter.Seq2[*StarredRepository, error] {
+	return func(yield func(*StarredRepository, error) bool) {
+		o := &ActivityListStarredOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListStarred(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserEventsForOrganizationIter returns an iterator that paginates through all results of ListUserEventsForOrganization.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/events#list-organization-events-for-the-authenticated-user
+//
+//meta:operation GET /users/{username}/events/orgs/{org}
+func (s *ActivityService) ListUserEventsForOrganizationIter(ctx context.Context, org, user string, opts *ListOptions) iter.Seq2[*Event, error] {
+	return func(yield func(*Event, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserEventsForOrganization(ctx, org, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListWatchedIter returns an iterator that paginates through all results of ListWatched.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/watching#list-repositories-watched-by-a-user
+// GitHub API docs: https://docs.github.com/rest/activity/watching#list-repositories-watched-by-the-authenticated-user
+//
+//meta:operation GET /user/subscriptions
+//meta:operation GET /users/{username}/subscriptions
+func (s *ActivityService) ListWatchedIter(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListWatched(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListWatchersIter returns an iterator that paginates through all results of ListWatchers.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/activity/watching#list-watchers
+//
+//meta:operation GET /repos/{owner}/{repo}/subscribers
+func (s *ActivityService) ListWatchersIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListWatchers(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListHookDeliveriesIter returns an iterator that paginates through all results of ListHookDeliveries.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/webhooks#list-deliveries-for-an-app-webhook
+//
+//meta:operation GET /app/hook/deliveries
+func (s *AppsService) ListHookDeliveriesIter(ctx context.Context, opts *ListCursorOptions) iter.Seq2[*HookDelivery, error] {
+	return func(yield func(*HookDelivery, error) bool) {
+		o := &ListCursorOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListHookDeliveries(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.Cursor = resp.Cursor
+			case resp.After != "":
+				o.After = resp.After
+			case resp.NextPageToken != "":
+				o.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListInstallationRequestsIter returns an iterator that paginates through all results of ListInstallationRequests.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/apps#list-installation-requests-for-the-authenticated-app
+//
+//meta:operation GET /app/installation-requests
+func (s *AppsService) ListInstallationRequestsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*InstallationRequest, error] {
+	return func(yield func(*InstallationRequest, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListInstallationRequests(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListInstallationsIter returns an iterator that paginates through all results of ListInstallations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/apps#list-installations-for-the-authenticated-app
+//
+//meta:operation GET /app/installations
+func (s *AppsService) ListInstallationsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*Installation, error] {
+	return func(yield func(*Installation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListInstallations(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListReposIter returns an iterator that paginates through all results of ListRepos.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/installations#list-repositories-accessible-to-the-app-installation
+//
+//meta:operation GET /installation/repositories
+func (s *AppsService) ListReposIter(ctx context.Context, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepos(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserInstallationsIter returns an iterator that paginates through all results of ListUserInstallations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/installations#list-app-installations-accessible-to-the-user-access-token
+//
+//meta:operation GET /user/installations
+func (s *AppsService) ListUserInstallationsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*Installation, error] {
+	return func(yield func(*Installation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserInstallations(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserReposIter returns an iterator that paginates through all results of ListUserRepos.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/installations#list-repositories-accessible-to-the-user-access-token
+//
+//meta:operation GET /user/installations/{installation_id}/repositories
+func (s *AppsService) ListUserReposIter(ctx context.Context, id int64, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserRepos(ctx, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCheckRunAnnotationsIter returns an iterator that paginates through all results of ListCheckRunAnnotations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/checks/runs#list-check-run-annotations
+//
+//meta:operation GET /repos/{owner}/{repo}/check-runs/{check_run_id}/annotations
+func (s *ChecksService) ListCheckRunAnnotationsIter(ctx context.Context, owner, repo string, checkRunID int64, opts *ListOptions) iter.Seq2[*CheckRunAnnotation, error] {
+	return func(yield func(*CheckRunAnnotation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCheckRunAnnotations(ctx, owner, repo, checkRunID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCheckRunsCheckSuiteIter returns an iterator that paginates through all results of ListCheckRunsCheckSuite.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/checks/runs#list-check-runs-in-a-check-suite
+//
+//meta:operation GET /repos/{owner}/{repo}/check-suites/{check_suite_id}/check-runs
+func (s *ChecksService) ListCheckRunsCheckSuiteIter(ctx context.Context, owner, repo string, checkSuiteID int64, opts *ListCheckRunsOptions) iter.Seq2[*CheckRun, error] {
+	return func(yield func(*CheckRun, error) bool) {
+		o := &ListCheckRunsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCheckRunsCheckSuite(ctx, owner, repo, checkSuiteID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.CheckRuns {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCheckRunsForRefIter returns an iterator that paginates through all results of ListCheckRunsForRef.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/checks/runs#list-check-runs-for-a-git-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-runs
+func (s *ChecksService) ListCheckRunsForRefIter(ctx context.Context, owner, repo, ref string, opts *ListCheckRunsOptions) iter.Seq2[*CheckRun, error] {
+	return func(yield func(*CheckRun, error) bool) {
+		o := &ListCheckRunsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCheckRunsForRef(ctx, owner, repo, ref, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.CheckRuns {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCheckSuitesForRefIter returns an iterator that paginates through all results of ListCheckSuitesForRef.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/checks/suites#list-check-suites-for-a-git-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-suites
+func (s *ChecksService) ListCheckSuitesForRefIter(ctx context.Context, owner, repo, ref string, opts *ListCheckSuiteOptions) iter.Seq2[*CheckSuite, error] {
+	return func(yield func(*CheckSuite, error) bool) {
+		o := &ListCheckSuiteOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCheckSuitesForRef(ctx, owner, repo, ref, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.CheckSuites {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAcceptedAssignmentsIter returns an iterator that paginates through all results of ListAcceptedAssignments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/classroom/classroom#list-accepted-assignments-for-an-assignment
+//
+//meta:operation GET /assignments/{assignment_id}/accepted_assignments
+func (s *ClassroomService) ListAcceptedAssignmentsIter(ctx context.Context, assignmentID int64, opts *ListOptions) iter.Seq2[*AcceptedAssignment, error] {
+	return func(yield func(*AcceptedAssignment, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAcceptedAssignments(ctx, assignmentID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListClassroomAssignmentsIter returns an iterator that paginates through all results of ListClassroomAssignments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/classroom/classroom#list-assignments-for-a-classroom
+//
+//meta:operation GET /classrooms/{classroom_id}/assignments
+func (s *ClassroomService) ListClassroomAssignmentsIter(ctx context.Context, classroomID int64, opts *ListOptions) iter.Seq2[*ClassroomAssignment, error] {
+	return func(yield func(*ClassroomAssignment, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListClassroomAssignments(ctx, classroomID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListClassroomsIter returns an iterator that paginates through all results of ListClassrooms.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/classroom/classroom#list-classrooms
+//
+//meta:operation GET /classrooms
+func (s *ClassroomService) ListClassroomsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*Classroom, error] {
+	return func(yield func(*Classroom, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListClassrooms(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAlertInstancesIter returns an iterator that paginates through all results of ListAlertInstances.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/code-scanning/code-scanning#list-instances-of-a-code-scanning-alert
+//
+//meta:operation GET /repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/instances
+func (s *CodeScanningService) ListAlertInstancesIter(ctx context.Context, owner, repo string, id int64, opts *AlertInstancesListOptions) iter.Seq2[*MostRecentInstance, error] {
+	return func(yield func(*MostRecentInstance, error) bool) {
+		o := &AlertInstancesListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAlertInstances(ctx, owner, repo, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAlertsForOrgIter returns an iterator that paginates through all results of ListAlertsForOrg.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/code-scanning/code-scanning#list-code-scanning-alerts-for-an-organization
+//
+//meta:operation GET /orgs/{org}/code-scanning/alerts
+func (s *CodeScanningService) ListAlertsForOrgIter(ctx context.Context, org string, opts *AlertListOptions) iter.Seq2[*Alert, error] {
+	return func(yield func(*Alert, error) bool) {
+		o := &AlertListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAlertsForOrg(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAlertsForRepoIter returns an iterator that paginates through all results of ListAlertsForRepo.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/code-scanning/code-scanning#list-code-scanning-alerts-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/code-scanning/alerts
+func (s *CodeScanningService) ListAlertsForRepoIter(ctx context.Context, owner, repo string, opts *AlertListOptions) iter.Seq2[*Alert, error] {
+	return func(yield func(*Alert, error) bool) {
+		o := &AlertListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAlertsForRepo(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAnalysesForRepoIter returns an iterator that paginates through all results of ListAnalysesForRepo.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/code-scanning/code-scanning#list-code-scanning-analyses-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/code-scanning/analyses
+func (s *CodeScanningService) ListAnalysesForRepoIter(ctx context.Context, owner, repo string, opts *AnalysesListOptions) iter.Seq2[*ScanningAnalysis, error] {
+	return func(yield func(*ScanningAnalysis, error) bool) {
+		o := &AnalysesListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAnalysesForRepo(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIter returns an iterator that paginates through all results of List.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/codespaces/codespaces#list-codespaces-for-the-authenticated-user
+//
+//meta:operation GET /user/codespaces
+func (s *CodespacesService) ListIter(ctx context.Context, opts *ListCodespacesOptions) iter.Seq2[*Codespace, error] {
+	return func(yield func(*Codespace, error) bool) {
+		o := &ListCodespacesOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.List(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Codespaces {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListInRepoIter returns an iterator that paginates through all results of ListInRepo.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/codespaces/codespaces#list-codespaces-in-a-repository-for-the-authenticated-user
+//
+//meta:operation GET /repos/{owner}/{repo}/codespaces
+func (s *CodespacesService) ListInRepoIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Codespace, error] {
+	return func(yield func(*Codespace, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListInRepo(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Codespaces {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrgSecretsIter returns an iterator that paginates through all results of ListOrgSecrets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/codespaces/organization-secrets#list-organization-secrets
+//
+//meta:operation GET /orgs/{org}/codespaces/secrets
+func (s *CodespacesService) ListOrgSecretsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Secret, error] {
+	return func(yield func(*Secret, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrgSecrets(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Secrets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepoSecretsIter returns an iterator that paginates through all results of ListRepoSecrets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/codespaces/repository-secrets#list-repository-secrets
+//
+//meta:operation GET /repos/{owner}/{repo}/codespaces/secrets
+func (s *CodespacesService) ListRepoSecretsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Secret, error] {
+	return func(yield func(*Secret, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepoSecrets(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Secrets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListSelectedReposForOrgSecretIter returns an iterator that paginates through all results of ListSelectedReposForOrgSecret.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/codespaces/organization-secrets#list-selected-repositories-for-an-organization-secret
+//
+//meta:operation GET /orgs/{org}/codespaces/secrets/{secret_name}/repositories
+func (s *CodespacesService) ListSelectedReposForOrgSecretIter(ctx context.Context, org, name string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListSelectedReposForOrgSecret(ctx, org, name, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListSelectedReposForUserSecretIter returns an iterator that paginates through all results of ListSelectedReposForUserSecret.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/codespaces/secrets#list-selected-repositories-for-a-user-secret
+//
+//meta:operation GET /user/codespaces/secrets/{secret_name}/repositories
+func (s *CodespacesService) ListSelectedReposForUserSecretIter(ctx context.Context, name string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListSelectedReposForUserSecret(ctx, name, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserSecretsIter returns an iterator that paginates through all results of ListUserSecrets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/codespaces/secrets#list-secrets-for-the-authenticated-user
+//
+//meta:operation GET /user/codespaces/secrets
+func (s *CodespacesService) ListUserSecretsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*Secret, error] {
+	return func(yield func(*Secret, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserSecrets(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Secrets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCopilotEnterpriseSeatsIter returns an iterator that paginates through all results of ListCopilotEnterpriseSeats.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/copilot/copilot-user-management#list-all-copilot-seat-assignments-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/copilot/billing/seats
+func (s *CopilotService) ListCopilotEnterpriseSeatsIter(ctx context.Context, enterprise string, opts *ListOptions) iter.Seq2[*CopilotSeatDetails, error] {
+	return func(yield func(*CopilotSeatDetails, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCopilotEnterpriseSeats(ctx, enterprise, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Seats {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCopilotSeatsIter returns an iterator that paginates through all results of ListCopilotSeats.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/copilot/copilot-user-management#list-all-copilot-seat-assignments-for-an-organization
+//
+//meta:operation GET /orgs/{org}/copilot/billing/seats
+func (s *CopilotService) ListCopilotSeatsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*CopilotSeatDetails, error] {
+	return func(yield func(*CopilotSeatDetails, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCopilotSeats(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Seats {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrgAlertsIter returns an iterator that paginates through all results of ListOrgAlerts.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/dependabot/alerts#list-dependabot-alerts-for-an-organization
+//
+//meta:operation GET /orgs/{org}/dependabot/alerts
+func (s *DependabotService) ListOrgAlertsIter(ctx context.Context, org string, opts *ListAlertsOptions) iter.Seq2[*DependabotAlert, error] {
+	return func(yield func(*DependabotAlert, error) bool) {
+		o := &ListAlertsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrgAlerts(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrgSecretsIter returns an iterator that paginates through all results of ListOrgSecrets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/dependabot/secrets#list-organization-secrets
+//
+//meta:operation GET /orgs/{org}/dependabot/secrets
+func (s *DependabotService) ListOrgSecretsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Secret, error] {
+	return func(yield func(*Secret, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrgSecrets(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Secrets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepoAlertsIter returns an iterator that paginates through all results of ListRepoAlerts.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/dependabot/alerts#list-dependabot-alerts-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/dependabot/alerts
+func (s *DependabotService) ListRepoAlertsIter(ctx context.Context, owner, repo string, opts *ListAlertsOptions) iter.Seq2[*DependabotAlert, error] {
+	return func(yield func(*DependabotAlert, error) bool) {
+		o := &ListAlertsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepoAlerts(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepoSecretsIter returns an iterator that paginates through all results of ListRepoSecrets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/dependabot/secrets#list-repository-secrets
+//
+//meta:operation GET /repos/{owner}/{repo}/dependabot/secrets
+func (s *DependabotService) ListRepoSecretsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Secret, error] {
+	return func(yield func(*Secret, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepoSecrets(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Secrets {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListSelectedReposForOrgSecretIter returns an iterator that paginates through all results of ListSelectedReposForOrgSecret.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/dependabot/secrets#list-selected-repositories-for-an-organization-secret
+//
+//meta:operation GET /orgs/{org}/dependabot/secrets/{secret_name}/repositories
+func (s *DependabotService) ListSelectedReposForOrgSecretIter(ctx context.Context, org, name string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListSelectedReposForOrgSecret(ctx, org, name, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Repositories {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIter returns an iterator that paginates through all results of List.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) ListIter(ctx context.Context, owner, repo string, opts *RepositoryDiscussionListOptions) iter.Seq2[*Discussion, error] {
+	return func(yield func(*Discussion, error) bool) {
+		o := &RepositoryDiscussionListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.List(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.After != "":
+				o.DiscussionCursorOptions.After = resp.After
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCategoriesIter returns an iterator that paginates through all results of ListCategories.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) ListCategoriesIter(ctx context.Context, owner, repo string, opts *DiscussionCursorOptions) iter.Seq2[*DiscussionCategory, error] {
+	return func(yield func(*DiscussionCategory, error) bool) {
+		o := &DiscussionCursorOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCategories(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.After != "":
+				o.After = resp.After
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommentRepliesIter returns an iterator that paginates through all results of ListCommentReplies.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) ListCommentRepliesIter(ctx context.Context, commentNodeID string, opts *DiscussionCursorOptions) iter.Seq2[*CommentDiscussion, error] {
+	return func(yield func(*CommentDiscussion, error) bool) {
+		o := &DiscussionCursorOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCommentReplies(ctx, commentNodeID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.After != "":
+				o.After = resp.After
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommentsIter returns an iterator that paginates through all results of ListComments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+//
+//meta:operation POST /graphql
+func (s *DiscussionsService) ListCommentsIter(ctx context.Context, owner, repo string, number int, opts *DiscussionCursorOptions) iter.Seq2[*CommentDiscussion, error] {
+	return func(yield func(*CommentDiscussion, error) bool) {
+		o := &DiscussionCursorOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListComments(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.After != "":
+				o.After = resp.After
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEnterpriseNetworkConfigurationsIter returns an iterator that paginates through all results of ListEnterpriseNetworkConfigurations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/enterprise-admin/network-configurations#list-hosted-compute-network-configurations-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/network-configurations
+func (s *EnterpriseService) ListEnterpriseNetworkConfigurationsIter(ctx context.Context, enterprise string, opts *ListOptions) iter.Seq2[*NetworkConfiguration, error] {
+	return func(yield func(*NetworkConfiguration, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEnterpriseNetworkConfigurations(ctx, enterprise, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.NetworkConfigurations {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListHostedRunnersIter returns an iterator that paginates through all results of ListHostedRunners.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/hosted-runners#list-github-hosted-runners-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/hosted-runners
+func (s *EnterpriseService) ListHostedRunnersIter(ctx context.Context, enterprise string, opts *ListOptions) iter.Seq2[*HostedRunner, error] {
+	return func(yield func(*HostedRunner, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListHostedRunners(ctx, enterprise, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Runners {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrganizationAccessRunnerGroupIter returns an iterator that paginates through all results of ListOrganizationAccessRunnerGroup.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runner-groups#list-organization-access-to-a-self-hosted-runner-group-in-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations
+func (s *EnterpriseService) ListOrganizationAccessRunnerGroupIter(ctx context.Context, enterprise string, groupID int64, opts *ListOptions) iter.Seq2[*Organization, error] {
+	return func(yield func(*Organization, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrganizationAccessRunnerGroup(ctx, enterprise, groupID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Organizations {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRunnerGroupRunnersIter returns an iterator that paginates through all results of ListRunnerGroupRunners.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runner-groups#list-self-hosted-runners-in-a-group-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners
+func (s *EnterpriseService) ListRunnerGroupRunnersIter(ctx context.Context, enterprise string, groupID int64, opts *ListOptions) iter.Seq2[*Runner, error] {
+	return func(yield func(*Runner, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRunnerGroupRunners(ctx, enterprise, groupID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Runners {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRunnerGroupsIter returns an iterator that paginates through all results of ListRunnerGroups.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runner-groups#list-self-hosted-runner-groups-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/runner-groups
+func (s *EnterpriseService) ListRunnerGroupsIter(ctx context.Context, enterprise string, opts *ListEnterpriseRunnerGroupOptions) iter.Seq2[*EnterpriseRunnerGroup, error] {
+	return func(yield func(*EnterpriseRunnerGroup, error) bool) {
+		o := &ListEnterpriseRunnerGroupOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRunnerGroups(ctx, enterprise, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.RunnerGroups {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRunnersIter returns an iterator that paginates through all results of ListRunners.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/actions/self-hosted-runners#list-self-hosted-runners-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/actions/runners
+func (s *EnterpriseService) ListRunnersIter(ctx context.Context, enterprise string, opts *ListRunnersOptions) iter.Seq2[*Runner, error] {
+	return func(yield func(*Runner, error) bool) {
+		o := &ListRunnersOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRunners(ctx, enterprise, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Runners {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIter returns an iterator that paginates through all results of List.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/gists/gists#list-gists-for-a-user
+// GitHub API docs: https://docs.github.com/rest/gists/gists#list-gists-for-the-authenticated-user
+//
+//meta:operation GET /gists
+//meta:operation GET /users/{username}/gists
+func (s *GistsService) ListIter(ctx context.Context, user string, opts *GistListOptions) iter.Seq2[*Gist, error] {
+	return func(yield func(*Gist, error) bool) {
+		o := &GistListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.List(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAllIter returns an iterator that paginates through all results of ListAll.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/gists/gists#list-public-gists
+//
+//meta:operation GET /gists/public
+func (s *GistsService) ListAllIter(ctx context.Context, opts *GistListOptions) iter.Seq2[*Gist, error] {
+	return func(yield func(*Gist, error) bool) {
+		o := &GistListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAll(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommentsIter returns an iterator that paginates through all results of ListComments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/gists/comments#list-gist-comments
+//
+//meta:operation GET /gists/{gist_id}/comments
+func (s *GistsService) ListCommentsIter(ctx context.Context, gistID string, opts *ListOptions) iter.Seq2[*GistComment, error] {
+	return func(yield func(*GistComment, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListComments(ctx, gistID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommitsIter returns an iterator that paginates through all results of ListCommits.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/gists/gists#list-gist-commits
+//
+//meta:operation GET /gists/{gist_id}/commits
+func (s *GistsService) ListCommitsIter(ctx context.Context, id string, opts *ListOptions) iter.Seq2[*GistCommit, error] {
+	return func(yield func(*GistCommit, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCommits(ctx, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListForksIter returns an iterator that paginates through all results of ListForks.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/gists/gists#list-gist-forks
+//
+//meta:operation GET /gists/{gist_id}/forks
+func (s *GistsService) ListForksIter(ctx context.Context, id string, opts *ListOptions) iter.Seq2[*GistFork, error] {
+	return func(yield func(*GistFork, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListForks(ctx, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListStarredIter returns an iterator that paginates through all results of ListStarred.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/gists/gists#list-starred-gists
+//
+//meta:operation GET /gists/starred
+func (s *GistsService) ListStarredIter(ctx context.Context, opts *GistListOptions) iter.Seq2[*Gist, error] {
+	return func(yield func(*Gist, error) bool) {
+		o := &GistListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListStarred(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListMatchingRefsIter returns an iterator that paginates through all results of ListMatchingRefs.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/git/refs#list-matching-references
+//
+//meta:operation GET /repos/{owner}/{repo}/git/matching-refs/{ref}
+func (s *GitService) ListMatchingRefsIter(ctx context.Context, owner, repo string, opts *ReferenceListOptions) iter.Seq2[*Reference, error] {
+	return func(yield func(*Reference, error) bool) {
+		o := &ReferenceListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListMatchingRefs(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIter returns an iterator that paginates through all results of List.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/issues#list-issues-assigned-to-the-authenticated-user
+// GitHub API docs: https://docs.github.com/rest/issues/issues#list-user-account-issues-assigned-to-the-authenticated-user
+//
+//meta:operation GET /issues
+//meta:operation GET /user/issues
+func (s *IssuesService) ListIter(ctx context.Context, all bool, opts *IssueListOptions) iter.Seq2[*Issue, error] {
+	return func(yield func(*Issue, error) bool) {
+		o := &IssueListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.List(ctx, all, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAssigneesIter returns an iterator that paginates through all results of ListAssignees.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/assignees#list-assignees
+//
+//meta:operation GET /repos/{owner}/{repo}/assignees
+func (s *IssuesService) ListAssigneesIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAssignees(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListByOrgIter returns an iterator that paginates through all results of ListByOrg.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/issues#list-organization-issues-assigned-to-the-authenticated-user
+//
+//meta:operation GET /orgs/{org}/issues
+func (s *IssuesService) ListByOrgIter(ctx context.Context, org string, opts *IssueListOptions) iter.Seq2[*Issue, error] {
+	return func(yield func(*Issue, error) bool) {
+		o := &IssueListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListByOrg(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListByRepoIter returns an iterator that paginates through all results of ListByRepo.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/issues#list-repository-issues
+//
+//meta:operation GET /repos/{owner}/{repo}/issues
+func (s *IssuesService) ListByRepoIter(ctx context.Context, owner, repo string, opts *IssueListByRepoOptions) iter.Seq2[*Issue, error] {
+	return func(yield func(*Issue, error) bool) {
+		o := &IssueListByRepoOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListByRepo(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommentsIter returns an iterator that paginates through all results of ListComments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/comments#list-issue-comments
+// GitHub API docs: https://docs.github.com/rest/issues/comments#list-issue-comments-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/comments
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/comments
+func (s *IssuesService) ListCommentsIter(ctx context.Context, owner, repo string, number int, opts *IssueListCommentsOptions) iter.Seq2[*IssueComment, error] {
+	return func(yield func(*IssueComment, error) bool) {
+		o := &IssueListCommentsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListComments(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIssueEventsIter returns an iterator that paginates through all results of ListIssueEvents.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/events#list-issue-events
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/events
+func (s *IssuesService) ListIssueEventsIter(ctx context.Context, owner, repo string, number int, opts *ListOptions) iter.Seq2[*IssueEvent, error] {
+	return func(yield func(*IssueEvent, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListIssueEvents(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIssueTimelineIter returns an iterator that paginates through all results of ListIssueTimeline.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/timeline#list-timeline-events-for-an-issue
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/timeline
+func (s *IssuesService) ListIssueTimelineIter(ctx context.Context, owner, repo string, number int, opts *ListOptions) iter.Seq2[*Timeline, error] {
+	return func(yield func(*Timeline, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListIssueTimeline(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListLabelsIter returns an iterator that paginates through all results of ListLabels.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/labels#list-labels-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/labels
+func (s *IssuesService) ListLabelsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Label, error] {
+	return func(yield func(*Label, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListLabels(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListLabelsByIssueIter returns an iterator that paginates through all results of ListLabelsByIssue.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/labels#list-labels-for-an-issue
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/labels
+func (s *IssuesService) ListLabelsByIssueIter(ctx context.Context, owner, repo string, number int, opts *ListOptions) iter.Seq2[*Label, error] {
+	return func(yield func(*Label, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListLabelsByIssue(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListLabelsForMilestoneIter returns an iterator that paginates through all results of ListLabelsForMilestone.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/labels#list-labels-for-issues-in-a-milestone
+//
+//meta:operation GET /repos/{owner}/{repo}/milestones/{milestone_number}/labels
+func (s *IssuesService) ListLabelsForMilestoneIter(ctx context.Context, owner, repo string, number int, opts *ListOptions) iter.Seq2[*Label, error] {
+	return func(yield func(*Label, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListLabelsForMilestone(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListMilestonesIter returns an iterator that paginates through all results of ListMilestones.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/milestones#list-milestones
+//
+//meta:operation GET /repos/{owner}/{repo}/milestones
+func (s *IssuesService) ListMilestonesIter(ctx context.Context, owner, repo string, opts *MilestoneListOptions) iter.Seq2[*Milestone, error] {
+	return func(yield func(*Milestone, error) bool) {
+		o := &MilestoneListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListMilestones(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepositoryEventsIter returns an iterator that paginates through all results of ListRepositoryEvents.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/events#list-issue-events-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/events
+func (s *IssuesService) ListRepositoryEventsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*IssueEvent, error] {
+	return func(yield func(*IssueEvent, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepositoryEvents(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListMarketplacePurchasesForUserIter returns an iterator that paginates through all results of ListMarketplacePurchasesForUser.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/marketplace#list-subscriptions-for-the-authenticated-user
+// GitHub API docs: https://docs.github.com/rest/apps/marketplace#list-subscriptions-for-the-authenticated-user-stubbed
+//
+//meta:operation GET /user/marketplace_purchases
+//meta:operation GET /user/marketplace_purchases/stubbed
+func (s *MarketplaceService) ListMarketplacePurchasesForUserIter(ctx context.Context, opts *ListOptions) iter.Seq2[*MarketplacePurchase, error] {
+	return func(yield func(*MarketplacePurchase, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListMarketplacePurchasesForUser(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPlanAccountsForPlanIter returns an iterator that paginates through all results of ListPlanAccountsForPlan.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/marketplace#list-accounts-for-a-plan
+// GitHub API docs: https://docs.github.com/rest/apps/marketplace#list-accounts-for-a-plan-stubbed
+//
+//meta:operation GET /marketplace_listing/plans/{plan_id}/accounts
+//meta:operation GET /marketplace_listing/stubbed/plans/{plan_id}/accounts
+func (s *MarketplaceService) ListPlanAccountsForPlanIter(ctx context.Context, planID int64, opts *ListOptions) iter.Seq2[*MarketplacePlanAccount, error] {
+	return func(yield func(*MarketplacePlanAccount, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPlanAccountsForPlan(ctx, planID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPlansIter returns an iterator that paginates through all results of ListPlans.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/apps/marketplace#list-plans
+// GitHub API docs: https://docs.github.com/rest/apps/marketplace#list-plans-stubbed
+//
+//meta:operation GET /marketplace_listing/plans
+//meta:operation GET /marketplace_listing/stubbed/plans
+func (s *MarketplaceService) ListPlansIter(ctx context.Context, opts *ListOptions) iter.Seq2[*MarketplacePlan, error] {
+	return func(yield func(*MarketplacePlan, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPlans(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListMigrationsIter returns an iterator that paginates through all results of ListMigrations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/orgs#list-organization-migrations
+//
+//meta:operation GET /orgs/{org}/migrations
+func (s *MigrationService) ListMigrationsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Migration, error] {
+	return func(yield func(*Migration, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListMigrations(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserMigrationsIter returns an iterator that paginates through all results of ListUserMigrations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/migrations/users#list-user-migrations
+//
+//meta:operation GET /user/migrations
+func (s *MigrationService) ListUserMigrationsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*UserMigration, error] {
+	return func(yield func(*UserMigration, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserMigrations(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIter returns an iterator that paginates through all results of List.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/orgs#list-organizations-for-a-user
+// GitHub API docs: https://docs.github.com/rest/orgs/orgs#list-organizations-for-the-authenticated-user
+//
+//meta:operation GET /user/orgs
+//meta:operation GET /users/{username}/orgs
+func (s *OrganizationsService) ListIter(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*Organization, error] {
+	return func(yield func(*Organization, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.List(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAllIter returns an iterator that paginates through all results of ListAll.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/orgs#list-organizations
+//
+//meta:operation GET /organizations
+func (s *OrganizationsService) ListAllIter(ctx context.Context, opts *OrganizationsListOptions) iter.Seq2[*Organization, error] {
+	return func(yield func(*Organization, error) bool) {
+		o := &OrganizationsListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAll(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Since = int64(resp.NextPage)
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAttestationsIter returns an iterator that paginates through all results of ListAttestations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/attestations#list-attestations
+//
+//meta:operation GET /orgs/{org}/attestations/{subject_digest}
+func (s *OrganizationsService) ListAttestationsIter(ctx context.Context, org, subjectDigest string, opts *ListOptions) iter.Seq2[*Attestation, error] {
+	return func(yield func(*Attestation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAttestations(ctx, org, subjectDigest, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Attestations {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListBlockedUsersIter returns an iterator that paginates through all results of ListBlockedUsers.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/blocking#list-users-blocked-by-an-organization
+//
+//meta:operation GET /orgs/{org}/blocks
+func (s *OrganizationsService) ListBlockedUsersIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListBlockedUsers(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCredentialAuthorizationsIter returns an iterator that paginates through all results of ListCredentialAuthorizations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/orgs/orgs#list-saml-sso-authorizations-for-an-organization
+//
+//meta:operation GET /orgs/{org}/credential-authorizations
+func (s *OrganizationsService) ListCredentialAuthorizationsIter(ctx context.Context, org string, opts *CredentialAuthorizationsListOptions) iter.Seq2[*CredentialAuthorization, error] {
+	return func(yield func(*CredentialAuthorization, error) bool) {
+		o := &CredentialAuthorizationsListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCredentialAuthorizations(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCustomPropertyValuesIter returns an iterator that paginates through all results of ListCustomPropertyValues.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/custom-properties#list-custom-property-values-for-organization-repositories
+//
+//meta:operation GET /orgs/{org}/properties/values
+func (s *OrganizationsService) ListCustomPropertyValuesIter(ctx context.Context, org string, opts *ListCustomPropertyValuesOptions) iter.Seq2[*RepoCustomPropertyValue, error] {
+	return func(yield func(*RepoCustomPropertyValue, error) bool) {
+		o := &ListCustomPropertyValuesOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCustomPropertyValues(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListFailedOrgInvitationsIter returns an iterator that paginates through all results of ListFailedOrgInvitations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/members#list-failed-organization-invitations
+//
+//meta:operation GET /orgs/{org}/failed_invitations
+func (s *OrganizationsService) ListFailedOrgInvitationsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Invitation, error] {
+	return func(yield func(*Invitation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListFailedOrgInvitations(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListFineGrainedPersonalAccessTokensIter returns an iterator that paginates through all results of ListFineGrainedPersonalAccessTokens.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/personal-access-tokens#list-fine-grained-personal-access-tokens-with-access-to-organization-resources
+//
+//meta:operation GET /orgs/{org}/personal-access-tokens
+func (s *OrganizationsService) ListFineGrainedPersonalAccessTokensIter(ctx context.Context, org string, opts *ListFineGrainedPATOptions) iter.Seq2[*PersonalAccessToken, error] {
+	return func(yield func(*PersonalAccessToken, error) bool) {
+		o := &ListFineGrainedPATOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListFineGrainedPersonalAccessTokens(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListHookDeliveriesIter returns an iterator that paginates through all results of ListHookDeliveries.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/webhooks#list-deliveries-for-an-organization-webhook
+//
+//meta:operation GET /orgs/{org}/hooks/{hook_id}/deliveries
+func (s *OrganizationsService) ListHookDeliveriesIter(ctx context.Context, org string, id int64, opts *ListCursorOptions) iter.Seq2[*HookDelivery, error] {
+	return func(yield func(*HookDelivery, error) bool) {
+		o := &ListCursorOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListHookDeliveries(ctx, org, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.Cursor = resp.Cursor
+			case resp.After != "":
+				o.After = resp.After
+			case resp.NextPageToken != "":
+				o.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListHooksIter returns an iterator that paginates through all results of ListHooks.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/webhooks#list-organization-webhooks
+//
+//meta:operation GET /orgs/{org}/hooks
+func (s *OrganizationsService) ListHooksIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Hook, error] {
+	return func(yield func(*Hook, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListHooks(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListInstallationsIter returns an iterator that paginates through all results of ListInstallations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/orgs#list-app-installations-for-an-organization
+//
+//meta:operation GET /orgs/{org}/installations
+func (s *OrganizationsService) ListInstallationsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Installation, error] {
+	return func(yield func(*Installation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListInstallations(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Installations {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListMembersIter returns an iterator that paginates through all results of ListMembers.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/members#list-organization-members
+// GitHub API docs: https://docs.github.com/rest/orgs/members#list-public-organization-members
+//
+//meta:operation GET /orgs/{org}/members
+//meta:operation GET /orgs/{org}/public_members
+func (s *OrganizationsService) ListMembersIter(ctx context.Context, org string, opts *ListMembersOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListMembersOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListMembers(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListNetworkConfigurationsIter returns an iterator that paginates through all results of ListNetworkConfigurations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/network-configurations#list-hosted-compute-network-configurations-for-an-organization
+//
+//meta:operation GET /orgs/{org}/settings/network-configurations
+func (s *OrganizationsService) ListNetworkConfigurationsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*NetworkConfiguration, error] {
+	return func(yield func(*NetworkConfiguration, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListNetworkConfigurations(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.NetworkConfigurations {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrgInvitationTeamsIter returns an iterator that paginates through all results of ListOrgInvitationTeams.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/members#list-organization-invitation-teams
+//
+//meta:operation GET /orgs/{org}/invitations/{invitation_id}/teams
+func (s *OrganizationsService) ListOrgInvitationTeamsIter(ctx context.Context, org, invitationID string, opts *ListOptions) iter.Seq2[*Team, error] {
+	return func(yield func(*Team, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrgInvitationTeams(ctx, org, invitationID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrgMembershipsIter returns an iterator that paginates through all results of ListOrgMemberships.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/members#list-organization-memberships-for-the-authenticated-user
+//
+//meta:operation GET /user/memberships/orgs
+func (s *OrganizationsService) ListOrgMembershipsIter(ctx context.Context, opts *ListOrgMembershipsOptions) iter.Seq2[*Membership, error] {
+	return func(yield func(*Membership, error) bool) {
+		o := &ListOrgMembershipsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrgMemberships(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOutsideCollaboratorsIter returns an iterator that paginates through all results of ListOutsideCollaborators.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/outside-collaborators#list-outside-collaborators-for-an-organization
+//
+//meta:operation GET /orgs/{org}/outside_collaborators
+func (s *OrganizationsService) ListOutsideCollaboratorsIter(ctx context.Context, org string, opts *ListOutsideCollaboratorsOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListOutsideCollaboratorsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOutsideCollaborators(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPackagesIter returns an iterator that paginates through all results of ListPackages.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/packages/packages#list-packages-for-an-organization
+//
+//meta:operation GET /orgs/{org}/packages
+func (s *OrganizationsService) ListPackagesIter(ctx context.Context, org string, opts *PackageListOptions) iter.Seq2[*Package, error] {
+	return func(yield func(*Package, error) bool) {
+		o := &PackageListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPackages(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPendingOrgInvitationsIter returns an iterator that paginates through all results of ListPendingOrgInvitations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/members#list-pending-organization-invitations
+//
+//meta:operation GET /orgs/{org}/invitations
+func (s *OrganizationsService) ListPendingOrgInvitationsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Invitation, error] {
+	return func(yield func(*Invitation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPendingOrgInvitations(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTeamsAssignedToOrgRoleIter returns an iterator that paginates through all results of ListTeamsAssignedToOrgRole.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/organization-roles#list-teams-that-are-assigned-to-an-organization-role
+//
+//meta:operation GET /orgs/{org}/organization-roles/{role_id}/teams
+func (s *OrganizationsService) ListTeamsAssignedToOrgRoleIter(ctx context.Context, org string, roleID int64, opts *ListOptions) iter.Seq2[*Team, error] {
+	return func(yield func(*Team, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTeamsAssignedToOrgRole(ctx, org, roleID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUsersAssignedToOrgRoleIter returns an iterator that paginates through all results of ListUsersAssignedToOrgRole.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/orgs/organization-roles#list-users-that-are-assigned-to-an-organization-role
+//
+//meta:operation GET /orgs/{org}/organization-roles/{role_id}/users
+func (s *OrganizationsService) ListUsersAssignedToOrgRoleIter(ctx context.Context, org string, roleID int64, opts *ListOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUsersAssignedToOrgRole(ctx, org, roleID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrganizationProjectFieldsIter returns an iterator that paginates through all results of ListOrganizationProjectFields.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#list-project-fields-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/fields
+func (s *ProjectsService) ListOrganizationProjectFieldsIter(ctx context.Context, org string, projectNumber int, opts *ListCursorOptions) iter.Seq2[*ProjectV2Field, error] {
+	return func(yield func(*ProjectV2Field, error) bool) {
+		o := &ListCursorOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrganizationProjectFields(ctx, org, projectNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.Cursor = resp.Cursor
+			case resp.After != "":
+				o.After = resp.After
+			case resp.NextPageToken != "":
+				o.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrganizationProjectItemsIter returns an iterator that paginates through all results of ListOrganizationProjectItems.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#list-items-for-an-organization-owned-project
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/items
+func (s *ProjectsService) ListOrganizationProjectItemsIter(ctx context.Context, org string, projectNumber int, opts *ListProjectItemsOptions) iter.Seq2[*ProjectV2Item, error] {
+	return func(yield func(*ProjectV2Item, error) bool) {
+		o := &ListProjectItemsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrganizationProjectItems(ctx, org, projectNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListOrganizationProjectsIter returns an iterator that paginates through all results of ListOrganizationProjects.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/projects#list-projects-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2
+func (s *ProjectsService) ListOrganizationProjectsIter(ctx context.Context, org string, opts *ListProjectsOptions) iter.Seq2[*ProjectV2, error] {
+	return func(yield func(*ProjectV2, error) bool) {
+		o := &ListProjectsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListOrganizationProjects(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserProjectFieldsIter returns an iterator that paginates through all results of ListUserProjectFields.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#list-project-fields-for-user
+//
+//meta:operation GET /users/{username}/projectsV2/{project_number}/fields
+func (s *ProjectsService) ListUserProjectFieldsIter(ctx context.Context, username string, projectNumber int, opts *ListCursorOptions) iter.Seq2[*ProjectV2Field, error] {
+	return func(yield func(*ProjectV2Field, error) bool) {
+		o := &ListCursorOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserProjectFields(ctx, username, projectNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.Cursor = resp.Cursor
+			case resp.After != "":
+				o.After = resp.After
+			case resp.NextPageToken != "":
+				o.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserProjectItemsIter returns an iterator that paginates through all results of ListUserProjectItems.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#list-items-for-a-user-owned-project
+//
+//meta:operation GET /users/{username}/projectsV2/{project_number}/items
+func (s *ProjectsService) ListUserProjectItemsIter(ctx context.Context, username string, projectNumber int, opts *ListProjectItemsOptions) iter.Seq2[*ProjectV2Item, error] {
+	return func(yield func(*ProjectV2Item, error) bool) {
+		o := &ListProjectItemsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserProjectItems(ctx, username, projectNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserProjectsIter returns an iterator that paginates through all results of ListUserProjects.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/projects#list-projects-for-user
+//
+//meta:operation GET /users/{username}/projectsV2
+func (s *ProjectsService) ListUserProjectsIter(ctx context.Context, username string, opts *ListProjectsOptions) iter.Seq2[*ProjectV2, error] {
+	return func(yield func(*ProjectV2, error) bool) {
+		o := &ListProjectsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserProjects(ctx, username, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIter returns an iterator that paginates through all results of List.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#list-pull-requests
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls
+func (s *PullRequestsService) ListIter(ctx context.Context, owner, repo string, opts *PullRequestListOptions) iter.Seq2[*PullRequest, error] {
+	return func(yield func(*PullRequest, error) bool) {
+		o := &PullRequestListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.List(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommentsIter returns an iterator that paginates through all results of ListComments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/comments#list-review-comments-in-a-repository
+// GitHub API docs: https://docs.github.com/rest/pulls/comments#list-review-comments-on-a-pull-request
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/comments
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/comments
+func (s *PullRequestsService) ListCommentsIter(ctx context.Context, owner, repo string, number int, opts *PullRequestListCommentsOptions) iter.Seq2[*PullRequestComment, error] {
+	return func(yield func(*PullRequestComment, error) bool) {
+		o := &PullRequestListCommentsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListComments(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommitsIter returns an iterator that paginates through all results of ListCommits.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#list-commits-on-a-pull-request
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/commits
+func (s *PullRequestsService) ListCommitsIter(ctx context.Context, owner, repo string, number int, opts *ListOptions) iter.Seq2[*RepositoryCommit, error] {
+	return func(yield func(*RepositoryCommit, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCommits(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListFilesIter returns an iterator that paginates through all results of ListFiles.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/pulls#list-pull-requests-files
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/files
+func (s *PullRequestsService) ListFilesIter(ctx context.Context, owner, repo string, number int, opts *ListOptions) iter.Seq2[*CommitFile, error] {
+	return func(yield func(*CommitFile, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListFiles(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPullRequestsWithCommitIter returns an iterator that paginates through all results of ListPullRequestsWithCommit.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#list-pull-requests-associated-with-a-commit
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{commit_sha}/pulls
+func (s *PullRequestsService) ListPullRequestsWithCommitIter(ctx context.Context, owner, repo, sha string, opts *ListOptions) iter.Seq2[*PullRequest, error] {
+	return func(yield func(*PullRequest, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPullRequestsWithCommit(ctx, owner, repo, sha, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListReviewCommentsIter returns an iterator that paginates through all results of ListReviewComments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/reviews#list-comments-for-a-pull-request-review
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/comments
+func (s *PullRequestsService) ListReviewCommentsIter(ctx context.Context, owner, repo string, number int, reviewID int64, opts *ListOptions) iter.Seq2[*PullRequestComment, error] {
+	return func(yield func(*PullRequestComment, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListReviewComments(ctx, owner, repo, number, reviewID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListReviewsIter returns an iterator that paginates through all results of ListReviews.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/pulls/reviews#list-reviews-for-a-pull-request
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/{pull_number}/reviews
+func (s *PullRequestsService) ListReviewsIter(ctx context.Context, owner, repo string, number int, opts *ListOptions) iter.Seq2[*PullRequestReview, error] {
+	return func(yield func(*PullRequestReview, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListReviews(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommentReactionsIter returns an iterator that paginates through all results of ListCommentReactions.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-a-commit-comment
+//
+//meta:operation GET /repos/{owner}/{repo}/comments/{comment_id}/reactions
+func (s *ReactionsService) ListCommentReactionsIter(ctx context.Context, owner, repo string, id int64, opts *ListReactionOptions) iter.Seq2[*Reaction, error] {
+	return func(yield func(*Reaction, error) bool) {
+		o := &ListReactionOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCommentReactions(ctx, owner, repo, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIssueCommentReactionsIter returns an iterator that paginates through all results of ListIssueCommentReactions.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-an-issue-comment
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/comments/{comment_id}/reactions
+func (s *ReactionsService) ListIssueCommentReactionsIter(ctx context.Context, owner, repo string, id int64, opts *ListReactionOptions) iter.Seq2[*Reaction, error] {
+	return func(yield func(*Reaction, error) bool) {
+		o := &ListReactionOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListIssueCommentReactions(ctx, owner, repo, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIssueReactionsIter returns an iterator that paginates through all results of ListIssueReactions.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-an-issue
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/reactions
+func (s *ReactionsService) ListIssueReactionsIter(ctx context.Context, owner, repo string, number int, opts *ListReactionOptions) iter.Seq2[*Reaction, error] {
+	return func(yield func(*Reaction, error) bool) {
+		o := &ListReactionOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListIssueReactions(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPullRequestCommentReactionsIter returns an iterator that paginates through all results of ListPullRequestCommentReactions.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-a-pull-request-review-comment
+//
+//meta:operation GET /repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions
+func (s *ReactionsService) ListPullRequestCommentReactionsIter(ctx context.Context, owner, repo string, id int64, opts *ListReactionOptions) iter.Seq2[*Reaction, error] {
+	return func(yield func(*Reaction, error) bool) {
+		o := &ListReactionOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPullRequestCommentReactions(ctx, owner, repo, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListReleaseReactionsIter returns an iterator that paginates through all results of ListReleaseReactions.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-a-release
+//
+//meta:operation GET /repos/{owner}/{repo}/releases/{release_id}/reactions
+func (s *ReactionsService) ListReleaseReactionsIter(ctx context.Context, owner, repo string, releaseID int64, opts *ListReactionOptions) iter.Seq2[*Reaction, error] {
+	return func(yield func(*Reaction, error) bool) {
+		o := &ListReactionOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListReleaseReactions(ctx, owner, repo, releaseID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTeamDiscussionCommentReactionsIter returns an iterator that paginates through all results of ListTeamDiscussionCommentReactions.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-a-team-discussion-comment-legacy
+//
+//meta:operation GET /teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}/reactions
+func (s *ReactionsService) ListTeamDiscussionCommentReactionsIter(ctx context.Context, teamID int64, discussionNumber, commentNumber int, opts *ListReactionOptions) iter.Seq2[*Reaction, error] {
+	return func(yield func(*Reaction, error) bool) {
+		o := &ListReactionOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTeamDiscussionCommentReactions(ctx, teamID, discussionNumber, commentNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTeamDiscussionReactionsIter returns an iterator that paginates through all results of ListTeamDiscussionReactions.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/reactions/reactions#list-reactions-for-a-team-discussion-legacy
+//
+//meta:operation GET /teams/{team_id}/discussions/{discussion_number}/reactions
+func (s *ReactionsService) ListTeamDiscussionReactionsIter(ctx context.Context, teamID int64, discussionNumber int, opts *ListReactionOptions) iter.Seq2[*Reaction, error] {
+	return func(yield func(*Reaction, error) bool) {
+		o := &ListReactionOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTeamDiscussionReactions(ctx, teamID, discussionNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIter returns an iterator that paginates through all results of List.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repositories-for-a-user
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repositories-for-the-authenticated-user
+//
+//meta:operation GET /user/repos
+//meta:operation GET /users/{username}/repos
+func (s *RepositoriesService) ListIter(ctx context.Context, user string, opts *RepositoryListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &RepositoryListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.List(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAllIter returns an iterator that paginates through all results of ListAll.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-public-repositories
+//
+//meta:operation GET /repositories
+func (s *RepositoriesService) ListAllIter(ctx context.Context, opts *RepositoryListAllOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &RepositoryListAllOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAll(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Since = int64(resp.NextPage)
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAttestationsIter returns an iterator that paginates through all results of ListAttestations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-attestations
+//
+//meta:operation GET /repos/{owner}/{repo}/attestations/{subject_digest}
+func (s *RepositoriesService) ListAttestationsIter(ctx context.Context, owner, repo, subjectDigest string, opts *ListOptions) iter.Seq2[*Attestation, error] {
+	return func(yield func(*Attestation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAttestations(ctx, owner, repo, subjectDigest, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Attestations {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAutolinksIter returns an iterator that paginates through all results of ListAutolinks.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/autolinks#get-all-autolinks-of-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/autolinks
+func (s *RepositoriesService) ListAutolinksIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Autolink, error] {
+	return func(yield func(*Autolink, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAutolinks(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListBranchesIter returns an iterator that paginates through all results of ListBranches.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/branches/branches#list-branches
+//
+//meta:operation GET /repos/{owner}/{repo}/branches
+func (s *RepositoriesService) ListBranchesIter(ctx context.Context, owner, repo string, opts *BranchListOptions) iter.Seq2[*Branch, error] {
+	return func(yield func(*Branch, error) bool) {
+		o := &BranchListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListBranches(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListByAuthenticatedUserIter returns an iterator that paginates through all results of ListByAuthenticatedUser.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repositories-for-the-authenticated-user
+//
+//meta:operation GET /user/repos
+func (s *RepositoriesService) ListByAuthenticatedUserIter(ctx context.Context, opts *RepositoryListByAuthenticatedUserOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &RepositoryListByAuthenticatedUserOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListByAuthenticatedUser(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListByOrgIter returns an iterator that paginates through all results of ListByOrg.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-organization-repositories
+//
+//meta:operation GET /orgs/{org}/repos
+func (s *RepositoriesService) ListByOrgIter(ctx context.Context, org string, opts *RepositoryListByOrgOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &RepositoryListByOrgOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListByOrg(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListByUserIter returns an iterator that paginates through all results of ListByUser.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repositories-for-a-user
+//
+//meta:operation GET /users/{username}/repos
+func (s *RepositoriesService) ListByUserIter(ctx context.Context, user string, opts *RepositoryListByUserOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &RepositoryListByUserOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListByUser(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCollaboratorsIter returns an iterator that paginates through all results of ListCollaborators.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/collaborators/collaborators#list-repository-collaborators
+//
+//meta:operation GET /repos/{owner}/{repo}/collaborators
+func (s *RepositoriesService) ListCollaboratorsIter(ctx context.Context, owner, repo string, opts *ListCollaboratorsOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListCollaboratorsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCollaborators(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommentsIter returns an iterator that paginates through all results of ListComments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/comments#list-commit-comments-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/comments
+func (s *RepositoriesService) ListCommentsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*RepositoryComment, error] {
+	return func(yield func(*RepositoryComment, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListComments(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommitCommentsIter returns an iterator that paginates through all results of ListCommitComments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/comments#list-commit-comments
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{commit_sha}/comments
+func (s *RepositoriesService) ListCommitCommentsIter(ctx context.Context, owner, repo, sha string, opts *ListOptions) iter.Seq2[*RepositoryComment, error] {
+	return func(yield func(*RepositoryComment, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCommitComments(ctx, owner, repo, sha, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommitsIter returns an iterator that paginates through all results of ListCommits.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#list-commits
+//
+//meta:operation GET /repos/{owner}/{repo}/commits
+func (s *RepositoriesService) ListCommitsIter(ctx context.Context, owner, repo string, opts *CommitsListOptions) iter.Seq2[*RepositoryCommit, error] {
+	return func(yield func(*RepositoryCommit, error) bool) {
+		o := &CommitsListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListCommits(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListContributorsIter returns an iterator that paginates through all results of ListContributors.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-contributors
+//
+//meta:operation GET /repos/{owner}/{repo}/contributors
+func (s *RepositoriesService) ListContributorsIter(ctx context.Context, owner, repository string, opts *ListContributorsOptions) iter.Seq2[*Contributor, error] {
+	return func(yield func(*Contributor, error) bool) {
+		o := &ListContributorsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListContributors(ctx, owner, repository, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListDeploymentStatusesIter returns an iterator that paginates through all results of ListDeploymentStatuses.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/deployments/statuses#list-deployment-statuses
+//
+//meta:operation GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
+func (s *RepositoriesService) ListDeploymentStatusesIter(ctx context.Context, owner, repo string, deployment int64, opts *ListOptions) iter.Seq2[*DeploymentStatus, error] {
+	return func(yield func(*DeploymentStatus, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListDeploymentStatuses(ctx, owner, repo, deployment, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListDeploymentsIter returns an iterator that paginates through all results of ListDeployments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/deployments/deployments#list-deployments
+//
+//meta:operation GET /repos/{owner}/{repo}/deployments
+func (s *RepositoriesService) ListDeploymentsIter(ctx context.Context, owner, repo string, opts *DeploymentsListOptions) iter.Seq2[*Deployment, error] {
+	return func(yield func(*Deployment, error) bool) {
+		o := &DeploymentsListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListDeployments(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEnvironmentsIter returns an iterator that paginates through all results of ListEnvironments.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/deployments/environments#list-environments
+//
+//meta:operation GET /repos/{owner}/{repo}/environments
+func (s *RepositoriesService) ListEnvironmentsIter(ctx context.Context, owner, repo string, opts *EnvironmentListOptions) iter.Seq2[*Environment, error] {
+	return func(yield func(*Environment, error) bool) {
+		o := &EnvironmentListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEnvironments(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Environments {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListForksIter returns an iterator that paginates through all results of ListForks.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/forks#list-forks
+//
+//meta:operation GET /repos/{owner}/{repo}/forks
+func (s *RepositoriesService) ListForksIter(ctx context.Context, owner, repo string, opts *RepositoryListForksOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &RepositoryListForksOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListForks(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListHookDeliveriesIter returns an iterator that paginates through all results of ListHookDeliveries.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/webhooks#list-deliveries-for-a-repository-webhook
+//
+//meta:operation GET /repos/{owner}/{repo}/hooks/{hook_id}/deliveries
+func (s *RepositoriesService) ListHookDeliveriesIter(ctx context.Context, owner, repo string, id int64, opts *ListCursorOptions) iter.Seq2[*HookDelivery, error] {
+	return func(yield func(*HookDelivery, error) bool) {
+		o := &ListCursorOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListHookDeliveries(ctx, owner, repo, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.Cursor = resp.Cursor
+			case resp.After != "":
+				o.After = resp.After
+			case resp.NextPageToken != "":
+				o.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListHooksIter returns an iterator that paginates through all results of ListHooks.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/webhooks#list-repository-webhooks
+//
+//meta:operation GET /repos/{owner}/{repo}/hooks
+func (s *RepositoriesService) ListHooksIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Hook, error] {
+	return func(yield func(*Hook, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListHooks(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListInvitationsIter returns an iterator that paginates through all results of ListInvitations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/collaborators/invitations#list-repository-invitations
+//
+//meta:operation GET /repos/{owner}/{repo}/invitations
+func (s *RepositoriesService) ListInvitationsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*RepositoryInvitation, error] {
+	return func(yield func(*RepositoryInvitation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListInvitations(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListKeysIter returns an iterator that paginates through all results of ListKeys.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/deploy-keys/deploy-keys#list-deploy-keys
+//
+//meta:operation GET /repos/{owner}/{repo}/keys
+func (s *RepositoriesService) ListKeysIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Key, error] {
+	return func(yield func(*Key, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListKeys(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPagesBuildsIter returns an iterator that paginates through all results of ListPagesBuilds.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/pages/pages#list-github-pages-builds
+//
+//meta:operation GET /repos/{owner}/{repo}/pages/builds
+func (s *RepositoriesService) ListPagesBuildsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*PagesBuild, error] {
+	return func(yield func(*PagesBuild, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPagesBuilds(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPreReceiveHooksIter returns an iterator that paginates through all results of ListPreReceiveHooks.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-server@3.17/rest/enterprise-admin/repo-pre-receive-hooks#list-pre-receive-hooks-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/pre-receive-hooks
+func (s *RepositoriesService) ListPreReceiveHooksIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*PreReceiveHook, error] {
+	return func(yield func(*PreReceiveHook, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPreReceiveHooks(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListReleaseAssetsIter returns an iterator that paginates through all results of ListReleaseAssets.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/releases/assets#list-release-assets
+//
+//meta:operation GET /repos/{owner}/{repo}/releases/{release_id}/assets
+func (s *RepositoriesService) ListReleaseAssetsIter(ctx context.Context, owner, repo string, id int64, opts *ListOptions) iter.Seq2[*ReleaseAsset, error] {
+	return func(yield func(*ReleaseAsset, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListReleaseAssets(ctx, owner, repo, id, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListReleasesIter returns an iterator that paginates through all results of ListReleases.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/releases/releases#list-releases
+//
+//meta:operation GET /repos/{owner}/{repo}/releases
+func (s *RepositoriesService) ListReleasesIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*RepositoryRelease, error] {
+	return func(yield func(*RepositoryRelease, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListReleases(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepositoryActivitiesIter returns an iterator that paginates through all results of ListRepositoryActivities.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-activities
+//
+//meta:operation GET /repos/{owner}/{repo}/activity
+func (s *RepositoriesService) ListRepositoryActivitiesIter(ctx context.Context, owner, repo string, opts *ListRepositoryActivityOptions) iter.Seq2[*RepositoryActivity, error] {
+	return func(yield func(*RepositoryActivity, error) bool) {
+		o := &ListRepositoryActivityOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListRepositoryActivities(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.After != "":
+				o.After = resp.After
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListStatusesIter returns an iterator that paginates through all results of ListStatuses.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/statuses#list-commit-statuses-for-a-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}/statuses
+func (s *RepositoriesService) ListStatusesIter(ctx context.Context, owner, repo, ref string, opts *ListOptions) iter.Seq2[*RepoStatus, error] {
+	return func(yield func(*RepoStatus, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListStatuses(ctx, owner, repo, ref, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTagsIter returns an iterator that paginates through all results of ListTags.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-tags
+//
+//meta:operation GET /repos/{owner}/{repo}/tags
+func (s *RepositoriesService) ListTagsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*RepositoryTag, error] {
+	return func(yield func(*RepositoryTag, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTags(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTeamsIter returns an iterator that paginates through all results of ListTeams.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/repos#list-repository-teams
+//
+//meta:operation GET /repos/{owner}/{repo}/teams
+func (s *RepositoriesService) ListTeamsIter(ctx context.Context, owner, repo string, opts *ListOptions) iter.Seq2[*Team, error] {
+	return func(yield func(*Team, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTeams(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAlertsForEnterpriseIter returns an iterator that paginates through all results of ListAlertsForEnterprise.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/secret-scanning/secret-scanning#list-secret-scanning-alerts-for-an-enterprise
+//
+//meta:operation GET /enterprises/{enterprise}/secret-scanning/alerts
+func (s *SecretScanningService) ListAlertsForEnterpriseIter(ctx context.Context, enterprise string, opts *SecretScanningAlertListOptions) iter.Seq2[*SecretScanningAlert, error] {
+	return func(yield func(*SecretScanningAlert, error) bool) {
+		o := &SecretScanningAlertListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAlertsForEnterprise(ctx, enterprise, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAlertsForOrgIter returns an iterator that paginates through all results of ListAlertsForOrg.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/secret-scanning/secret-scanning#list-secret-scanning-alerts-for-an-organization
+//
+//meta:operation GET /orgs/{org}/secret-scanning/alerts
+func (s *SecretScanningService) ListAlertsForOrgIter(ctx context.Context, org string, opts *SecretScanningAlertListOptions) iter.Seq2[*SecretScanningAlert, error] {
+	return func(yield func(*SecretScanningAlert, error) bool) {
+		o := &SecretScanningAlertListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAlertsForOrg(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAlertsForRepoIter returns an iterator that paginates through all results of ListAlertsForRepo.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/secret-scanning/secret-scanning#list-secret-scanning-alerts-for-a-repository
+//
+//meta:operation GET /repos/{owner}/{repo}/secret-scanning/alerts
+func (s *SecretScanningService) ListAlertsForRepoIter(ctx context.Context, owner, repo string, opts *SecretScanningAlertListOptions) iter.Seq2[*SecretScanningAlert, error] {
+	return func(yield func(*SecretScanningAlert, error) bool) {
+		o := &SecretScanningAlertListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAlertsForRepo(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListLocationsForAlertIter returns an iterator that paginates through all results of ListLocationsForAlert.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/secret-scanning/secret-scanning#list-locations-for-a-secret-scanning-alert
+//
+//meta:operation GET /repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}/locations
+func (s *SecretScanningService) ListLocationsForAlertIter(ctx context.Context, owner, repo string, number int64, opts *ListOptions) iter.Seq2[*SecretScanningAlertLocation, error] {
+	return func(yield func(*SecretScanningAlertLocation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListLocationsForAlert(ctx, owner, repo, number, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListGlobalSecurityAdvisoriesIter returns an iterator that paginates through all results of ListGlobalSecurityAdvisories.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/global-advisories#list-global-security-advisories
+//
+//meta:operation GET /advisories
+func (s *SecurityAdvisoriesService) ListGlobalSecurityAdvisoriesIter(ctx context.Context, opts *ListGlobalSecurityAdvisoriesOptions) iter.Seq2[*GlobalSecurityAdvisory, error] {
+	return func(yield func(*GlobalSecurityAdvisory, error) bool) {
+		o := &ListGlobalSecurityAdvisoriesOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListGlobalSecurityAdvisories(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepositorySecurityAdvisoriesIter returns an iterator that paginates through all results of ListRepositorySecurityAdvisories.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opt is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/repository-advisories#list-repository-security-advisories
+//
+//meta:operation GET /repos/{owner}/{repo}/security-advisories
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesIter(ctx context.Context, owner, repo string, opt *ListRepositorySecurityAdvisoriesOptions) iter.Seq2[*SecurityAdvisory, error] {
+	return func(yield func(*SecurityAdvisory, error) bool) {
+		o := &ListRepositorySecurityAdvisoriesOptions{}
+		if opt != nil {
+			*o = *opt
+		}
+		for {
+			result, resp, err := s.ListRepositorySecurityAdvisories(ctx, owner, repo, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListRepositorySecurityAdvisoriesForOrgIter returns an iterator that paginates through all results of ListRepositorySecurityAdvisoriesForOrg.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opt is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/security-advisories/repository-advisories#list-repository-security-advisories-for-an-organization
+//
+//meta:operation GET /orgs/{org}/security-advisories
+func (s *SecurityAdvisoriesService) ListRepositorySecurityAdvisoriesForOrgIter(ctx context.Context, org string, opt *ListRepositorySecurityAdvisoriesOptions) iter.Seq2[*SecurityAdvisory, error] {
+	return func(yield func(*SecurityAdvisory, error) bool) {
+		o := &ListRepositorySecurityAdvisoriesOptions{}
+		if opt != nil {
+			*o = *opt
+		}
+		for {
+			result, resp, err := s.ListRepositorySecurityAdvisoriesForOrg(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListByIssueIter returns an iterator that paginates through all results of ListByIssue.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/issues/sub-issues#list-sub-issues
+//
+//meta:operation GET /repos/{owner}/{repo}/issues/{issue_number}/sub_issues
+func (s *SubIssueService) ListByIssueIter(ctx context.Context, owner, repo string, issueNumber int64, opts *IssueListOptions) iter.Seq2[*SubIssue, error] {
+	return func(yield func(*SubIssue, error) bool) {
+		o := &IssueListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListByIssue(ctx, owner, repo, issueNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListChildTeamsByParentIDIter returns an iterator that paginates through all results of ListChildTeamsByParentID.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/teams#list-child-teams
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/teams
+func (s *TeamsService) ListChildTeamsByParentIDIter(ctx context.Context, orgID, teamID int64, opts *ListOptions) iter.Seq2[*Team, error] {
+	return func(yield func(*Team, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListChildTeamsByParentID(ctx, orgID, teamID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListChildTeamsByParentSlugIter returns an iterator that paginates through all results of ListChildTeamsByParentSlug.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/teams#list-child-teams
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/teams
+func (s *TeamsService) ListChildTeamsByParentSlugIter(ctx context.Context, org, slug string, opts *ListOptions) iter.Seq2[*Team, error] {
+	return func(yield func(*Team, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListChildTeamsByParentSlug(ctx, org, slug, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommentsByIDIter returns an iterator that paginates through all results of ListCommentsByID.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// options is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/discussion-comments#list-discussion-comments
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments
+func (s *TeamsService) ListCommentsByIDIter(ctx context.Context, orgID, teamID int64, discussionNumber int, options *DiscussionCommentListOptions) iter.Seq2[*DiscussionComment, error] {
+	return func(yield func(*DiscussionComment, error) bool) {
+		o := &DiscussionCommentListOptions{}
+		if options != nil {
+			*o = *options
+		}
+		for {
+			result, resp, err := s.ListCommentsByID(ctx, orgID, teamID, discussionNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListCommentsBySlugIter returns an iterator that paginates through all results of ListCommentsBySlug.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// options is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/discussion-comments#list-discussion-comments
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments
+func (s *TeamsService) ListCommentsBySlugIter(ctx context.Context, org, slug string, discussionNumber int, options *DiscussionCommentListOptions) iter.Seq2[*DiscussionComment, error] {
+	return func(yield func(*DiscussionComment, error) bool) {
+		o := &DiscussionCommentListOptions{}
+		if options != nil {
+			*o = *options
+		}
+		for {
+			result, resp, err := s.ListCommentsBySlug(ctx, org, slug, discussionNumber, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListDiscussionsByIDIter returns an iterator that paginates through all results of ListDiscussionsByID.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/discussions#list-discussions
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/discussions
+func (s *TeamsService) ListDiscussionsByIDIter(ctx context.Context, orgID, teamID int64, opts *DiscussionListOptions) iter.Seq2[*TeamDiscussion, error] {
+	return func(yield func(*TeamDiscussion, error) bool) {
+		o := &DiscussionListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListDiscussionsByID(ctx, orgID, teamID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListDiscussionsBySlugIter returns an iterator that paginates through all results of ListDiscussionsBySlug.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/discussions#list-discussions
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/discussions
+func (s *TeamsService) ListDiscussionsBySlugIter(ctx context.Context, org, slug string, opts *DiscussionListOptions) iter.Seq2[*TeamDiscussion, error] {
+	return func(yield func(*TeamDiscussion, error) bool) {
+		o := &DiscussionListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListDiscussionsBySlug(ctx, org, slug, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListExternalGroupsIter returns an iterator that paginates through all results of ListExternalGroups.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/teams/external-groups#list-external-groups-available-to-an-organization
+//
+//meta:operation GET /orgs/{org}/external-groups
+func (s *TeamsService) ListExternalGroupsIter(ctx context.Context, org string, opts *ListExternalGroupsOptions) iter.Seq2[*ExternalGroup, error] {
+	return func(yield func(*ExternalGroup, error) bool) {
+		o := &ListExternalGroupsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListExternalGroups(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Groups {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListIDPGroupsInOrganizationIter returns an iterator that paginates through all results of ListIDPGroupsInOrganization.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/enterprise-cloud@latest/rest/teams/team-sync#list-idp-groups-for-an-organization
+//
+//meta:operation GET /orgs/{org}/team-sync/groups
+func (s *TeamsService) ListIDPGroupsInOrganizationIter(ctx context.Context, org string, opts *ListIDPGroupsOptions) iter.Seq2[*IDPGroup, error] {
+	return func(yield func(*IDPGroup, error) bool) {
+		o := &ListIDPGroupsOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListIDPGroupsInOrganization(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Groups {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.Cursor != "":
+				o.ListCursorOptions.Cursor = resp.Cursor
+			case resp.After != "":
+				o.ListCursorOptions.After = resp.After
+			case resp.NextPageToken != "":
+				o.ListCursorOptions.Page = resp.NextPageToken
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPendingTeamInvitationsByIDIter returns an iterator that paginates through all results of ListPendingTeamInvitationsByID.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/members#list-pending-team-invitations
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/invitations
+func (s *TeamsService) ListPendingTeamInvitationsByIDIter(ctx context.Context, orgID, teamID int64, opts *ListOptions) iter.Seq2[*Invitation, error] {
+	return func(yield func(*Invitation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPendingTeamInvitationsByID(ctx, orgID, teamID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPendingTeamInvitationsBySlugIter returns an iterator that paginates through all results of ListPendingTeamInvitationsBySlug.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/members#list-pending-team-invitations
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/invitations
+func (s *TeamsService) ListPendingTeamInvitationsBySlugIter(ctx context.Context, org, slug string, opts *ListOptions) iter.Seq2[*Invitation, error] {
+	return func(yield func(*Invitation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPendingTeamInvitationsBySlug(ctx, org, slug, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTeamMembersByIDIter returns an iterator that paginates through all results of ListTeamMembersByID.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/members#list-team-members
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/members
+func (s *TeamsService) ListTeamMembersByIDIter(ctx context.Context, orgID, teamID int64, opts *TeamListTeamMembersOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &TeamListTeamMembersOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTeamMembersByID(ctx, orgID, teamID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTeamMembersBySlugIter returns an iterator that paginates through all results of ListTeamMembersBySlug.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/members#list-team-members
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/members
+func (s *TeamsService) ListTeamMembersBySlugIter(ctx context.Context, org, slug string, opts *TeamListTeamMembersOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &TeamListTeamMembersOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTeamMembersBySlug(ctx, org, slug, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTeamReposByIDIter returns an iterator that paginates through all results of ListTeamReposByID.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/teams#list-team-repositories
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/repos
+func (s *TeamsService) ListTeamReposByIDIter(ctx context.Context, orgID, teamID int64, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTeamReposByID(ctx, orgID, teamID, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTeamReposBySlugIter returns an iterator that paginates through all results of ListTeamReposBySlug.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/teams#list-team-repositories
+//
+//meta:operation GET /orgs/{org}/teams/{team_slug}/repos
+func (s *TeamsService) ListTeamReposBySlugIter(ctx context.Context, org, slug string, opts *ListOptions) iter.Seq2[*Repository, error] {
+	return func(yield func(*Repository, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTeamReposBySlug(ctx, org, slug, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListTeamsIter returns an iterator that paginates through all results of ListTeams.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/teams#list-teams
+//
+//meta:operation GET /orgs/{org}/teams
+func (s *TeamsService) ListTeamsIter(ctx context.Context, org string, opts *ListOptions) iter.Seq2[*Team, error] {
+	return func(yield func(*Team, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListTeams(ctx, org, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserTeamsIter returns an iterator that paginates through all results of ListUserTeams.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/teams/teams#list-teams-for-the-authenticated-user
+//
+//meta:operation GET /user/teams
+func (s *TeamsService) ListUserTeamsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*Team, error] {
+	return func(yield func(*Team, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserTeams(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAllIter returns an iterator that paginates through all results of ListAll.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/users#list-users
+//
+//meta:operation GET /users
+func (s *UsersService) ListAllIter(ctx context.Context, opts *UserListOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &UserListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAll(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Since = int64(resp.NextPage)
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListAttestationsIter returns an iterator that paginates through all results of ListAttestations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/attestations#list-attestations
+//
+//meta:operation GET /users/{username}/attestations/{subject_digest}
+func (s *UsersService) ListAttestationsIter(ctx context.Context, user, subjectDigest string, opts *ListOptions) iter.Seq2[*Attestation, error] {
+	return func(yield func(*Attestation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListAttestations(ctx, user, subjectDigest, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if result == nil {
+				return
+			}
+			for _, item := range result.Attestations {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListBlockedUsersIter returns an iterator that paginates through all results of ListBlockedUsers.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/blocking#list-users-blocked-by-the-authenticated-user
+//
+//meta:operation GET /user/blocks
+func (s *UsersService) ListBlockedUsersIter(ctx context.Context, opts *ListOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListBlockedUsers(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListEmailsIter returns an iterator that paginates through all results of ListEmails.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/emails#list-email-addresses-for-the-authenticated-user
+//
+//meta:operation GET /user/emails
+func (s *UsersService) ListEmailsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*UserEmail, error] {
+	return func(yield func(*UserEmail, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListEmails(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListFollowersIter returns an iterator that paginates through all results of ListFollowers.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/followers#list-followers-of-a-user
+// GitHub API docs: https://docs.github.com/rest/users/followers#list-followers-of-the-authenticated-user
+//
+//meta:operation GET /user/followers
+//meta:operation GET /users/{username}/followers
+func (s *UsersService) ListFollowersIter(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListFollowers(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListFollowingIter returns an iterator that paginates through all results of ListFollowing.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/followers#list-the-people-a-user-follows
+// GitHub API docs: https://docs.github.com/rest/users/followers#list-the-people-the-authenticated-user-follows
+//
+//meta:operation GET /user/following
+//meta:operation GET /users/{username}/following
+func (s *UsersService) ListFollowingIter(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*User, error] {
+	return func(yield func(*User, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListFollowing(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListGPGKeysIter returns an iterator that paginates through all results of ListGPGKeys.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/gpg-keys#list-gpg-keys-for-a-user
+// GitHub API docs: https://docs.github.com/rest/users/gpg-keys#list-gpg-keys-for-the-authenticated-user
+//
+//meta:operation GET /user/gpg_keys
+//meta:operation GET /users/{username}/gpg_keys
+func (s *UsersService) ListGPGKeysIter(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*GPGKey, error] {
+	return func(yield func(*GPGKey, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListGPGKeys(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListInvitationsIter returns an iterator that paginates through all results of ListInvitations.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/collaborators/invitations#list-repository-invitations-for-the-authenticated-user
+//
+//meta:operation GET /user/repository_invitations
+func (s *UsersService) ListInvitationsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*RepositoryInvitation, error] {
+	return func(yield func(*RepositoryInvitation, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListInvitations(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListKeysIter returns an iterator that paginates through all results of ListKeys.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/keys#list-public-keys-for-a-user
+// GitHub API docs: https://docs.github.com/rest/users/keys#list-public-ssh-keys-for-the-authenticated-user
+//
+//meta:operation GET /user/keys
+//meta:operation GET /users/{username}/keys
+func (s *UsersService) ListKeysIter(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*Key, error] {
+	return func(yield func(*Key, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListKeys(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListPackagesIter returns an iterator that paginates through all results of ListPackages.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/packages/packages#list-packages-for-a-user
+// GitHub API docs: https://docs.github.com/rest/packages/packages#list-packages-for-the-authenticated-users-namespace
+//
+//meta:operation GET /user/packages
+//meta:operation GET /users/{username}/packages
+func (s *UsersService) ListPackagesIter(ctx context.Context, user string, opts *PackageListOptions) iter.Seq2[*Package, error] {
+	return func(yield func(*Package, error) bool) {
+		o := &PackageListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListPackages(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.ListOptions.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListSSHSigningKeysIter returns an iterator that paginates through all results of ListSSHSigningKeys.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/ssh-signing-keys#list-ssh-signing-keys-for-a-user
+// GitHub API docs: https://docs.github.com/rest/users/ssh-signing-keys#list-ssh-signing-keys-for-the-authenticated-user
+//
+//meta:operation GET /user/ssh_signing_keys
+//meta:operation GET /users/{username}/ssh_signing_keys
+func (s *UsersService) ListSSHSigningKeysIter(ctx context.Context, user string, opts *ListOptions) iter.Seq2[*SSHSigningKey, error] {
+	return func(yield func(*SSHSigningKey, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListSSHSigningKeys(ctx, user, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListSocialAccountsIter returns an iterator that paginates through all results of ListSocialAccounts.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/social-accounts#list-social-accounts-for-the-authenticated-user
+//
+//meta:operation GET /user/social_accounts
+func (s *UsersService) ListSocialAccountsIter(ctx context.Context, opts *ListOptions) iter.Seq2[*SocialAccount, error] {
+	return func(yield func(*SocialAccount, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListSocialAccounts(ctx, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
+
+// ListUserSocialAccountsIter returns an iterator that paginates through all results of ListUserSocialAccounts.
+// The iterator stops at the first error, which is yielded as the final value;
+// this includes the context being canceled while paginating.
+// opts is copied and is not modified by the iterator.
+//
+// GitHub API docs: https://docs.github.com/rest/users/social-accounts#list-social-accounts-for-a-user
+//
+//meta:operation GET /users/{username}/social_accounts
+func (s *UsersService) ListUserSocialAccountsIter(ctx context.Context, username string, opts *ListOptions) iter.Seq2[*SocialAccount, error] {
+	return func(yield func(*SocialAccount, error) bool) {
+		o := &ListOptions{}
+		if opts != nil {
+			*o = *opts
+		}
+		for {
+			result, resp, err := s.ListUserSocialAccounts(ctx, username, o)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, item := range result {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			switch {
+			case resp.NextPage != 0:
+				o.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}
+}
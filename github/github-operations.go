@@ -0,0 +1,1444 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-operations; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package github
+
+// apiOperations are the operations of the GitHub API.
+var apiOperations = []Operation{
+	{Method: "GET", Path: "/"},
+	{Method: "GET", Path: "/admin/hooks"},
+	{Method: "POST", Path: "/admin/hooks"},
+	{Method: "DELETE", Path: "/admin/hooks/{hook_id}"},
+	{Method: "GET", Path: "/admin/hooks/{hook_id}"},
+	{Method: "PATCH", Path: "/admin/hooks/{hook_id}"},
+	{Method: "POST", Path: "/admin/hooks/{hook_id}/pings"},
+	{Method: "GET", Path: "/admin/keys"},
+	{Method: "DELETE", Path: "/admin/keys/{key_ids}"},
+	{Method: "PATCH", Path: "/admin/ldap/teams/{team_id}/mapping"},
+	{Method: "POST", Path: "/admin/ldap/teams/{team_id}/sync"},
+	{Method: "PATCH", Path: "/admin/ldap/users/{username}/mapping"},
+	{Method: "POST", Path: "/admin/ldap/users/{username}/sync"},
+	{Method: "POST", Path: "/admin/organizations"},
+	{Method: "PATCH", Path: "/admin/organizations/{org}"},
+	{Method: "GET", Path: "/admin/pre-receive-environments"},
+	{Method: "POST", Path: "/admin/pre-receive-environments"},
+	{Method: "DELETE", Path: "/admin/pre-receive-environments/{pre_receive_environment_id}"},
+	{Method: "GET", Path: "/admin/pre-receive-environments/{pre_receive_environment_id}"},
+	{Method: "PATCH", Path: "/admin/pre-receive-environments/{pre_receive_environment_id}"},
+	{Method: "POST", Path: "/admin/pre-receive-environments/{pre_receive_environment_id}/downloads"},
+	{Method: "GET", Path: "/admin/pre-receive-environments/{pre_receive_environment_id}/downloads/latest"},
+	{Method: "GET", Path: "/admin/pre-receive-hooks"},
+	{Method: "POST", Path: "/admin/pre-receive-hooks"},
+	{Method: "DELETE", Path: "/admin/pre-receive-hooks/{pre_receive_hook_id}"},
+	{Method: "GET", Path: "/admin/pre-receive-hooks/{pre_receive_hook_id}"},
+	{Method: "PATCH", Path: "/admin/pre-receive-hooks/{pre_receive_hook_id}"},
+	{Method: "GET", Path: "/admin/tokens"},
+	{Method: "DELETE", Path: "/admin/tokens/{token_id}"},
+	{Method: "POST", Path: "/admin/users"},
+	{Method: "DELETE", Path: "/admin/users/{username}"},
+	{Method: "PATCH", Path: "/admin/users/{username}"},
+	{Method: "DELETE", Path: "/admin/users/{username}/authorizations"},
+	{Method: "POST", Path: "/admin/users/{username}/authorizations"},
+	{Method: "GET", Path: "/advisories"},
+	{Method: "GET", Path: "/advisories/{ghsa_id}"},
+	{Method: "GET", Path: "/app"},
+	{Method: "POST", Path: "/app-manifests/{code}/conversions"},
+	{Method: "GET", Path: "/app/hook/config"},
+	{Method: "PATCH", Path: "/app/hook/config"},
+	{Method: "GET", Path: "/app/hook/deliveries"},
+	{Method: "GET", Path: "/app/hook/deliveries/{delivery_id}"},
+	{Method: "POST", Path: "/app/hook/deliveries/{delivery_id}/attempts"},
+	{Method: "GET", Path: "/app/installation-requests"},
+	{Method: "GET", Path: "/app/installations"},
+	{Method: "DELETE", Path: "/app/installations/{installation_id}"},
+	{Method: "GET", Path: "/app/installations/{installation_id}"},
+	{Method: "POST", Path: "/app/installations/{installation_id}/access_tokens"},
+	{Method: "DELETE", Path: "/app/installations/{installation_id}/suspended"},
+	{Method: "PUT", Path: "/app/installations/{installation_id}/suspended"},
+	{Method: "GET", Path: "/applications/grants"},
+	{Method: "DELETE", Path: "/applications/grants/{grant_id}"},
+	{Method: "GET", Path: "/applications/grants/{grant_id}"},
+	{Method: "DELETE", Path: "/applications/{client_id}/grant"},
+	{Method: "DELETE", Path: "/applications/{client_id}/grants/{access_token}"},
+	{Method: "DELETE", Path: "/applications/{client_id}/token"},
+	{Method: "PATCH", Path: "/applications/{client_id}/token"},
+	{Method: "POST", Path: "/applications/{client_id}/token"},
+	{Method: "POST", Path: "/applications/{client_id}/token/scoped"},
+	{Method: "DELETE", Path: "/applications/{client_id}/tokens/{access_token}"},
+	{Method: "GET", Path: "/applications/{client_id}/tokens/{access_token}"},
+	{Method: "POST", Path: "/applications/{client_id}/tokens/{access_token}"},
+	{Method: "GET", Path: "/apps/{app_slug}"},
+	{Method: "GET", Path: "/assignments/{assignment_id}"},
+	{Method: "GET", Path: "/assignments/{assignment_id}/accepted_assignments"},
+	{Method: "GET", Path: "/assignments/{assignment_id}/grades"},
+	{Method: "GET", Path: "/authorizations"},
+	{Method: "POST", Path: "/authorizations"},
+	{Method: "PUT", Path: "/authorizations/clients/{client_id}"},
+	{Method: "PUT", Path: "/authorizations/clients/{client_id}/{fingerprint}"},
+	{Method: "DELETE", Path: "/authorizations/{authorization_id}"},
+	{Method: "GET", Path: "/authorizations/{authorization_id}"},
+	{Method: "PATCH", Path: "/authorizations/{authorization_id}"},
+	{Method: "GET", Path: "/classrooms"},
+	{Method: "GET", Path: "/classrooms/{classroom_id}"},
+	{Method: "GET", Path: "/classrooms/{classroom_id}/assignments"},
+	{Method: "GET", Path: "/codes_of_conduct"},
+	{Method: "GET", Path: "/codes_of_conduct/{key}"},
+	{Method: "POST", Path: "/credentials/revoke"},
+	{Method: "GET", Path: "/emojis"},
+	{Method: "GET", Path: "/enterprise-installation/{enterprise_or_org}/server-statistics"},
+	{Method: "DELETE", Path: "/enterprise/announcement"},
+	{Method: "GET", Path: "/enterprise/announcement"},
+	{Method: "PATCH", Path: "/enterprise/announcement"},
+	{Method: "GET", Path: "/enterprise/settings/license"},
+	{Method: "GET", Path: "/enterprise/stats/all"},
+	{Method: "GET", Path: "/enterprise/stats/comments"},
+	{Method: "GET", Path: "/enterprise/stats/gists"},
+	{Method: "GET", Path: "/enterprise/stats/hooks"},
+	{Method: "GET", Path: "/enterprise/stats/issues"},
+	{Method: "GET", Path: "/enterprise/stats/milestones"},
+	{Method: "GET", Path: "/enterprise/stats/orgs"},
+	{Method: "GET", Path: "/enterprise/stats/pages"},
+	{Method: "GET", Path: "/enterprise/stats/pulls"},
+	{Method: "GET", Path: "/enterprise/stats/repos"},
+	{Method: "GET", Path: "/enterprise/stats/security-products"},
+	{Method: "GET", Path: "/enterprise/stats/users"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/access-restrictions/disable"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/access-restrictions/enable"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/cache/usage"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/cache/usage-policy"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/actions/cache/usage-policy"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/hosted-runners"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/hosted-runners"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/hosted-runners/images/github-owned"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/hosted-runners/images/partner"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/hosted-runners/limits"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/hosted-runners/machine-sizes"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/hosted-runners/platforms"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/hosted-runners/{hosted_runner_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/hosted-runners/{hosted_runner_id}"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/actions/hosted-runners/{hosted_runner_id}"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/oidc/customization/issuer"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/artifact-and-log-retention"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/artifact-and-log-retention"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/fork-pr-contributor-approval"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/fork-pr-contributor-approval"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/fork-pr-workflows-private-repos"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/fork-pr-workflows-private-repos"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/organizations"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/organizations"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/permissions/organizations/{org_id}"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/organizations/{org_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/selected-actions"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/selected-actions"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/self-hosted-runners"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/self-hosted-runners"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/permissions/workflow"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/permissions/workflow"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runner-groups"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/runner-groups"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations/{org_id}"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/organizations/{org_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners/{runner_id}"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/runner-groups/{runner_group_id}/runners/{runner_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runners"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runners/downloads"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/runners/generate-jitconfig"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/runners/registration-token"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/runners/remove-token"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runners/{runner_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runners/{runner_id}"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runners/{runner_id}/labels"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/actions/runners/{runner_id}/labels"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/actions/runners/{runner_id}/labels"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/actions/runners/{runner_id}/labels"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/actions/runners/{runner_id}/labels/{name}"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/announcement"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/announcement"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/announcement"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/apps/installable_organizations"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/apps/installable_organizations/{org}/accessible_repositories"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/apps/organizations/{org}/installations"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/apps/organizations/{org}/installations"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/apps/organizations/{org}/installations/{installation_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/apps/organizations/{org}/installations/{installation_id}/repositories"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/apps/organizations/{org}/installations/{installation_id}/repositories"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/apps/organizations/{org}/installations/{installation_id}/repositories/add"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/apps/organizations/{org}/installations/{installation_id}/repositories/remove"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/audit-log"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/audit-log/stream-key"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/audit-log/streams"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/audit-log/streams"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/audit-log/streams/{stream_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/audit-log/streams/{stream_id}"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/audit-log/streams/{stream_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/bypass-requests/push-rules"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/bypass-requests/secret-scanning"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/code-scanning/alerts"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/code-security/configurations"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/code-security/configurations"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/code-security/configurations/defaults"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/code-security/configurations/{configuration_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/code-security/configurations/{configuration_id}"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/code-security/configurations/{configuration_id}"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/code-security/configurations/{configuration_id}/attach"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/code-security/configurations/{configuration_id}/defaults"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/code-security/configurations/{configuration_id}/repositories"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/code_security_and_analysis"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/code_security_and_analysis"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/consumed-licenses"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/copilot/billing/seats"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/copilot/billing/selected_enterprise_teams"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/copilot/billing/selected_enterprise_teams"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/copilot/billing/selected_users"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/copilot/billing/selected_users"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/copilot/metrics"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/dependabot/alerts"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/license-sync-status"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/members/{username}/copilot"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/network-configurations"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/network-configurations"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/network-configurations/{network_configuration_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/network-configurations/{network_configuration_id}"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/network-configurations/{network_configuration_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/network-settings/{network_settings_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/properties/schema"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/properties/schema"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/properties/schema/organizations/{org}/{custom_property_name}/promote"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/properties/schema/{custom_property_name}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/properties/schema/{custom_property_name}"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/properties/schema/{custom_property_name}"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/rulesets"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/rulesets/{ruleset_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/rulesets/{ruleset_id}"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/rulesets/{ruleset_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/rulesets/{ruleset_id}/history"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/rulesets/{ruleset_id}/history/{version_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/secret-scanning/alerts"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/secret-scanning/pattern-configurations"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/secret-scanning/pattern-configurations"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/settings/billing/actions"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/settings/billing/advanced-security"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/settings/billing/cost-centers"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/settings/billing/cost-centers"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/settings/billing/cost-centers/{cost_center_id}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/settings/billing/cost-centers/{cost_center_id}"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/settings/billing/cost-centers/{cost_center_id}"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/settings/billing/cost-centers/{cost_center_id}/resource"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/settings/billing/cost-centers/{cost_center_id}/resource"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/settings/billing/packages"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/settings/billing/premium_request/usage"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/settings/billing/shared-storage"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/settings/billing/usage"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/team/{team_slug}/copilot/metrics"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/teams"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/teams"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/teams/{enterprise-team}/memberships"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/teams/{enterprise-team}/memberships/add"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/teams/{enterprise-team}/memberships/remove"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/teams/{enterprise-team}/memberships/{username}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/teams/{enterprise-team}/memberships/{username}"},
+	{Method: "PUT", Path: "/enterprises/{enterprise}/teams/{enterprise-team}/memberships/{username}"},
+	{Method: "DELETE", Path: "/enterprises/{enterprise}/teams/{team_slug}"},
+	{Method: "GET", Path: "/enterprises/{enterprise}/teams/{team_slug}"},
+	{Method: "PATCH", Path: "/enterprises/{enterprise}/teams/{team_slug}"},
+	{Method: "POST", Path: "/enterprises/{enterprise}/{security_product}/{enablement}"},
+	{Method: "GET", Path: "/events"},
+	{Method: "GET", Path: "/feeds"},
+	{Method: "GET", Path: "/gists"},
+	{Method: "POST", Path: "/gists"},
+	{Method: "GET", Path: "/gists/public"},
+	{Method: "GET", Path: "/gists/starred"},
+	{Method: "DELETE", Path: "/gists/{gist_id}"},
+	{Method: "GET", Path: "/gists/{gist_id}"},
+	{Method: "PATCH", Path: "/gists/{gist_id}"},
+	{Method: "GET", Path: "/gists/{gist_id}/comments"},
+	{Method: "POST", Path: "/gists/{gist_id}/comments"},
+	{Method: "DELETE", Path: "/gists/{gist_id}/comments/{comment_id}"},
+	{Method: "GET", Path: "/gists/{gist_id}/comments/{comment_id}"},
+	{Method: "PATCH", Path: "/gists/{gist_id}/comments/{comment_id}"},
+	{Method: "GET", Path: "/gists/{gist_id}/commits"},
+	{Method: "GET", Path: "/gists/{gist_id}/forks"},
+	{Method: "POST", Path: "/gists/{gist_id}/forks"},
+	{Method: "DELETE", Path: "/gists/{gist_id}/star"},
+	{Method: "GET", Path: "/gists/{gist_id}/star"},
+	{Method: "PUT", Path: "/gists/{gist_id}/star"},
+	{Method: "GET", Path: "/gists/{gist_id}/{sha}"},
+	{Method: "GET", Path: "/gitignore/templates"},
+	{Method: "GET", Path: "/gitignore/templates/{name}"},
+	{Method: "POST", Path: "/graphql"},
+	{Method: "POST", Path: "/hub"},
+	{Method: "GET", Path: "/installation/repositories"},
+	{Method: "DELETE", Path: "/installation/token"},
+	{Method: "GET", Path: "/issues"},
+	{Method: "GET", Path: "/licenses"},
+	{Method: "GET", Path: "/licenses/{license}"},
+	{Method: "DELETE", Path: "/manage/v1/access/ssh"},
+	{Method: "GET", Path: "/manage/v1/access/ssh"},
+	{Method: "POST", Path: "/manage/v1/access/ssh"},
+	{Method: "GET", Path: "/manage/v1/checks/system-requirements"},
+	{Method: "GET", Path: "/manage/v1/cluster/status"},
+	{Method: "GET", Path: "/manage/v1/config/apply"},
+	{Method: "POST", Path: "/manage/v1/config/apply"},
+	{Method: "GET", Path: "/manage/v1/config/apply/events"},
+	{Method: "POST", Path: "/manage/v1/config/init"},
+	{Method: "GET", Path: "/manage/v1/config/license"},
+	{Method: "PUT", Path: "/manage/v1/config/license"},
+	{Method: "GET", Path: "/manage/v1/config/license/check"},
+	{Method: "GET", Path: "/manage/v1/config/nodes"},
+	{Method: "GET", Path: "/manage/v1/config/settings"},
+	{Method: "PUT", Path: "/manage/v1/config/settings"},
+	{Method: "GET", Path: "/manage/v1/maintenance"},
+	{Method: "POST", Path: "/manage/v1/maintenance"},
+	{Method: "GET", Path: "/manage/v1/replication/status"},
+	{Method: "GET", Path: "/manage/v1/version"},
+	{Method: "POST", Path: "/markdown"},
+	{Method: "POST", Path: "/markdown/raw"},
+	{Method: "GET", Path: "/marketplace_listing/accounts/{account_id}"},
+	{Method: "GET", Path: "/marketplace_listing/plans"},
+	{Method: "GET", Path: "/marketplace_listing/plans/{plan_id}/accounts"},
+	{Method: "GET", Path: "/marketplace_listing/stubbed/accounts/{account_id}"},
+	{Method: "GET", Path: "/marketplace_listing/stubbed/plans"},
+	{Method: "GET", Path: "/marketplace_listing/stubbed/plans/{plan_id}/accounts"},
+	{Method: "GET", Path: "/meta"},
+	{Method: "GET", Path: "/networks/{owner}/{repo}/events"},
+	{Method: "GET", Path: "/notifications"},
+	{Method: "PUT", Path: "/notifications"},
+	{Method: "DELETE", Path: "/notifications/threads/{thread_id}"},
+	{Method: "GET", Path: "/notifications/threads/{thread_id}"},
+	{Method: "PATCH", Path: "/notifications/threads/{thread_id}"},
+	{Method: "DELETE", Path: "/notifications/threads/{thread_id}/subscription"},
+	{Method: "GET", Path: "/notifications/threads/{thread_id}/subscription"},
+	{Method: "PUT", Path: "/notifications/threads/{thread_id}/subscription"},
+	{Method: "GET", Path: "/octocat"},
+	{Method: "GET", Path: "/organizations"},
+	{Method: "GET", Path: "/organizations/{organization_id}"},
+	{Method: "GET", Path: "/organizations/{organization_id}/custom_roles"},
+	{Method: "GET", Path: "/organizations/{org}/dependabot/repository-access"},
+	{Method: "PATCH", Path: "/organizations/{org}/dependabot/repository-access"},
+	{Method: "PUT", Path: "/organizations/{org}/dependabot/repository-access/default-level"},
+	{Method: "GET", Path: "/organizations/{org}/settings/billing/premium_request/usage"},
+	{Method: "GET", Path: "/organizations/{org}/settings/billing/usage"},
+	{Method: "DELETE", Path: "/orgs/{org}"},
+	{Method: "GET", Path: "/orgs/{org}"},
+	{Method: "PATCH", Path: "/orgs/{org}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/cache/usage"},
+	{Method: "GET", Path: "/orgs/{org}/actions/cache/usage-by-repository"},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners"},
+	{Method: "POST", Path: "/orgs/{org}/actions/hosted-runners"},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/images/github-owned"},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/images/partner"},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/limits"},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/machine-sizes"},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/platforms"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/hosted-runners/{hosted_runner_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/hosted-runners/{hosted_runner_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/actions/hosted-runners/{hosted_runner_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/oidc/customization/sub"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/oidc/customization/sub"},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions"},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/artifact-and-log-retention"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/artifact-and-log-retention"},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/fork-pr-contributor-approval"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/fork-pr-contributor-approval"},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/fork-pr-workflows-private-repos"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/fork-pr-workflows-private-repos"},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/repositories"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/repositories"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/permissions/repositories/{repository_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/repositories/{repository_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/selected-actions"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/selected-actions"},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/self-hosted-runners"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/self-hosted-runners"},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/self-hosted-runners/repositories"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/self-hosted-runners/repositories"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/permissions/self-hosted-runners/repositories/{repository_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/self-hosted-runners/repositories/{repository_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/permissions/workflow"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/permissions/workflow"},
+	{Method: "GET", Path: "/orgs/{org}/actions/runner-groups"},
+	{Method: "POST", Path: "/orgs/{org}/actions/runner-groups"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/hosted-runners"},
+	{Method: "GET", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/repositories"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/repositories"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/repositories/{repository_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/repositories/{repository_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/runners"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/runners"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/runners/{runner_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/runner-groups/{runner_group_id}/runners/{runner_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/runners"},
+	{Method: "GET", Path: "/orgs/{org}/actions/runners/downloads"},
+	{Method: "POST", Path: "/orgs/{org}/actions/runners/generate-jitconfig"},
+	{Method: "POST", Path: "/orgs/{org}/actions/runners/registration-token"},
+	{Method: "POST", Path: "/orgs/{org}/actions/runners/remove-token"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runners/{runner_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/runners/{runner_id}"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runners/{runner_id}/labels"},
+	{Method: "GET", Path: "/orgs/{org}/actions/runners/{runner_id}/labels"},
+	{Method: "POST", Path: "/orgs/{org}/actions/runners/{runner_id}/labels"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/runners/{runner_id}/labels"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/runners/{runner_id}/labels/{name}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/secrets"},
+	{Method: "GET", Path: "/orgs/{org}/actions/secrets/public-key"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/secrets/{secret_name}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/secrets/{secret_name}"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/secrets/{secret_name}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/secrets/{secret_name}/repositories"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/secrets/{secret_name}/repositories"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/secrets/{secret_name}/repositories/{repository_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/secrets/{secret_name}/repositories/{repository_id}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/variables"},
+	{Method: "POST", Path: "/orgs/{org}/actions/variables"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/variables/{name}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/variables/{name}"},
+	{Method: "PATCH", Path: "/orgs/{org}/actions/variables/{name}"},
+	{Method: "GET", Path: "/orgs/{org}/actions/variables/{name}/repositories"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/variables/{name}/repositories"},
+	{Method: "DELETE", Path: "/orgs/{org}/actions/variables/{name}/repositories/{repository_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/actions/variables/{name}/repositories/{repository_id}"},
+	{Method: "DELETE", Path: "/orgs/{org}/announcement"},
+	{Method: "GET", Path: "/orgs/{org}/announcement"},
+	{Method: "PATCH", Path: "/orgs/{org}/announcement"},
+	{Method: "POST", Path: "/orgs/{org}/artifacts/metadata/storage-record"},
+	{Method: "GET", Path: "/orgs/{org}/artifacts/{subject_digest}/metadata/storage-records"},
+	{Method: "POST", Path: "/orgs/{org}/attestations/bulk-list"},
+	{Method: "POST", Path: "/orgs/{org}/attestations/delete-request"},
+	{Method: "DELETE", Path: "/orgs/{org}/attestations/digest/{subject_digest}"},
+	{Method: "DELETE", Path: "/orgs/{org}/attestations/{attestation_id}"},
+	{Method: "GET", Path: "/orgs/{org}/attestations/{subject_digest}"},
+	{Method: "GET", Path: "/orgs/{org}/audit-log"},
+	{Method: "GET", Path: "/orgs/{org}/blocks"},
+	{Method: "DELETE", Path: "/orgs/{org}/blocks/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/blocks/{username}"},
+	{Method: "PUT", Path: "/orgs/{org}/blocks/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/bypass-requests/push-rules"},
+	{Method: "GET", Path: "/orgs/{org}/bypass-requests/secret-scanning"},
+	{Method: "GET", Path: "/orgs/{org}/campaigns"},
+	{Method: "POST", Path: "/orgs/{org}/campaigns"},
+	{Method: "DELETE", Path: "/orgs/{org}/campaigns/{campaign_number}"},
+	{Method: "GET", Path: "/orgs/{org}/campaigns/{campaign_number}"},
+	{Method: "PATCH", Path: "/orgs/{org}/campaigns/{campaign_number}"},
+	{Method: "GET", Path: "/orgs/{org}/code-scanning/alerts"},
+	{Method: "GET", Path: "/orgs/{org}/code-security/configurations"},
+	{Method: "POST", Path: "/orgs/{org}/code-security/configurations"},
+	{Method: "GET", Path: "/orgs/{org}/code-security/configurations/defaults"},
+	{Method: "DELETE", Path: "/orgs/{org}/code-security/configurations/detach"},
+	{Method: "DELETE", Path: "/orgs/{org}/code-security/configurations/{configuration_id}"},
+	{Method: "GET", Path: "/orgs/{org}/code-security/configurations/{configuration_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/code-security/configurations/{configuration_id}"},
+	{Method: "POST", Path: "/orgs/{org}/code-security/configurations/{configuration_id}/attach"},
+	{Method: "PUT", Path: "/orgs/{org}/code-security/configurations/{configuration_id}/defaults"},
+	{Method: "GET", Path: "/orgs/{org}/code-security/configurations/{configuration_id}/repositories"},
+	{Method: "GET", Path: "/orgs/{org}/codespaces"},
+	{Method: "PUT", Path: "/orgs/{org}/codespaces/access"},
+	{Method: "DELETE", Path: "/orgs/{org}/codespaces/access/selected_users"},
+	{Method: "POST", Path: "/orgs/{org}/codespaces/access/selected_users"},
+	{Method: "GET", Path: "/orgs/{org}/codespaces/secrets"},
+	{Method: "GET", Path: "/orgs/{org}/codespaces/secrets/public-key"},
+	{Method: "DELETE", Path: "/orgs/{org}/codespaces/secrets/{secret_name}"},
+	{Method: "GET", Path: "/orgs/{org}/codespaces/secrets/{secret_name}"},
+	{Method: "PUT", Path: "/orgs/{org}/codespaces/secrets/{secret_name}"},
+	{Method: "GET", Path: "/orgs/{org}/codespaces/secrets/{secret_name}/repositories"},
+	{Method: "PUT", Path: "/orgs/{org}/codespaces/secrets/{secret_name}/repositories"},
+	{Method: "DELETE", Path: "/orgs/{org}/codespaces/secrets/{secret_name}/repositories/{repository_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/codespaces/secrets/{secret_name}/repositories/{repository_id}"},
+	{Method: "GET", Path: "/orgs/{org}/copilot/billing"},
+	{Method: "GET", Path: "/orgs/{org}/copilot/billing/seats"},
+	{Method: "DELETE", Path: "/orgs/{org}/copilot/billing/selected_teams"},
+	{Method: "POST", Path: "/orgs/{org}/copilot/billing/selected_teams"},
+	{Method: "DELETE", Path: "/orgs/{org}/copilot/billing/selected_users"},
+	{Method: "POST", Path: "/orgs/{org}/copilot/billing/selected_users"},
+	{Method: "GET", Path: "/orgs/{org}/copilot/metrics"},
+	{Method: "GET", Path: "/orgs/{org}/credential-authorizations"},
+	{Method: "DELETE", Path: "/orgs/{org}/credential-authorizations/{credential_id}"},
+	{Method: "GET", Path: "/orgs/{org}/custom-repository-roles"},
+	{Method: "POST", Path: "/orgs/{org}/custom-repository-roles"},
+	{Method: "DELETE", Path: "/orgs/{org}/custom-repository-roles/{role_id}"},
+	{Method: "GET", Path: "/orgs/{org}/custom-repository-roles/{role_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/custom-repository-roles/{role_id}"},
+	{Method: "POST", Path: "/orgs/{org}/custom_roles"},
+	{Method: "DELETE", Path: "/orgs/{org}/custom_roles/{role_id}"},
+	{Method: "GET", Path: "/orgs/{org}/custom_roles/{role_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/custom_roles/{role_id}"},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/alerts"},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/secrets"},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/secrets/public-key"},
+	{Method: "DELETE", Path: "/orgs/{org}/dependabot/secrets/{secret_name}"},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/secrets/{secret_name}"},
+	{Method: "PUT", Path: "/orgs/{org}/dependabot/secrets/{secret_name}"},
+	{Method: "GET", Path: "/orgs/{org}/dependabot/secrets/{secret_name}/repositories"},
+	{Method: "PUT", Path: "/orgs/{org}/dependabot/secrets/{secret_name}/repositories"},
+	{Method: "DELETE", Path: "/orgs/{org}/dependabot/secrets/{secret_name}/repositories/{repository_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/dependabot/secrets/{secret_name}/repositories/{repository_id}"},
+	{Method: "GET", Path: "/orgs/{org}/dismissal-requests/code-scanning"},
+	{Method: "GET", Path: "/orgs/{org}/dismissal-requests/secret-scanning"},
+	{Method: "GET", Path: "/orgs/{org}/docker/conflicts"},
+	{Method: "GET", Path: "/orgs/{org}/events"},
+	{Method: "GET", Path: "/orgs/{org}/external-group/{group_id}"},
+	{Method: "GET", Path: "/orgs/{org}/external-groups"},
+	{Method: "GET", Path: "/orgs/{org}/failed_invitations"},
+	{Method: "GET", Path: "/orgs/{org}/fine_grained_permissions"},
+	{Method: "GET", Path: "/orgs/{org}/hooks"},
+	{Method: "POST", Path: "/orgs/{org}/hooks"},
+	{Method: "DELETE", Path: "/orgs/{org}/hooks/{hook_id}"},
+	{Method: "GET", Path: "/orgs/{org}/hooks/{hook_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/hooks/{hook_id}"},
+	{Method: "GET", Path: "/orgs/{org}/hooks/{hook_id}/config"},
+	{Method: "PATCH", Path: "/orgs/{org}/hooks/{hook_id}/config"},
+	{Method: "GET", Path: "/orgs/{org}/hooks/{hook_id}/deliveries"},
+	{Method: "GET", Path: "/orgs/{org}/hooks/{hook_id}/deliveries/{delivery_id}"},
+	{Method: "POST", Path: "/orgs/{org}/hooks/{hook_id}/deliveries/{delivery_id}/attempts"},
+	{Method: "POST", Path: "/orgs/{org}/hooks/{hook_id}/pings"},
+	{Method: "GET", Path: "/orgs/{org}/insights/api/route-stats/{actor_type}/{actor_id}"},
+	{Method: "GET", Path: "/orgs/{org}/insights/api/subject-stats"},
+	{Method: "GET", Path: "/orgs/{org}/insights/api/summary-stats"},
+	{Method: "GET", Path: "/orgs/{org}/insights/api/summary-stats/users/{user_id}"},
+	{Method: "GET", Path: "/orgs/{org}/insights/api/summary-stats/{actor_type}/{actor_id}"},
+	{Method: "GET", Path: "/orgs/{org}/insights/api/time-stats"},
+	{Method: "GET", Path: "/orgs/{org}/insights/api/time-stats/users/{user_id}"},
+	{Method: "GET", Path: "/orgs/{org}/insights/api/time-stats/{actor_type}/{actor_id}"},
+	{Method: "GET", Path: "/orgs/{org}/insights/api/user-stats/{user_id}"},
+	{Method: "GET", Path: "/orgs/{org}/installation"},
+	{Method: "GET", Path: "/orgs/{org}/installations"},
+	{Method: "DELETE", Path: "/orgs/{org}/interaction-limits"},
+	{Method: "GET", Path: "/orgs/{org}/interaction-limits"},
+	{Method: "PUT", Path: "/orgs/{org}/interaction-limits"},
+	{Method: "GET", Path: "/orgs/{org}/invitations"},
+	{Method: "POST", Path: "/orgs/{org}/invitations"},
+	{Method: "DELETE", Path: "/orgs/{org}/invitations/{invitation_id}"},
+	{Method: "GET", Path: "/orgs/{org}/invitations/{invitation_id}/teams"},
+	{Method: "GET", Path: "/orgs/{org}/issue-types"},
+	{Method: "POST", Path: "/orgs/{org}/issue-types"},
+	{Method: "DELETE", Path: "/orgs/{org}/issue-types/{issue_type_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/issue-types/{issue_type_id}"},
+	{Method: "GET", Path: "/orgs/{org}/issues"},
+	{Method: "GET", Path: "/orgs/{org}/members"},
+	{Method: "DELETE", Path: "/orgs/{org}/members/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/members/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/members/{username}/codespaces"},
+	{Method: "DELETE", Path: "/orgs/{org}/members/{username}/codespaces/{codespace_name}"},
+	{Method: "POST", Path: "/orgs/{org}/members/{username}/codespaces/{codespace_name}/stop"},
+	{Method: "GET", Path: "/orgs/{org}/members/{username}/copilot"},
+	{Method: "DELETE", Path: "/orgs/{org}/memberships/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/memberships/{username}"},
+	{Method: "PUT", Path: "/orgs/{org}/memberships/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/migrations"},
+	{Method: "POST", Path: "/orgs/{org}/migrations"},
+	{Method: "GET", Path: "/orgs/{org}/migrations/{migration_id}"},
+	{Method: "DELETE", Path: "/orgs/{org}/migrations/{migration_id}/archive"},
+	{Method: "GET", Path: "/orgs/{org}/migrations/{migration_id}/archive"},
+	{Method: "DELETE", Path: "/orgs/{org}/migrations/{migration_id}/repos/{repo_name}/lock"},
+	{Method: "GET", Path: "/orgs/{org}/migrations/{migration_id}/repositories"},
+	{Method: "GET", Path: "/orgs/{org}/organization-fine-grained-permissions"},
+	{Method: "GET", Path: "/orgs/{org}/organization-roles"},
+	{Method: "POST", Path: "/orgs/{org}/organization-roles"},
+	{Method: "DELETE", Path: "/orgs/{org}/organization-roles/teams/{team_slug}"},
+	{Method: "DELETE", Path: "/orgs/{org}/organization-roles/teams/{team_slug}/{role_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/organization-roles/teams/{team_slug}/{role_id}"},
+	{Method: "DELETE", Path: "/orgs/{org}/organization-roles/users/{username}"},
+	{Method: "DELETE", Path: "/orgs/{org}/organization-roles/users/{username}/{role_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/organization-roles/users/{username}/{role_id}"},
+	{Method: "DELETE", Path: "/orgs/{org}/organization-roles/{role_id}"},
+	{Method: "GET", Path: "/orgs/{org}/organization-roles/{role_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/organization-roles/{role_id}"},
+	{Method: "GET", Path: "/orgs/{org}/organization-roles/{role_id}/teams"},
+	{Method: "GET", Path: "/orgs/{org}/organization-roles/{role_id}/users"},
+	{Method: "GET", Path: "/orgs/{org}/outside_collaborators"},
+	{Method: "DELETE", Path: "/orgs/{org}/outside_collaborators/{username}"},
+	{Method: "PUT", Path: "/orgs/{org}/outside_collaborators/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/packages"},
+	{Method: "DELETE", Path: "/orgs/{org}/packages/{package_type}/{package_name}"},
+	{Method: "GET", Path: "/orgs/{org}/packages/{package_type}/{package_name}"},
+	{Method: "POST", Path: "/orgs/{org}/packages/{package_type}/{package_name}/restore"},
+	{Method: "GET", Path: "/orgs/{org}/packages/{package_type}/{package_name}/versions"},
+	{Method: "DELETE", Path: "/orgs/{org}/packages/{package_type}/{package_name}/versions/{package_version_id}"},
+	{Method: "GET", Path: "/orgs/{org}/packages/{package_type}/{package_name}/versions/{package_version_id}"},
+	{Method: "POST", Path: "/orgs/{org}/packages/{package_type}/{package_name}/versions/{package_version_id}/restore"},
+	{Method: "GET", Path: "/orgs/{org}/personal-access-token-requests"},
+	{Method: "POST", Path: "/orgs/{org}/personal-access-token-requests"},
+	{Method: "POST", Path: "/orgs/{org}/personal-access-token-requests/{pat_request_id}"},
+	{Method: "GET", Path: "/orgs/{org}/personal-access-token-requests/{pat_request_id}/repositories"},
+	{Method: "GET", Path: "/orgs/{org}/personal-access-tokens"},
+	{Method: "POST", Path: "/orgs/{org}/personal-access-tokens"},
+	{Method: "POST", Path: "/orgs/{org}/personal-access-tokens/{pat_id}"},
+	{Method: "GET", Path: "/orgs/{org}/personal-access-tokens/{pat_id}/repositories"},
+	{Method: "GET", Path: "/orgs/{org}/pre-receive-hooks"},
+	{Method: "DELETE", Path: "/orgs/{org}/pre-receive-hooks/{pre_receive_hook_id}"},
+	{Method: "GET", Path: "/orgs/{org}/pre-receive-hooks/{pre_receive_hook_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/pre-receive-hooks/{pre_receive_hook_id}"},
+	{Method: "GET", Path: "/orgs/{org}/private-registries"},
+	{Method: "POST", Path: "/orgs/{org}/private-registries"},
+	{Method: "GET", Path: "/orgs/{org}/private-registries/public-key"},
+	{Method: "DELETE", Path: "/orgs/{org}/private-registries/{secret_name}"},
+	{Method: "GET", Path: "/orgs/{org}/private-registries/{secret_name}"},
+	{Method: "PATCH", Path: "/orgs/{org}/private-registries/{secret_name}"},
+	{Method: "GET", Path: "/orgs/{org}/projects"},
+	{Method: "POST", Path: "/orgs/{org}/projects"},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2"},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2/{project_number}"},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2/{project_number}/fields"},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2/{project_number}/fields/{field_id}"},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2/{project_number}/items"},
+	{Method: "POST", Path: "/orgs/{org}/projectsV2/{project_number}/items"},
+	{Method: "DELETE", Path: "/orgs/{org}/projectsV2/{project_number}/items/{item_id}"},
+	{Method: "GET", Path: "/orgs/{org}/projectsV2/{project_number}/items/{item_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/projectsV2/{project_number}/items/{item_id}"},
+	{Method: "GET", Path: "/orgs/{org}/properties/schema"},
+	{Method: "PATCH", Path: "/orgs/{org}/properties/schema"},
+	{Method: "DELETE", Path: "/orgs/{org}/properties/schema/{custom_property_name}"},
+	{Method: "GET", Path: "/orgs/{org}/properties/schema/{custom_property_name}"},
+	{Method: "PUT", Path: "/orgs/{org}/properties/schema/{custom_property_name}"},
+	{Method: "GET", Path: "/orgs/{org}/properties/values"},
+	{Method: "PATCH", Path: "/orgs/{org}/properties/values"},
+	{Method: "GET", Path: "/orgs/{org}/public_members"},
+	{Method: "DELETE", Path: "/orgs/{org}/public_members/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/public_members/{username}"},
+	{Method: "PUT", Path: "/orgs/{org}/public_members/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/repos"},
+	{Method: "POST", Path: "/orgs/{org}/repos"},
+	{Method: "GET", Path: "/orgs/{org}/repository-fine-grained-permissions"},
+	{Method: "GET", Path: "/orgs/{org}/rulesets"},
+	{Method: "POST", Path: "/orgs/{org}/rulesets"},
+	{Method: "GET", Path: "/orgs/{org}/rulesets/rule-suites"},
+	{Method: "GET", Path: "/orgs/{org}/rulesets/rule-suites/{rule_suite_id}"},
+	{Method: "DELETE", Path: "/orgs/{org}/rulesets/{ruleset_id}"},
+	{Method: "GET", Path: "/orgs/{org}/rulesets/{ruleset_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/rulesets/{ruleset_id}"},
+	{Method: "GET", Path: "/orgs/{org}/rulesets/{ruleset_id}/history"},
+	{Method: "GET", Path: "/orgs/{org}/rulesets/{ruleset_id}/history/{version_id}"},
+	{Method: "GET", Path: "/orgs/{org}/secret-scanning/alerts"},
+	{Method: "GET", Path: "/orgs/{org}/secret-scanning/pattern-configurations"},
+	{Method: "PATCH", Path: "/orgs/{org}/secret-scanning/pattern-configurations"},
+	{Method: "GET", Path: "/orgs/{org}/security-advisories"},
+	{Method: "GET", Path: "/orgs/{org}/security-managers"},
+	{Method: "DELETE", Path: "/orgs/{org}/security-managers/teams/{team_slug}"},
+	{Method: "PUT", Path: "/orgs/{org}/security-managers/teams/{team_slug}"},
+	{Method: "GET", Path: "/orgs/{org}/settings/billing/actions"},
+	{Method: "GET", Path: "/orgs/{org}/settings/billing/advanced-security"},
+	{Method: "GET", Path: "/orgs/{org}/settings/billing/packages"},
+	{Method: "GET", Path: "/orgs/{org}/settings/billing/shared-storage"},
+	{Method: "GET", Path: "/orgs/{org}/settings/network-configurations"},
+	{Method: "POST", Path: "/orgs/{org}/settings/network-configurations"},
+	{Method: "DELETE", Path: "/orgs/{org}/settings/network-configurations/{network_configuration_id}"},
+	{Method: "GET", Path: "/orgs/{org}/settings/network-configurations/{network_configuration_id}"},
+	{Method: "PATCH", Path: "/orgs/{org}/settings/network-configurations/{network_configuration_id}"},
+	{Method: "GET", Path: "/orgs/{org}/settings/network-settings/{network_settings_id}"},
+	{Method: "GET", Path: "/orgs/{org}/team-sync/groups"},
+	{Method: "GET", Path: "/orgs/{org}/team/{team_slug}/copilot/metrics"},
+	{Method: "GET", Path: "/orgs/{org}/teams"},
+	{Method: "POST", Path: "/orgs/{org}/teams"},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}"},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions"},
+	{Method: "POST", Path: "/orgs/{org}/teams/{team_slug}/discussions"},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}"},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments"},
+	{Method: "POST", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments"},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}"},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}/reactions"},
+	{Method: "POST", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}/reactions"},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/comments/{comment_number}/reactions/{reaction_id}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/reactions"},
+	{Method: "POST", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/reactions"},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/discussions/{discussion_number}/reactions/{reaction_id}"},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/external-groups"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/external-groups"},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}/external-groups"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/invitations"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/members"},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/memberships/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/memberships/{username}"},
+	{Method: "PUT", Path: "/orgs/{org}/teams/{team_slug}/memberships/{username}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/projects"},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/projects/{project_id}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/projects/{project_id}"},
+	{Method: "PUT", Path: "/orgs/{org}/teams/{team_slug}/projects/{project_id}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/repos"},
+	{Method: "DELETE", Path: "/orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}"},
+	{Method: "PUT", Path: "/orgs/{org}/teams/{team_slug}/repos/{owner}/{repo}"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/team-sync/group-mappings"},
+	{Method: "PATCH", Path: "/orgs/{org}/teams/{team_slug}/team-sync/group-mappings"},
+	{Method: "GET", Path: "/orgs/{org}/teams/{team_slug}/teams"},
+	{Method: "POST", Path: "/orgs/{org}/{security_product}/{enablement}"},
+	{Method: "DELETE", Path: "/projects/columns/cards/{card_id}"},
+	{Method: "GET", Path: "/projects/columns/cards/{card_id}"},
+	{Method: "PATCH", Path: "/projects/columns/cards/{card_id}"},
+	{Method: "POST", Path: "/projects/columns/cards/{card_id}/moves"},
+	{Method: "DELETE", Path: "/projects/columns/{column_id}"},
+	{Method: "GET", Path: "/projects/columns/{column_id}"},
+	{Method: "PATCH", Path: "/projects/columns/{column_id}"},
+	{Method: "GET", Path: "/projects/columns/{column_id}/cards"},
+	{Method: "POST", Path: "/projects/columns/{column_id}/cards"},
+	{Method: "POST", Path: "/projects/columns/{column_id}/moves"},
+	{Method: "DELETE", Path: "/projects/{project_id}"},
+	{Method: "GET", Path: "/projects/{project_id}"},
+	{Method: "PATCH", Path: "/projects/{project_id}"},
+	{Method: "GET", Path: "/projects/{project_id}/collaborators"},
+	{Method: "DELETE", Path: "/projects/{project_id}/collaborators/{username}"},
+	{Method: "PUT", Path: "/projects/{project_id}/collaborators/{username}"},
+	{Method: "GET", Path: "/projects/{project_id}/collaborators/{username}/permission"},
+	{Method: "GET", Path: "/projects/{project_id}/columns"},
+	{Method: "POST", Path: "/projects/{project_id}/columns"},
+	{Method: "GET", Path: "/rate_limit"},
+	{Method: "DELETE", Path: "/reactions/{reaction_id}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/artifacts"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/artifacts/{artifact_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/artifacts/{artifact_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/artifacts/{artifact_id}/{archive_format}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/cache/usage"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/cache/usage-policy"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/actions/cache/usage-policy"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/caches"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/caches"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/caches/{cache_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/jobs/{job_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/jobs/{job_id}/logs"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/jobs/{job_id}/rerun"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/oidc/customization/sub"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/oidc/customization/sub"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/organization-secrets"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/organization-variables"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions/access"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions/access"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions/artifact-and-log-retention"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions/artifact-and-log-retention"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions/fork-pr-contributor-approval"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions/fork-pr-contributor-approval"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions/fork-pr-workflows-private-repos"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions/fork-pr-workflows-private-repos"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions/selected-actions"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions/selected-actions"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/permissions/workflow"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/permissions/workflow"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runners"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runners/downloads"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runners/generate-jitconfig"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runners/registration-token"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runners/remove-token"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/runners/{runner_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runners/{runner_id}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/runners/{runner_id}/labels"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runners/{runner_id}/labels"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runners/{runner_id}/labels"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/runners/{runner_id}/labels"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/runners/{runner_id}/labels/{name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/approvals"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/approve"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/artifacts"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/jobs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/attempts/{attempt_number}/logs"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/cancel"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/deployment_protection_rule"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/force-cancel"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/jobs"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/logs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/logs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/pending_deployments"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/pending_deployments"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/rerun"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/rerun-failed-jobs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/runs/{run_id}/timing"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/secrets"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/secrets/public-key"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/secrets/{secret_name}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/variables"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/variables"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/actions/variables/{name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/variables/{name}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/actions/variables/{name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/workflows"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/disable"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/dispatches"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/enable"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/runs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/actions/workflows/{workflow_id}/timing"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/activity"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/assignees"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/assignees/{assignee}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/attestations"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/attestations/{subject_digest}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/autolinks"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/autolinks"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/autolinks/{autolink_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/autolinks/{autolink_id}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/automated-security-fixes"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/automated-security-fixes"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/automated-security-fixes"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/branches/{branch}/protection"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/enforce_admins"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_signatures"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_signatures"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_signatures"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks/contexts"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks/contexts"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks/contexts"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/required_status_checks/contexts"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/apps"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/branches/{branch}/protection/restrictions/users"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/branches/{branch}/rename"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/bypass-requests/push-rules"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/bypass-requests/push-rules/{bypass_request_number}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/bypass-requests/secret-scanning"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/bypass-requests/secret-scanning/{bypass_request_number}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/bypass-requests/secret-scanning/{bypass_request_number}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/bypass-responses/secret-scanning/{bypass_response_id}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/check-runs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/check-runs/{check_run_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/check-runs/{check_run_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/check-runs/{check_run_id}/annotations"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/check-runs/{check_run_id}/rerequest"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/check-suites"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/check-suites/preferences"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/check-suites/{check_suite_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/check-suites/{check_suite_id}/check-runs"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/check-suites/{check_suite_id}/rerequest"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/alerts"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/alerts/{alert_number}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/code-scanning/alerts/{alert_number}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/autofix"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/autofix"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/autofix/commits"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/alerts/{alert_number}/instances"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/analyses"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/analyses/{analysis_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/codeql/databases"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/code-scanning/codeql/databases/{language}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/codeql/databases/{language}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/code-scanning/codeql/variant-analyses"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/codeql/variant-analyses/{codeql_variant_analysis_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/codeql/variant-analyses/{codeql_variant_analysis_id}/repos/{repo_owner}/{repo_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/default-setup"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/code-scanning/default-setup"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/code-scanning/sarifs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-scanning/sarifs/{sarif_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/code-security-configuration"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codeowners/errors"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/codespaces"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/devcontainers"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/machines"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/new"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/permissions_check"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/secrets"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/secrets/public-key"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/codespaces/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/codespaces/secrets/{secret_name}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/codespaces/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/collaborators"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/collaborators/{username}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/collaborators/{username}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/collaborators/{username}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/collaborators/{username}/permission"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/comments"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/comments/{comment_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/comments/{comment_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/comments/{comment_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/comments/{comment_id}/reactions"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/comments/{comment_id}/reactions"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/comments/{comment_id}/reactions/{reaction_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{commit_sha}/branches-where-head"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{commit_sha}/comments"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/commits/{commit_sha}/comments"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{commit_sha}/pulls"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}/check-runs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}/check-suites"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}/status"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/commits/{ref}/statuses"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/community/profile"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/compare/{basehead}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/content_references/{content_reference_id}/attachments"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/contents/{path}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/contents/{path}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/contents/{path}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/contributors"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/alerts"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/alerts/{alert_number}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/dependabot/alerts/{alert_number}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/secrets"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/secrets/public-key"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/dependabot/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependabot/secrets/{secret_name}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/dependabot/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependency-graph/compare/{basehead}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dependency-graph/sbom"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/dependency-graph/snapshots"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/deployments"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/deployments"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}/statuses"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}/statuses"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/deployments/{deployment_id}/statuses/{status_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dismissal-requests/code-scanning"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dismissal-requests/code-scanning/{alert_number}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/dismissal-requests/code-scanning/{alert_number}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dismissal-requests/secret-scanning"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/dismissal-requests/secret-scanning/{alert_number}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/dismissal-requests/secret-scanning/{alert_number}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/dispatches"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/environments/{environment_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/environments/{environment_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment-branch-policies/{branch_policy_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/apps"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/{protection_rule_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/deployment_protection_rules/{protection_rule_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/secrets"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/secrets/public-key"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/environments/{environment_name}/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/secrets/{secret_name}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/environments/{environment_name}/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables/{name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables/{name}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/environments/{environment_name}/variables/{name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/events"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/forks"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/forks"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/blobs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/blobs/{file_sha}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/commits"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/commits/{commit_sha}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/matching-refs/{ref}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/ref/{ref}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/refs"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/git/refs/{ref}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/git/refs/{ref}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/tags"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/tags/{tag_sha}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/git/trees"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/git/trees/{tree_sha}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/hooks"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/hooks/{hook_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks/{hook_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/hooks/{hook_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/config"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/config"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/deliveries"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/deliveries/{delivery_id}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/deliveries/{delivery_id}/attempts"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/pings"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/hooks/{hook_id}/tests"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/import"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/import"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/import"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import/authors"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/import/authors/{author_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import/issues"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/import/issues"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import/issues/{issue_number}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/import/large_files"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/import/lfs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/installation"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/interaction-limits"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/interaction-limits"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/interaction-limits"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/invitations"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/invitations/{invitation_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/invitations/{invitation_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/comments"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}/reactions"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}/reactions"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/comments/{comment_id}/reactions/{reaction_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/events"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/events/{event_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/issues/{issue_number}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/assignees"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/assignees"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/assignees/{assignee}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/comments"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/comments"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocked_by"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocked_by"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocked_by/{issue_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/dependencies/blocking"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/events"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/labels/{name}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/lock"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/issues/{issue_number}/lock"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/parent"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/reactions"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/reactions"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/reactions/{reaction_id}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issue"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/issues/{issue_number}/sub_issues/priority"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/issues/{issue_number}/timeline"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/keys"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/keys"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/keys/{key_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/keys/{key_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/labels"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/labels"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/labels/{name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/labels/{name}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/labels/{name}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/languages"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/lfs"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/lfs"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/license"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/merge-upstream"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/merges"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/milestones"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/milestones"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/milestones/{milestone_number}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/milestones/{milestone_number}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/milestones/{milestone_number}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/milestones/{milestone_number}/labels"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/notifications"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/notifications"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pages"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pages"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pages"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/builds"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pages/builds"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/builds/latest"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/builds/{build_id}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pages/deployment"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pages/deployments"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pages/deployments/{pages_deployment_id}/cancel"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pages/health"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pre-receive-hooks"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pre-receive-hooks/{pre_receive_hook_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pre-receive-hooks/{pre_receive_hook_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/pre-receive-hooks/{pre_receive_hook_id}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/private-vulnerability-reporting"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/private-vulnerability-reporting"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/private-vulnerability-reporting"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/projects"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/projects"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/properties/values"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/properties/values"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/comments"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pulls/comments/{comment_id}/reactions/{reaction_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/pulls/{pull_number}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/codespaces"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/comments"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/comments"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/comments/{comment_id}/replies"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/commits"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/files"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/merge"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/merge"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/requested_reviewers"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/comments"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/dismissals"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/reviews/{review_id}/events"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/pulls/{pull_number}/update-branch"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/readme"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/readme/{dir}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/releases"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/releases/assets/{asset_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/assets/{asset_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/releases/assets/{asset_id}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/releases/generate-notes"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/latest"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/tags/{tag}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/releases/{release_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/{release_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/releases/{release_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/{release_id}/assets"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/releases/{release_id}/assets"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/releases/{release_id}/reactions"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/releases/{release_id}/reactions"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/releases/{release_id}/reactions/{reaction_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/replicas/caches"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rules/branches/{branch}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rulesets"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/rulesets"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rulesets/rule-suites"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rulesets/rule-suites/{rule_suite_id}"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/rulesets/{ruleset_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rulesets/{ruleset_id}"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/rulesets/{ruleset_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rulesets/{ruleset_id}/history"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/rulesets/{ruleset_id}/history/{version_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/secret-scanning/alerts"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/secret-scanning/alerts/{alert_number}/locations"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/secret-scanning/push-protection-bypasses"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/secret-scanning/scan-history"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/security-advisories"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/security-advisories"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/security-advisories/reports"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/security-advisories/{ghsa_id}"},
+	{Method: "PATCH", Path: "/repos/{owner}/{repo}/security-advisories/{ghsa_id}"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/security-advisories/{ghsa_id}/cve"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/security-advisories/{ghsa_id}/forks"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stargazers"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/code_frequency"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/commit_activity"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/contributors"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/participation"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/stats/punch_card"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/statuses/{sha}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/subscribers"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/subscription"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/subscription"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/subscription"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/tags"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/tags/protection"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/tags/protection"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/tags/protection/{tag_protection_id}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/tarball/{ref}"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/teams"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/topics"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/topics"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/traffic/clones"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/traffic/popular/paths"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/traffic/popular/referrers"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/traffic/views"},
+	{Method: "POST", Path: "/repos/{owner}/{repo}/transfer"},
+	{Method: "DELETE", Path: "/repos/{owner}/{repo}/vulnerability-alerts"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/vulnerability-alerts"},
+	{Method: "PUT", Path: "/repos/{owner}/{repo}/vulnerability-alerts"},
+	{Method: "GET", Path: "/repos/{owner}/{repo}/zipball/{ref}"},
+	{Method: "POST", Path: "/repos/{template_owner}/{template_repo}/generate"},
+	{Method: "GET", Path: "/repositories"},
+	{Method: "GET", Path: "/repositories/{repository_id}"},
+	{Method: "GET", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets"},
+	{Method: "GET", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets/public-key"},
+	{Method: "DELETE", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}"},
+	{Method: "PUT", Path: "/repositories/{repository_id}/environments/{environment_name}/secrets/{secret_name}"},
+	{Method: "GET", Path: "/repositories/{repository_id}/installation"},
+	{Method: "GET", Path: "/scim/v2/Groups"},
+	{Method: "POST", Path: "/scim/v2/Groups"},
+	{Method: "DELETE", Path: "/scim/v2/Groups/{scim_group_id}"},
+	{Method: "GET", Path: "/scim/v2/Groups/{scim_group_id}"},
+	{Method: "PATCH", Path: "/scim/v2/Groups/{scim_group_id}"},
+	{Method: "PUT", Path: "/scim/v2/Groups/{scim_group_id}"},
+	{Method: "GET", Path: "/scim/v2/Users"},
+	{Method: "POST", Path: "/scim/v2/Users"},
+	{Method: "DELETE", Path: "/scim/v2/Users/{scim_user_id}"},
+	{Method: "GET", Path: "/scim/v2/Users/{scim_user_id}"},
+	{Method: "PATCH", Path: "/scim/v2/Users/{scim_user_id}"},
+	{Method: "PUT", Path: "/scim/v2/Users/{scim_user_id}"},
+	{Method: "GET", Path: "/scim/v2/enterprises/{enterprise}/Groups"},
+	{Method: "POST", Path: "/scim/v2/enterprises/{enterprise}/Groups"},
+	{Method: "DELETE", Path: "/scim/v2/enterprises/{enterprise}/Groups/{scim_group_id}"},
+	{Method: "GET", Path: "/scim/v2/enterprises/{enterprise}/Groups/{scim_group_id}"},
+	{Method: "PATCH", Path: "/scim/v2/enterprises/{enterprise}/Groups/{scim_group_id}"},
+	{Method: "PUT", Path: "/scim/v2/enterprises/{enterprise}/Groups/{scim_group_id}"},
+	{Method: "GET", Path: "/scim/v2/enterprises/{enterprise}/Users"},
+	{Method: "POST", Path: "/scim/v2/enterprises/{enterprise}/Users"},
+	{Method: "DELETE", Path: "/scim/v2/enterprises/{enterprise}/Users/{scim_user_id}"},
+	{Method: "GET", Path: "/scim/v2/enterprises/{enterprise}/Users/{scim_user_id}"},
+	{Method: "PATCH", Path: "/scim/v2/enterprises/{enterprise}/Users/{scim_user_id}"},
+	{Method: "PUT", Path: "/scim/v2/enterprises/{enterprise}/Users/{scim_user_id}"},
+	{Method: "GET", Path: "/scim/v2/organizations/{org}/Users"},
+	{Method: "POST", Path: "/scim/v2/organizations/{org}/Users"},
+	{Method: "DELETE", Path: "/scim/v2/organizations/{org}/Users/{scim_user_id}"},
+	{Method: "GET", Path: "/scim/v2/organizations/{org}/Users/{scim_user_id}"},
+	{Method: "PATCH", Path: "/scim/v2/organizations/{org}/Users/{scim_user_id}"},
+	{Method: "PUT", Path: "/scim/v2/organizations/{org}/Users/{scim_user_id}"},
+	{Method: "GET", Path: "/search/code"},
+	{Method: "GET", Path: "/search/commits"},
+	{Method: "GET", Path: "/search/issues"},
+	{Method: "GET", Path: "/search/labels"},
+	{Method: "GET", Path: "/search/repositories"},
+	{Method: "GET", Path: "/search/topics"},
+	{Method: "GET", Path: "/search/users"},
+	{Method: "GET", Path: "/setup/api/configcheck"},
+	{Method: "POST", Path: "/setup/api/configure"},
+	{Method: "GET", Path: "/setup/api/maintenance"},
+	{Method: "POST", Path: "/setup/api/maintenance"},
+	{Method: "GET", Path: "/setup/api/settings"},
+	{Method: "PUT", Path: "/setup/api/settings"},
+	{Method: "DELETE", Path: "/setup/api/settings/authorized-keys"},
+	{Method: "GET", Path: "/setup/api/settings/authorized-keys"},
+	{Method: "POST", Path: "/setup/api/settings/authorized-keys"},
+	{Method: "POST", Path: "/setup/api/start"},
+	{Method: "POST", Path: "/setup/api/upgrade"},
+	{Method: "DELETE", Path: "/teams/{team_id}"},
+	{Method: "GET", Path: "/teams/{team_id}"},
+	{Method: "PATCH", Path: "/teams/{team_id}"},
+	{Method: "GET", Path: "/teams/{team_id}/discussions"},
+	{Method: "POST", Path: "/teams/{team_id}/discussions"},
+	{Method: "DELETE", Path: "/teams/{team_id}/discussions/{discussion_number}"},
+	{Method: "GET", Path: "/teams/{team_id}/discussions/{discussion_number}"},
+	{Method: "PATCH", Path: "/teams/{team_id}/discussions/{discussion_number}"},
+	{Method: "GET", Path: "/teams/{team_id}/discussions/{discussion_number}/comments"},
+	{Method: "POST", Path: "/teams/{team_id}/discussions/{discussion_number}/comments"},
+	{Method: "DELETE", Path: "/teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}"},
+	{Method: "GET", Path: "/teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}"},
+	{Method: "PATCH", Path: "/teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}"},
+	{Method: "GET", Path: "/teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}/reactions"},
+	{Method: "POST", Path: "/teams/{team_id}/discussions/{discussion_number}/comments/{comment_number}/reactions"},
+	{Method: "GET", Path: "/teams/{team_id}/discussions/{discussion_number}/reactions"},
+	{Method: "POST", Path: "/teams/{team_id}/discussions/{discussion_number}/reactions"},
+	{Method: "GET", Path: "/teams/{team_id}/invitations"},
+	{Method: "GET", Path: "/teams/{team_id}/members"},
+	{Method: "DELETE", Path: "/teams/{team_id}/members/{username}"},
+	{Method: "GET", Path: "/teams/{team_id}/members/{username}"},
+	{Method: "PUT", Path: "/teams/{team_id}/members/{username}"},
+	{Method: "DELETE", Path: "/teams/{team_id}/memberships/{username}"},
+	{Method: "GET", Path: "/teams/{team_id}/memberships/{username}"},
+	{Method: "PUT", Path: "/teams/{team_id}/memberships/{username}"},
+	{Method: "GET", Path: "/teams/{team_id}/projects"},
+	{Method: "DELETE", Path: "/teams/{team_id}/projects/{project_id}"},
+	{Method: "GET", Path: "/teams/{team_id}/projects/{project_id}"},
+	{Method: "PUT", Path: "/teams/{team_id}/projects/{project_id}"},
+	{Method: "GET", Path: "/teams/{team_id}/repos"},
+	{Method: "DELETE", Path: "/teams/{team_id}/repos/{owner}/{repo}"},
+	{Method: "GET", Path: "/teams/{team_id}/repos/{owner}/{repo}"},
+	{Method: "PUT", Path: "/teams/{team_id}/repos/{owner}/{repo}"},
+	{Method: "GET", Path: "/teams/{team_id}/team-sync/group-mappings"},
+	{Method: "PATCH", Path: "/teams/{team_id}/team-sync/group-mappings"},
+	{Method: "GET", Path: "/teams/{team_id}/teams"},
+	{Method: "GET", Path: "/user"},
+	{Method: "PATCH", Path: "/user"},
+	{Method: "GET", Path: "/user/blocks"},
+	{Method: "DELETE", Path: "/user/blocks/{username}"},
+	{Method: "GET", Path: "/user/blocks/{username}"},
+	{Method: "PUT", Path: "/user/blocks/{username}"},
+	{Method: "GET", Path: "/user/codespaces"},
+	{Method: "POST", Path: "/user/codespaces"},
+	{Method: "GET", Path: "/user/codespaces/secrets"},
+	{Method: "GET", Path: "/user/codespaces/secrets/public-key"},
+	{Method: "DELETE", Path: "/user/codespaces/secrets/{secret_name}"},
+	{Method: "GET", Path: "/user/codespaces/secrets/{secret_name}"},
+	{Method: "PUT", Path: "/user/codespaces/secrets/{secret_name}"},
+	{Method: "GET", Path: "/user/codespaces/secrets/{secret_name}/repositories"},
+	{Method: "PUT", Path: "/user/codespaces/secrets/{secret_name}/repositories"},
+	{Method: "DELETE", Path: "/user/codespaces/secrets/{secret_name}/repositories/{repository_id}"},
+	{Method: "PUT", Path: "/user/codespaces/secrets/{secret_name}/repositories/{repository_id}"},
+	{Method: "DELETE", Path: "/user/codespaces/{codespace_name}"},
+	{Method: "GET", Path: "/user/codespaces/{codespace_name}"},
+	{Method: "PATCH", Path: "/user/codespaces/{codespace_name}"},
+	{Method: "POST", Path: "/user/codespaces/{codespace_name}/exports"},
+	{Method: "GET", Path: "/user/codespaces/{codespace_name}/exports/{export_id}"},
+	{Method: "GET", Path: "/user/codespaces/{codespace_name}/machines"},
+	{Method: "POST", Path: "/user/codespaces/{codespace_name}/publish"},
+	{Method: "POST", Path: "/user/codespaces/{codespace_name}/start"},
+	{Method: "POST", Path: "/user/codespaces/{codespace_name}/stop"},
+	{Method: "GET", Path: "/user/docker/conflicts"},
+	{Method: "PATCH", Path: "/user/email/visibility"},
+	{Method: "DELETE", Path: "/user/emails"},
+	{Method: "GET", Path: "/user/emails"},
+	{Method: "POST", Path: "/user/emails"},
+	{Method: "GET", Path: "/user/followers"},
+	{Method: "GET", Path: "/user/following"},
+	{Method: "DELETE", Path: "/user/following/{username}"},
+	{Method: "GET", Path: "/user/following/{username}"},
+	{Method: "PUT", Path: "/user/following/{username}"},
+	{Method: "GET", Path: "/user/gpg_keys"},
+	{Method: "POST", Path: "/user/gpg_keys"},
+	{Method: "DELETE", Path: "/user/gpg_keys/{gpg_key_id}"},
+	{Method: "GET", Path: "/user/gpg_keys/{gpg_key_id}"},
+	{Method: "GET", Path: "/user/installations"},
+	{Method: "GET", Path: "/user/installations/{installation_id}/repositories"},
+	{Method: "DELETE", Path: "/user/installations/{installation_id}/repositories/{repository_id}"},
+	{Method: "PUT", Path: "/user/installations/{installation_id}/repositories/{repository_id}"},
+	{Method: "DELETE", Path: "/user/interaction-limits"},
+	{Method: "GET", Path: "/user/interaction-limits"},
+	{Method: "PUT", Path: "/user/interaction-limits"},
+	{Method: "GET", Path: "/user/issues"},
+	{Method: "GET", Path: "/user/keys"},
+	{Method: "POST", Path: "/user/keys"},
+	{Method: "DELETE", Path: "/user/keys/{key_id}"},
+	{Method: "GET", Path: "/user/keys/{key_id}"},
+	{Method: "GET", Path: "/user/marketplace_purchases"},
+	{Method: "GET", Path: "/user/marketplace_purchases/stubbed"},
+	{Method: "GET", Path: "/user/memberships/orgs"},
+	{Method: "GET", Path: "/user/memberships/orgs/{org}"},
+	{Method: "PATCH", Path: "/user/memberships/orgs/{org}"},
+	{Method: "GET", Path: "/user/migrations"},
+	{Method: "POST", Path: "/user/migrations"},
+	{Method: "GET", Path: "/user/migrations/{migration_id}"},
+	{Method: "DELETE", Path: "/user/migrations/{migration_id}/archive"},
+	{Method: "GET", Path: "/user/migrations/{migration_id}/archive"},
+	{Method: "DELETE", Path: "/user/migrations/{migration_id}/repos/{repo_name}/lock"},
+	{Method: "GET", Path: "/user/migrations/{migration_id}/repositories"},
+	{Method: "GET", Path: "/user/orgs"},
+	{Method: "GET", Path: "/user/packages"},
+	{Method: "DELETE", Path: "/user/packages/{package_type}/{package_name}"},
+	{Method: "GET", Path: "/user/packages/{package_type}/{package_name}"},
+	{Method: "POST", Path: "/user/packages/{package_type}/{package_name}/restore"},
+	{Method: "GET", Path: "/user/packages/{package_type}/{package_name}/versions"},
+	{Method: "DELETE", Path: "/user/packages/{package_type}/{package_name}/versions/{package_version_id}"},
+	{Method: "GET", Path: "/user/packages/{package_type}/{package_name}/versions/{package_version_id}"},
+	{Method: "POST", Path: "/user/packages/{package_type}/{package_name}/versions/{package_version_id}/restore"},
+	{Method: "POST", Path: "/user/projects"},
+	{Method: "GET", Path: "/user/public_emails"},
+	{Method: "GET", Path: "/user/repos"},
+	{Method: "POST", Path: "/user/repos"},
+	{Method: "GET", Path: "/user/repository_invitations"},
+	{Method: "DELETE", Path: "/user/repository_invitations/{invitation_id}"},
+	{Method: "PATCH", Path: "/user/repository_invitations/{invitation_id}"},
+	{Method: "DELETE", Path: "/user/social_accounts"},
+	{Method: "GET", Path: "/user/social_accounts"},
+	{Method: "POST", Path: "/user/social_accounts"},
+	{Method: "GET", Path: "/user/ssh_signing_keys"},
+	{Method: "POST", Path: "/user/ssh_signing_keys"},
+	{Method: "DELETE", Path: "/user/ssh_signing_keys/{ssh_signing_key_id}"},
+	{Method: "GET", Path: "/user/ssh_signing_keys/{ssh_signing_key_id}"},
+	{Method: "GET", Path: "/user/starred"},
+	{Method: "DELETE", Path: "/user/starred/{owner}/{repo}"},
+	{Method: "GET", Path: "/user/starred/{owner}/{repo}"},
+	{Method: "PUT", Path: "/user/starred/{owner}/{repo}"},
+	{Method: "GET", Path: "/user/subscriptions"},
+	{Method: "GET", Path: "/user/teams"},
+	{Method: "GET", Path: "/user/{account_id}"},
+	{Method: "GET", Path: "/users"},
+	{Method: "GET", Path: "/users/{username}"},
+	{Method: "POST", Path: "/users/{username}/attestations/bulk-list"},
+	{Method: "POST", Path: "/users/{username}/attestations/delete-request"},
+	{Method: "DELETE", Path: "/users/{username}/attestations/digest/{subject_digest}"},
+	{Method: "DELETE", Path: "/users/{username}/attestations/{attestation_id}"},
+	{Method: "GET", Path: "/users/{username}/attestations/{subject_digest}"},
+	{Method: "GET", Path: "/users/{username}/docker/conflicts"},
+	{Method: "GET", Path: "/users/{username}/events"},
+	{Method: "GET", Path: "/users/{username}/events/orgs/{org}"},
+	{Method: "GET", Path: "/users/{username}/events/public"},
+	{Method: "GET", Path: "/users/{username}/followers"},
+	{Method: "GET", Path: "/users/{username}/following"},
+	{Method: "GET", Path: "/users/{username}/following/{target_user}"},
+	{Method: "GET", Path: "/users/{username}/gists"},
+	{Method: "GET", Path: "/users/{username}/gpg_keys"},
+	{Method: "GET", Path: "/users/{username}/hovercard"},
+	{Method: "GET", Path: "/users/{username}/installation"},
+	{Method: "GET", Path: "/users/{username}/keys"},
+	{Method: "GET", Path: "/users/{username}/orgs"},
+	{Method: "GET", Path: "/users/{username}/packages"},
+	{Method: "DELETE", Path: "/users/{username}/packages/{package_type}/{package_name}"},
+	{Method: "GET", Path: "/users/{username}/packages/{package_type}/{package_name}"},
+	{Method: "POST", Path: "/users/{username}/packages/{package_type}/{package_name}/restore"},
+	{Method: "GET", Path: "/users/{username}/packages/{package_type}/{package_name}/versions"},
+	{Method: "DELETE", Path: "/users/{username}/packages/{package_type}/{package_name}/versions/{package_version_id}"},
+	{Method: "GET", Path: "/users/{username}/packages/{package_type}/{package_name}/versions/{package_version_id}"},
+	{Method: "POST", Path: "/users/{username}/packages/{package_type}/{package_name}/versions/{package_version_id}/restore"},
+	{Method: "GET", Path: "/users/{username}/projects"},
+	{Method: "GET", Path: "/users/{username}/projectsV2"},
+	{Method: "GET", Path: "/users/{username}/projectsV2/{project_number}"},
+	{Method: "GET", Path: "/users/{username}/projectsV2/{project_number}/fields"},
+	{Method: "GET", Path: "/users/{username}/projectsV2/{project_number}/fields/{field_id}"},
+	{Method: "GET", Path: "/users/{username}/projectsV2/{project_number}/items"},
+	{Method: "POST", Path: "/users/{username}/projectsV2/{project_number}/items"},
+	{Method: "DELETE", Path: "/users/{username}/projectsV2/{project_number}/items/{item_id}"},
+	{Method: "GET", Path: "/users/{username}/projectsV2/{project_number}/items/{item_id}"},
+	{Method: "PATCH", Path: "/users/{username}/projectsV2/{project_number}/items/{item_id}"},
+	{Method: "GET", Path: "/users/{username}/received_events"},
+	{Method: "GET", Path: "/users/{username}/received_events/public"},
+	{Method: "GET", Path: "/users/{username}/repos"},
+	{Method: "GET", Path: "/users/{username}/settings/billing/actions"},
+	{Method: "GET", Path: "/users/{username}/settings/billing/packages"},
+	{Method: "GET", Path: "/users/{username}/settings/billing/premium_request/usage"},
+	{Method: "GET", Path: "/users/{username}/settings/billing/shared-storage"},
+	{Method: "GET", Path: "/users/{username}/settings/billing/usage"},
+	{Method: "DELETE", Path: "/users/{username}/site_admin"},
+	{Method: "PUT", Path: "/users/{username}/site_admin"},
+	{Method: "GET", Path: "/users/{username}/social_accounts"},
+	{Method: "GET", Path: "/users/{username}/ssh_signing_keys"},
+	{Method: "GET", Path: "/users/{username}/starred"},
+	{Method: "GET", Path: "/users/{username}/subscriptions"},
+	{Method: "DELETE", Path: "/users/{username}/suspended"},
+	{Method: "PUT", Path: "/users/{username}/suspended"},
+	{Method: "GET", Path: "/versions"},
+	{Method: "GET", Path: "/zen"},
+}
@@ -0,0 +1,559 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-webhook-handlers; DO NOT EDIT.
+// Instead, please run "go generate ./..." as described here:
+// https://github.com/google/go-github/blob/master/CONTRIBUTING.md#submitting-a-patch
+
+package github
+
+import "context"
+
+// OnBranchProtectionConfiguration registers fn to be called for "branch_protection_configuration" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnBranchProtectionConfiguration(fn func(ctx context.Context, event *BranchProtectionConfigurationEvent) error, actions ...string) {
+	h.on("branch_protection_configuration", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*BranchProtectionConfigurationEvent))
+	})
+}
+
+// OnBranchProtectionRule registers fn to be called for "branch_protection_rule" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnBranchProtectionRule(fn func(ctx context.Context, event *BranchProtectionRuleEvent) error, actions ...string) {
+	h.on("branch_protection_rule", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*BranchProtectionRuleEvent))
+	})
+}
+
+// OnCheckRun registers fn to be called for "check_run" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnCheckRun(fn func(ctx context.Context, event *CheckRunEvent) error, actions ...string) {
+	h.on("check_run", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*CheckRunEvent))
+	})
+}
+
+// OnCheckSuite registers fn to be called for "check_suite" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnCheckSuite(fn func(ctx context.Context, event *CheckSuiteEvent) error, actions ...string) {
+	h.on("check_suite", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*CheckSuiteEvent))
+	})
+}
+
+// OnCodeScanningAlert registers fn to be called for "code_scanning_alert" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnCodeScanningAlert(fn func(ctx context.Context, event *CodeScanningAlertEvent) error, actions ...string) {
+	h.on("code_scanning_alert", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*CodeScanningAlertEvent))
+	})
+}
+
+// OnCommitComment registers fn to be called for "commit_comment" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnCommitComment(fn func(ctx context.Context, event *CommitCommentEvent) error, actions ...string) {
+	h.on("commit_comment", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*CommitCommentEvent))
+	})
+}
+
+// OnContentReference registers fn to be called for "content_reference" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnContentReference(fn func(ctx context.Context, event *ContentReferenceEvent) error, actions ...string) {
+	h.on("content_reference", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*ContentReferenceEvent))
+	})
+}
+
+// OnCreate registers fn to be called for "create" webhook events.
+func (h *WebhookHandler) OnCreate(fn func(ctx context.Context, event *CreateEvent) error) {
+	h.on("create", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*CreateEvent))
+	})
+}
+
+// OnCustomProperty registers fn to be called for "custom_property" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnCustomProperty(fn func(ctx context.Context, event *CustomPropertyEvent) error, actions ...string) {
+	h.on("custom_property", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*CustomPropertyEvent))
+	})
+}
+
+// OnCustomPropertyValues registers fn to be called for "custom_property_values" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnCustomPropertyValues(fn func(ctx context.Context, event *CustomPropertyValuesEvent) error, actions ...string) {
+	h.on("custom_property_values", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*CustomPropertyValuesEvent))
+	})
+}
+
+// OnDelete registers fn to be called for "delete" webhook events.
+func (h *WebhookHandler) OnDelete(fn func(ctx context.Context, event *DeleteEvent) error) {
+	h.on("delete", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*DeleteEvent))
+	})
+}
+
+// OnDependabotAlert registers fn to be called for "dependabot_alert" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnDependabotAlert(fn func(ctx context.Context, event *DependabotAlertEvent) error, actions ...string) {
+	h.on("dependabot_alert", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*DependabotAlertEvent))
+	})
+}
+
+// OnDeployKey registers fn to be called for "deploy_key" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnDeployKey(fn func(ctx context.Context, event *DeployKeyEvent) error, actions ...string) {
+	h.on("deploy_key", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*DeployKeyEvent))
+	})
+}
+
+// OnDeployment registers fn to be called for "deployment" webhook events.
+func (h *WebhookHandler) OnDeployment(fn func(ctx context.Context, event *DeploymentEvent) error) {
+	h.on("deployment", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*DeploymentEvent))
+	})
+}
+
+// OnDeploymentProtectionRule registers fn to be called for "deployment_protection_rule" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnDeploymentProtectionRule(fn func(ctx context.Context, event *DeploymentProtectionRuleEvent) error, actions ...string) {
+	h.on("deployment_protection_rule", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*DeploymentProtectionRuleEvent))
+	})
+}
+
+// OnDeploymentReview registers fn to be called for "deployment_review" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnDeploymentReview(fn func(ctx context.Context, event *DeploymentReviewEvent) error, actions ...string) {
+	h.on("deployment_review", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*DeploymentReviewEvent))
+	})
+}
+
+// OnDeploymentStatus registers fn to be called for "deployment_status" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnDeploymentStatus(fn func(ctx context.Context, event *DeploymentStatusEvent) error, actions ...string) {
+	h.on("deployment_status", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*DeploymentStatusEvent))
+	})
+}
+
+// OnDiscussion registers fn to be called for "discussion" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnDiscussion(fn func(ctx context.Context, event *DiscussionEvent) error, actions ...string) {
+	h.on("discussion", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*DiscussionEvent))
+	})
+}
+
+// OnDiscussionComment registers fn to be called for "discussion_comment" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnDiscussionComment(fn func(ctx context.Context, event *DiscussionCommentEvent) error, actions ...string) {
+	h.on("discussion_comment", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*DiscussionCommentEvent))
+	})
+}
+
+// OnFork registers fn to be called for "fork" webhook events.
+func (h *WebhookHandler) OnFork(fn func(ctx context.Context, event *ForkEvent) error) {
+	h.on("fork", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*ForkEvent))
+	})
+}
+
+// OnGitHubAppAuthorization registers fn to be called for "github_app_authorization" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnGitHubAppAuthorization(fn func(ctx context.Context, event *GitHubAppAuthorizationEvent) error, actions ...string) {
+	h.on("github_app_authorization", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*GitHubAppAuthorizationEvent))
+	})
+}
+
+// OnGollum registers fn to be called for "gollum" webhook events.
+func (h *WebhookHandler) OnGollum(fn func(ctx context.Context, event *GollumEvent) error) {
+	h.on("gollum", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*GollumEvent))
+	})
+}
+
+// OnInstallation registers fn to be called for "installation" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnInstallation(fn func(ctx context.Context, event *InstallationEvent) error, actions ...string) {
+	h.on("installation", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*InstallationEvent))
+	})
+}
+
+// OnInstallationRepositories registers fn to be called for "installation_repositories" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnInstallationRepositories(fn func(ctx context.Context, event *InstallationRepositoriesEvent) error, actions ...string) {
+	h.on("installation_repositories", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*InstallationRepositoriesEvent))
+	})
+}
+
+// OnInstallationTarget registers fn to be called for "installation_target" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnInstallationTarget(fn func(ctx context.Context, event *InstallationTargetEvent) error, actions ...string) {
+	h.on("installation_target", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*InstallationTargetEvent))
+	})
+}
+
+// OnIssueComment registers fn to be called for "issue_comment" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnIssueComment(fn func(ctx context.Context, event *IssueCommentEvent) error, actions ...string) {
+	h.on("issue_comment", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*IssueCommentEvent))
+	})
+}
+
+// OnIssues registers fn to be called for "issues" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnIssues(fn func(ctx context.Context, event *IssuesEvent) error, actions ...string) {
+	h.on("issues", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*IssuesEvent))
+	})
+}
+
+// OnLabel registers fn to be called for "label" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnLabel(fn func(ctx context.Context, event *LabelEvent) error, actions ...string) {
+	h.on("label", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*LabelEvent))
+	})
+}
+
+// OnMarketplacePurchase registers fn to be called for "marketplace_purchase" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnMarketplacePurchase(fn func(ctx context.Context, event *MarketplacePurchaseEvent) error, actions ...string) {
+	h.on("marketplace_purchase", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*MarketplacePurchaseEvent))
+	})
+}
+
+// OnMember registers fn to be called for "member" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnMember(fn func(ctx context.Context, event *MemberEvent) error, actions ...string) {
+	h.on("member", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*MemberEvent))
+	})
+}
+
+// OnMembership registers fn to be called for "membership" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnMembership(fn func(ctx context.Context, event *MembershipEvent) error, actions ...string) {
+	h.on("membership", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*MembershipEvent))
+	})
+}
+
+// OnMergeGroup registers fn to be called for "merge_group" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnMergeGroup(fn func(ctx context.Context, event *MergeGroupEvent) error, actions ...string) {
+	h.on("merge_group", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*MergeGroupEvent))
+	})
+}
+
+// OnMeta registers fn to be called for "meta" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnMeta(fn func(ctx context.Context, event *MetaEvent) error, actions ...string) {
+	h.on("meta", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*MetaEvent))
+	})
+}
+
+// OnMilestone registers fn to be called for "milestone" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnMilestone(fn func(ctx context.Context, event *MilestoneEvent) error, actions ...string) {
+	h.on("milestone", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*MilestoneEvent))
+	})
+}
+
+// OnOrgBlock registers fn to be called for "org_block" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnOrgBlock(fn func(ctx context.Context, event *OrgBlockEvent) error, actions ...string) {
+	h.on("org_block", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*OrgBlockEvent))
+	})
+}
+
+// OnOrganization registers fn to be called for "organization" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnOrganization(fn func(ctx context.Context, event *OrganizationEvent) error, actions ...string) {
+	h.on("organization", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*OrganizationEvent))
+	})
+}
+
+// OnPackage registers fn to be called for "package" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnPackage(fn func(ctx context.Context, event *PackageEvent) error, actions ...string) {
+	h.on("package", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PackageEvent))
+	})
+}
+
+// OnPageBuild registers fn to be called for "page_build" webhook events.
+func (h *WebhookHandler) OnPageBuild(fn func(ctx context.Context, event *PageBuildEvent) error) {
+	h.on("page_build", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PageBuildEvent))
+	})
+}
+
+// OnPersonalAccessTokenRequest registers fn to be called for "personal_access_token_request" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnPersonalAccessTokenRequest(fn func(ctx context.Context, event *PersonalAccessTokenRequestEvent) error, actions ...string) {
+	h.on("personal_access_token_request", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PersonalAccessTokenRequestEvent))
+	})
+}
+
+// OnPing registers fn to be called for "ping" webhook events.
+func (h *WebhookHandler) OnPing(fn func(ctx context.Context, event *PingEvent) error) {
+	h.on("ping", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PingEvent))
+	})
+}
+
+// OnProjectV2 registers fn to be called for "projects_v2" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnProjectV2(fn func(ctx context.Context, event *ProjectV2Event) error, actions ...string) {
+	h.on("projects_v2", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*ProjectV2Event))
+	})
+}
+
+// OnProjectV2Item registers fn to be called for "projects_v2_item" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnProjectV2Item(fn func(ctx context.Context, event *ProjectV2ItemEvent) error, actions ...string) {
+	h.on("projects_v2_item", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*ProjectV2ItemEvent))
+	})
+}
+
+// OnPublic registers fn to be called for "public" webhook events.
+func (h *WebhookHandler) OnPublic(fn func(ctx context.Context, event *PublicEvent) error) {
+	h.on("public", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PublicEvent))
+	})
+}
+
+// OnPullRequest registers fn to be called for "pull_request" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnPullRequest(fn func(ctx context.Context, event *PullRequestEvent) error, actions ...string) {
+	h.on("pull_request", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PullRequestEvent))
+	})
+}
+
+// OnPullRequestReview registers fn to be called for "pull_request_review" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnPullRequestReview(fn func(ctx context.Context, event *PullRequestReviewEvent) error, actions ...string) {
+	h.on("pull_request_review", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PullRequestReviewEvent))
+	})
+}
+
+// OnPullRequestReviewComment registers fn to be called for "pull_request_review_comment" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnPullRequestReviewComment(fn func(ctx context.Context, event *PullRequestReviewCommentEvent) error, actions ...string) {
+	h.on("pull_request_review_comment", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PullRequestReviewCommentEvent))
+	})
+}
+
+// OnPullRequestReviewThread registers fn to be called for "pull_request_review_thread" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnPullRequestReviewThread(fn func(ctx context.Context, event *PullRequestReviewThreadEvent) error, actions ...string) {
+	h.on("pull_request_review_thread", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PullRequestReviewThreadEvent))
+	})
+}
+
+// OnPullRequestTarget registers fn to be called for "pull_request_target" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnPullRequestTarget(fn func(ctx context.Context, event *PullRequestTargetEvent) error, actions ...string) {
+	h.on("pull_request_target", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PullRequestTargetEvent))
+	})
+}
+
+// OnPush registers fn to be called for "push" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnPush(fn func(ctx context.Context, event *PushEvent) error, actions ...string) {
+	h.on("push", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*PushEvent))
+	})
+}
+
+// OnRegistryPackage registers fn to be called for "registry_package" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnRegistryPackage(fn func(ctx context.Context, event *RegistryPackageEvent) error, actions ...string) {
+	h.on("registry_package", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*RegistryPackageEvent))
+	})
+}
+
+// OnRelease registers fn to be called for "release" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnRelease(fn func(ctx context.Context, event *ReleaseEvent) error, actions ...string) {
+	h.on("release", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*ReleaseEvent))
+	})
+}
+
+// OnRepository registers fn to be called for "repository" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnRepository(fn func(ctx context.Context, event *RepositoryEvent) error, actions ...string) {
+	h.on("repository", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*RepositoryEvent))
+	})
+}
+
+// OnRepositoryDispatch registers fn to be called for "repository_dispatch" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnRepositoryDispatch(fn func(ctx context.Context, event *RepositoryDispatchEvent) error, actions ...string) {
+	h.on("repository_dispatch", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*RepositoryDispatchEvent))
+	})
+}
+
+// OnRepositoryImport registers fn to be called for "repository_import" webhook events.
+func (h *WebhookHandler) OnRepositoryImport(fn func(ctx context.Context, event *RepositoryImportEvent) error) {
+	h.on("repository_import", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*RepositoryImportEvent))
+	})
+}
+
+// OnRepositoryRuleset registers fn to be called for "repository_ruleset" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnRepositoryRuleset(fn func(ctx context.Context, event *RepositoryRulesetEvent) error, actions ...string) {
+	h.on("repository_ruleset", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*RepositoryRulesetEvent))
+	})
+}
+
+// OnRepositoryVulnerabilityAlert registers fn to be called for "repository_vulnerability_alert" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnRepositoryVulnerabilityAlert(fn func(ctx context.Context, event *RepositoryVulnerabilityAlertEvent) error, actions ...string) {
+	h.on("repository_vulnerability_alert", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*RepositoryVulnerabilityAlertEvent))
+	})
+}
+
+// OnSecretScanningAlert registers fn to be called for "secret_scanning_alert" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnSecretScanningAlert(fn func(ctx context.Context, event *SecretScanningAlertEvent) error, actions ...string) {
+	h.on("secret_scanning_alert", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*SecretScanningAlertEvent))
+	})
+}
+
+// OnSecretScanningAlertLocation registers fn to be called for "secret_scanning_alert_location" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnSecretScanningAlertLocation(fn func(ctx context.Context, event *SecretScanningAlertLocationEvent) error, actions ...string) {
+	h.on("secret_scanning_alert_location", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*SecretScanningAlertLocationEvent))
+	})
+}
+
+// OnSecurityAdvisory registers fn to be called for "security_advisory" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnSecurityAdvisory(fn func(ctx context.Context, event *SecurityAdvisoryEvent) error, actions ...string) {
+	h.on("security_advisory", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*SecurityAdvisoryEvent))
+	})
+}
+
+// OnSecurityAndAnalysis registers fn to be called for "security_and_analysis" webhook events.
+func (h *WebhookHandler) OnSecurityAndAnalysis(fn func(ctx context.Context, event *SecurityAndAnalysisEvent) error) {
+	h.on("security_and_analysis", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*SecurityAndAnalysisEvent))
+	})
+}
+
+// OnSponsorship registers fn to be called for "sponsorship" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnSponsorship(fn func(ctx context.Context, event *SponsorshipEvent) error, actions ...string) {
+	h.on("sponsorship", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*SponsorshipEvent))
+	})
+}
+
+// OnStar registers fn to be called for "star" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnStar(fn func(ctx context.Context, event *StarEvent) error, actions ...string) {
+	h.on("star", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*StarEvent))
+	})
+}
+
+// OnStatus registers fn to be called for "status" webhook events.
+func (h *WebhookHandler) OnStatus(fn func(ctx context.Context, event *StatusEvent) error) {
+	h.on("status", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*StatusEvent))
+	})
+}
+
+// OnTeam registers fn to be called for "team" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnTeam(fn func(ctx context.Context, event *TeamEvent) error, actions ...string) {
+	h.on("team", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*TeamEvent))
+	})
+}
+
+// OnTeamAdd registers fn to be called for "team_add" webhook events.
+func (h *WebhookHandler) OnTeamAdd(fn func(ctx context.Context, event *TeamAddEvent) error) {
+	h.on("team_add", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*TeamAddEvent))
+	})
+}
+
+// OnUser registers fn to be called for "user" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnUser(fn func(ctx context.Context, event *UserEvent) error, actions ...string) {
+	h.on("user", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*UserEvent))
+	})
+}
+
+// OnWatch registers fn to be called for "watch" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnWatch(fn func(ctx context.Context, event *WatchEvent) error, actions ...string) {
+	h.on("watch", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*WatchEvent))
+	})
+}
+
+// OnWorkflowDispatch registers fn to be called for "workflow_dispatch" webhook events.
+func (h *WebhookHandler) OnWorkflowDispatch(fn func(ctx context.Context, event *WorkflowDispatchEvent) error) {
+	h.on("workflow_dispatch", nil, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*WorkflowDispatchEvent))
+	})
+}
+
+// OnWorkflowJob registers fn to be called for "workflow_job" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnWorkflowJob(fn func(ctx context.Context, event *WorkflowJobEvent) error, actions ...string) {
+	h.on("workflow_job", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*WorkflowJobEvent))
+	})
+}
+
+// OnWorkflowRun registers fn to be called for "workflow_run" webhook events.
+// If any actions are given, fn is only called for events with one of them.
+func (h *WebhookHandler) OnWorkflowRun(fn func(ctx context.Context, event *WorkflowRunEvent) error, actions ...string) {
+	h.on("workflow_run", actions, func(ctx context.Context, event any) error {
+		return fn(ctx, event.(*WorkflowRunEvent))
+	})
+}
@@ -5,6 +5,9 @@
 
 //go:generate go run gen-accessors.go
 //go:generate go run gen-stringify-test.go
+//go:generate go run gen-iterators.go
+//go:generate go run gen-webhook-handlers.go
+//go:generate go run gen-operations.go
 //go:generate ../script/metadata.sh update-go
 
 package github
@@ -187,6 +190,26 @@ type Client struct {
 	// Whether to respect rate limit headers on endpoints that return 302 redirections to artifacts
 	RateLimitRedirectionalEndpoints bool
 
+	// RetryPolicy, if non-nil, makes the client retry requests that failed
+	// with a transient error. See RetryPolicy for details.
+	RetryPolicy *RetryPolicy
+
+	// Cache, if non-nil, is used to make conditional GET requests, which do
+	// not count against the rate limit when the resource has not changed.
+	// It must not be shared with clients using different credentials. See
+	// Cache for details.
+	Cache Cache
+
+	// Throttle, if non-nil, paces requests to spread the rate limit budget
+	// until it resets and to avoid secondary rate limits. See Throttle for
+	// details.
+	Throttle *Throttle
+
+	// Observer, if non-nil, is notified of every request sent by the client,
+	// with its route, status, latency and rate limit. See Observer for
+	// details.
+	Observer Observer
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the GitHub API.
@@ -204,6 +227,7 @@ type Client struct {
 	Copilot            *CopilotService
 	Dependabot         *DependabotService
 	DependencyGraph    *DependencyGraphService
+	Discussions        *DiscussionsService
 	Emojis             *EmojisService
 	Enterprise         *EnterpriseService
 	Gists              *GistsService
@@ -218,6 +242,7 @@ type Client struct {
 	Meta               *MetaService
 	Migrations         *MigrationService
 	Organizations      *OrganizationsService
+	Projects           *ProjectsService
 	PullRequests       *PullRequestsService
 	RateLimit          *RateLimitService
 	Reactions          *ReactionsService
@@ -350,16 +375,24 @@ func (c *Client) WithAuthToken(token string) *Client {
 	if transport == nil {
 		transport = http.DefaultTransport
 	}
-	c2.client.Transport = roundTripperFunc(
-		func(req *http.Request) (*http.Response, error) {
-			req = req.Clone(req.Context())
-			req.Header.Set("Authorization", fmt.Sprintf("Bearer %v", token))
-			return transport.RoundTrip(req)
-		},
-	)
+	c2.client.Transport = &authTokenTransport{token: token, base: transport}
 	return c2
 }
 
+// authTokenTransport is the transport of a client configured by
+// Client.WithAuthToken. It sets the Authorization header of requests before
+// sending them with base.
+type authTokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *authTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %v", t.token))
+	return t.base.RoundTrip(req)
+}
+
 // WithEnterpriseURLs returns a copy of the client configured to use the provided base and
 // upload URLs. If the base URL does not have the suffix "/api/v3/", it will be added
 // automatically. If the upload URL does not have the suffix "/api/uploads", it will be
@@ -442,6 +475,7 @@ func (c *Client) initialize() {
 	c.Copilot = (*CopilotService)(&c.common)
 	c.Dependabot = (*DependabotService)(&c.common)
 	c.DependencyGraph = (*DependencyGraphService)(&c.common)
+	c.Discussions = (*DiscussionsService)(&c.common)
 	c.Emojis = (*EmojisService)(&c.common)
 	c.Enterprise = (*EnterpriseService)(&c.common)
 	c.Gists = (*GistsService)(&c.common)
@@ -456,6 +490,7 @@ func (c *Client) initialize() {
 	c.Meta = (*MetaService)(&c.common)
 	c.Migrations = (*MigrationService)(&c.common)
 	c.Organizations = (*OrganizationsService)(&c.common)
+	c.Projects = (*ProjectsService)(&c.common)
 	c.PullRequests = (*PullRequestsService)(&c.common)
 	c.RateLimit = (*RateLimitService)(&c.common)
 	c.Reactions = (*ReactionsService)(&c.common)
@@ -479,6 +514,10 @@ func (c *Client) copy() *Client {
 		BaseURL:                         c.BaseURL,
 		UploadURL:                       c.UploadURL,
 		RateLimitRedirectionalEndpoints: c.RateLimitRedirectionalEndpoints,
+		RetryPolicy:                     c.RetryPolicy,
+		Cache:                           c.Cache,
+		Throttle:                        c.Throttle,
+		Observer:                        c.Observer,
 		secondaryRateLimitReset:         c.secondaryRateLimitReset,
 	}
 	c.clientMu.Unlock()
@@ -687,6 +726,10 @@ type Response struct {
 	// token's expiration date. Timestamp is 0001-01-01 when token doesn't expire.
 	// So it is valid for TokenExpiration.Equal(Timestamp{}) or TokenExpiration.Time.After(time.Now())
 	TokenExpiration Timestamp
+
+	// CacheStatus reports whether the response was served from the Client's
+	// Cache after GitHub confirmed it was not modified.
+	CacheStatus CacheStatus
 }
 
 // newResponse creates a new Response for the provided http.Response.
@@ -847,6 +890,7 @@ const (
 // will contain more information. Otherwise you are supposed to read and close the
 // response's Body. If rate limit is exceeded and reset time is in the future,
 // bareDo returns *RateLimitError immediately without making a network API call.
+// If the client has a RetryPolicy, transient failures are retried according to it.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is
 // canceled or times out, ctx.Err() will be returned.
@@ -855,9 +899,31 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 		return nil, errNonNilContext
 	}
 
-	req = withContext(ctx, req)
+	for attempt := 1; ; attempt++ {
+		response, err := c.bareDoOnce(ctx, caller, req)
+		if err == nil {
+			return response, nil
+		}
+
+		delay, ok := c.RetryPolicy.retryDelay(req, attempt, err)
+		if !ok {
+			return response, err
+		}
+		if err := sleepWithContext(ctx, delay); err != nil {
+			return response, err
+		}
+		if req, err = rewindRequest(req); err != nil {
+			return response, err
+		}
+	}
+}
+
+// bareDoOnce makes a single attempt at sending an API request. See bareDo.
+func (c *Client) bareDoOnce(ctx context.Context, caller *http.Client, req *http.Request) (response *Response, err error) {
+	req = c.withOperation(withContext(ctx, req))
 
 	rateLimitCategory := CoreCategory
+	release := func() {}
 
 	if !c.DisableRateLimitCheck {
 		rateLimitCategory = GetRateLimitCategory(req.Method, req.URL.Path)
@@ -880,10 +946,43 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 		}
 	}
 
+	if c.Throttle != nil && ctx.Value(BypassRateLimitCheck) == nil {
+		done, err := c.Throttle.wait(ctx, c, req, rateLimitCategory)
+		if err != nil {
+			return nil, err
+		}
+		// The slot is released once the rate limits have been updated, or
+		// on return.
+		defer done()
+		release = done
+	}
+
+	var key string
+	var cached *http.Response
+	if c.Cache != nil && isCacheable(req) {
+		key = cacheKey(req)
+		if cached = cachedResponse(c.Cache, key, req); cached != nil {
+			req = withValidators(req, cached)
+		}
+	}
+
+	var observed func(*Response, error)
+	req, observed = c.observeRequest(req)
+	defer func() { observed(response, err) }()
+
 	resp, err := caller.Do(req)
-	var response *Response
+	cacheStatus := CacheStatusNone
+	if key != "" {
+		if err == nil {
+			resp, cacheStatus = updateCache(c.Cache, key, cached, resp)
+		} else if cached != nil {
+			cached.Body.Close()
+		}
+	}
+
 	if resp != nil {
 		response = newResponse(resp)
+		response.CacheStatus = cacheStatus
 	}
 
 	if err != nil {
@@ -911,10 +1010,17 @@ func (c *Client) bareDo(ctx context.Context, caller *http.Client, req *http.Requ
 	// this was a cached response. The X-From-Cache is set by
 	// https://github.com/bartventer/httpcache if it's enabled.
 	if !c.DisableRateLimitCheck && response.Header.Get("X-From-Cache") == "" {
+		// GraphQL requests may be sent to a path GetRateLimitCategory does
+		// not recognize, such as behind a proxy, but are always reported
+		// against the graphql resource.
+		if response.Rate.Resource == "graphql" {
+			rateLimitCategory = GraphqlCategory
+		}
 		c.rateMu.Lock()
 		c.rateLimits[rateLimitCategory] = response.Rate
 		c.rateMu.Unlock()
 	}
+	release()
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -1517,6 +1623,31 @@ const (
 	Categories // An array of this length will be able to contain all rate limit categories.
 )
 
+// rateLimitCategoryNames are the names of the rate limit categories, as
+// used for the resources of the rate limit API.
+var rateLimitCategoryNames = [Categories]string{
+	CoreCategory:                      "core",
+	SearchCategory:                    "search",
+	GraphqlCategory:                   "graphql",
+	IntegrationManifestCategory:       "integration_manifest",
+	SourceImportCategory:              "source_import",
+	CodeScanningUploadCategory:        "code_scanning_upload",
+	ActionsRunnerRegistrationCategory: "actions_runner_registration",
+	ScimCategory:                      "scim",
+	DependencySnapshotsCategory:       "dependency_snapshots",
+	CodeSearchCategory:                "code_search",
+	AuditLogCategory:                  "audit_log",
+}
+
+// String returns the name of the rate limit category, such as "core" or
+// "search", as used for the resources of the rate limit API.
+func (c RateLimitCategory) String() string {
+	if c < Categories {
+		return rateLimitCategoryNames[c]
+	}
+	return fmt.Sprintf("RateLimitCategory(%v)", uint8(c))
+}
+
 // GetRateLimitCategory returns the rate limit RateLimitCategory of the endpoint, determined by HTTP method and Request.URL.Path.
 func GetRateLimitCategory(method, path string) RateLimitCategory {
 	switch {
@@ -1533,7 +1664,7 @@ func GetRateLimitCategory(method, path string) RateLimitCategory {
 
 	case strings.HasPrefix(path, "/search/"):
 		return SearchCategory
-	case path == "/graphql":
+	case path == "/graphql" || path == "/api/graphql":
 		return GraphqlCategory
 	case strings.HasPrefix(path, "/app-manifests/") &&
 		strings.HasSuffix(path, "/conversions") &&
@@ -1263,6 +1263,11 @@ func TestDo_rateLimitCategory(t *testing.T) {
 			url:      "/graphql",
 			category: GraphqlCategory,
 		},
+		{
+			method:   "POST",
+			url:      "/api/graphql",
+			category: GraphqlCategory,
+		},
 		{
 			method:   "POST",
 			url:      "/app-manifests/code/conversions",
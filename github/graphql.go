@@ -0,0 +1,146 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// graphQLRequest is the body of a request to the GraphQL API.
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// GraphQLError represents an error reported in the body of a GraphQL API
+// response.
+//
+// GitHub API docs: https://docs.github.com/graphql/overview/about-the-graphql-api
+type GraphQLError struct {
+	// Type classifies the error. Possible values include "NOT_FOUND",
+	// "FORBIDDEN", "RATE_LIMITED", and "UNPROCESSABLE". It is empty for
+	// errors in the query itself, such as syntax errors.
+	Type    string `json:"type,omitempty"`
+	Message string `json:"message"`
+	// Path is the path of the field of the response data the error is
+	// associated with. Its elements are field names or list indexes.
+	Path       []any                   `json:"path,omitempty"`
+	Locations  []*GraphQLErrorLocation `json:"locations,omitempty"`
+	Extensions map[string]any          `json:"extensions,omitempty"`
+}
+
+func (e *GraphQLError) Error() string {
+	if e.Type == "" {
+		return e.Message
+	}
+	return e.Type + ": " + e.Message
+}
+
+// GraphQLErrorLocation represents the location in the query of a GraphQLError.
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GraphQLErrorResponse is returned by Client.GraphQL when the response
+// contains errors. Any data returned alongside the errors is still decoded.
+// Use errors.As to find a specific *GraphQLError.
+type GraphQLErrorResponse struct {
+	Response *http.Response // HTTP response that caused this error
+	Errors   []*GraphQLError
+}
+
+func (r *GraphQLErrorResponse) Error() string {
+	msgs := make([]string, len(r.Errors))
+	for i, e := range r.Errors {
+		msgs[i] = e.Error()
+	}
+	if r.Response != nil && r.Response.Request != nil {
+		return fmt.Sprintf("%v %v: %d %v",
+			r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
+			r.Response.StatusCode, strings.Join(msgs, "; "))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the individual GraphQL errors.
+func (r *GraphQLErrorResponse) Unwrap() []error {
+	errs := make([]error, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = e
+	}
+	return errs
+}
+
+// graphQLPageInfo is the pagination information of a GraphQL connection.
+type graphQLPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// graphQLURL returns the URL of the GraphQL API, relative to BaseURL.
+// GitHub Enterprise Server serves it at /api/graphql rather than below the
+// /api/v3/ REST API path.
+func (c *Client) graphQLURL() string {
+	if strings.HasSuffix(c.BaseURL.Path, "/api/v3/") {
+		return "../graphql"
+	}
+	return "graphql"
+}
+
+// GraphQL sends a GraphQL query or mutation with the given variables, and
+// decodes the data of the response into the value pointed to by v.
+//
+// The request is sent using the same transport, authentication and base URL
+// as REST API requests; for GitHub Enterprise Server, the GraphQL endpoint is
+// derived from the URLs set with WithEnterpriseURLs. The rate limit reported
+// by the response is tracked under GraphqlCategory.
+//
+// If the response contains errors, GraphQL returns a *GraphQLErrorResponse,
+// or a *RateLimitError if the GraphQL rate limit was exceeded. Partial data
+// returned alongside errors is still decoded into v.
+//
+// GitHub API docs: https://docs.github.com/graphql/guides/forming-calls-with-graphql
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]any, v any) (*Response, error) {
+	req, err := c.NewRequest("POST", c.graphQLURL(), &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return nil, err
+	}
+
+	var body struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []*GraphQLError `json:"errors"`
+	}
+	resp, err := c.Do(ctx, req, &body)
+	if err != nil {
+		return resp, err
+	}
+
+	if v != nil && len(body.Data) > 0 && string(body.Data) != "null" {
+		if err := json.Unmarshal(body.Data, v); err != nil {
+			return resp, err
+		}
+	}
+
+	if len(body.Errors) > 0 {
+		for _, e := range body.Errors {
+			if e.Type == "RATE_LIMITED" {
+				return resp, &RateLimitError{
+					Rate:     resp.Rate,
+					Response: resp.Response,
+					Message:  e.Message,
+				}
+			}
+		}
+		return resp, &GraphQLErrorResponse{Response: resp.Response, Errors: body.Errors}
+	}
+
+	return resp, nil
+}
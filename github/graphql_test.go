@@ -0,0 +1,202 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// decodeGraphQLRequest decodes the GraphQL request sent in r.
+func decodeGraphQLRequest(t *testing.T, r *http.Request) *graphQLRequest {
+	t.Helper()
+	testMethod(t, r, "POST")
+	req := new(graphQLRequest)
+	assertNilError(t, json.NewDecoder(r.Body).Decode(req))
+	return req
+}
+
+func TestClient_GraphQL(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		req := decodeGraphQLRequest(t, r)
+		if want := "query { viewer { login } }"; req.Query != want {
+			t.Errorf("query = %q, want %q", req.Query, want)
+		}
+		if want := map[string]any{"n": float64(1)}; !cmp.Equal(req.Variables, want) {
+			t.Errorf("variables = %v, want %v", req.Variables, want)
+		}
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "4999")
+		w.Header().Set(headerRateResource, "graphql")
+		fmt.Fprint(w, `{"data":{"viewer":{"login":"l"}}}`)
+	})
+
+	ctx := t.Context()
+	var data struct {
+		Viewer struct {
+			Login string `json:"login"`
+		} `json:"viewer"`
+	}
+	resp, err := client.GraphQL(ctx, "query { viewer { login } }", map[string]any{"n": 1}, &data)
+	if err != nil {
+		t.Fatalf("GraphQL returned error: %v", err)
+	}
+	if want := "l"; data.Viewer.Login != want {
+		t.Errorf("GraphQL returned login %q, want %q", data.Viewer.Login, want)
+	}
+
+	if want := 4999; resp.Rate.Remaining != want {
+		t.Errorf("GraphQL returned Rate.Remaining %v, want %v", resp.Rate.Remaining, want)
+	}
+	client.rateMu.Lock()
+	graphQLRate, coreRate := client.rateLimits[GraphqlCategory], client.rateLimits[CoreCategory]
+	client.rateMu.Unlock()
+	if want := 4999; graphQLRate.Remaining != want {
+		t.Errorf("GraphQL recorded graphql Rate.Remaining %v, want %v", graphQLRate.Remaining, want)
+	}
+	if coreRate != (Rate{}) {
+		t.Errorf("GraphQL recorded core Rate %+v, want none", coreRate)
+	}
+
+	const methodName = "GraphQL"
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.GraphQL(ctx, "query { viewer { login } }", nil, &data)
+	})
+}
+
+func TestClient_GraphQL_errors(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{
+			"data": {"a": {"b": 1}, "c": null},
+			"errors": [
+				{"type": "NOT_FOUND", "path": ["c"], "locations": [{"line": 1, "column": 12}], "message": "Could not resolve to a node."},
+				{"message": "other"}
+			]
+		}`)
+	})
+
+	ctx := t.Context()
+	var data struct {
+		A struct {
+			B int `json:"b"`
+		} `json:"a"`
+	}
+	_, err := client.GraphQL(ctx, "query { a { b } c { d } }", nil, &data)
+
+	var errResp *GraphQLErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("GraphQL returned error %T, want *GraphQLErrorResponse", err)
+	}
+	if errResp.Response == nil || errResp.Response.StatusCode != http.StatusOK {
+		t.Errorf("GraphQLErrorResponse.Response = %v, want 200 response", errResp.Response)
+	}
+	wantErrs := []*GraphQLError{
+		{
+			Type:      "NOT_FOUND",
+			Message:   "Could not resolve to a node.",
+			Path:      []any{"c"},
+			Locations: []*GraphQLErrorLocation{{Line: 1, Column: 12}},
+		},
+		{Message: "other"},
+	}
+	if !cmp.Equal(errResp.Errors, wantErrs) {
+		t.Errorf("GraphQLErrorResponse.Errors = %+v, want %+v", errResp.Errors, wantErrs)
+	}
+
+	var gqlErr *GraphQLError
+	if !errors.As(err, &gqlErr) || gqlErr.Type != "NOT_FOUND" {
+		t.Errorf("errors.As(*GraphQLError) = %v, want NOT_FOUND error", gqlErr)
+	}
+
+	if want := 1; data.A.B != want {
+		t.Errorf("GraphQL decoded partial data %v, want %v", data.A.B, want)
+	}
+}
+
+func TestClient_GraphQL_rateLimited(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(headerRateLimit, "5000")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, "1")
+		w.Header().Set(headerRateResource, "graphql")
+		fmt.Fprint(w, `{"errors":[{"type":"RATE_LIMITED","message":"API rate limit exceeded"}]}`)
+	})
+
+	ctx := t.Context()
+	_, err := client.GraphQL(ctx, "query { viewer { login } }", nil, nil)
+
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("GraphQL returned error %T, want *RateLimitError", err)
+	}
+	if rateLimitErr.Rate.Remaining != 0 || rateLimitErr.Rate.Limit != 5000 {
+		t.Errorf("RateLimitError.Rate = %+v, want exhausted rate", rateLimitErr.Rate)
+	}
+	if want := "API rate limit exceeded"; rateLimitErr.Message != want {
+		t.Errorf("RateLimitError.Message = %q, want %q", rateLimitErr.Message, want)
+	}
+}
+
+func TestGraphQLErrorResponse_Error(t *testing.T) {
+	t.Parallel()
+	u, _ := url.Parse("https://api.github.com/graphql")
+	err := &GraphQLErrorResponse{
+		Response: &http.Response{
+			StatusCode: http.StatusOK,
+			Request:    &http.Request{Method: "POST", URL: u},
+		},
+		Errors: []*GraphQLError{{Type: "NOT_FOUND", Message: "a"}, {Message: "b"}},
+	}
+	if got, want := err.Error(), "POST https://api.github.com/graphql: 200 NOT_FOUND: a; b"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	err.Response = nil
+	if got, want := err.Error(), "NOT_FOUND: a; b"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestClient_graphQLURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{"https://api.github.com/", "https://api.github.com/graphql"},
+		{"https://ghe.example.com/api/v3/", "https://ghe.example.com/api/graphql"},
+		{"https://ghe.example.com", "https://ghe.example.com/api/graphql"},
+	}
+
+	for _, tt := range tests {
+		client, err := NewClient(nil).WithEnterpriseURLs(tt.baseURL, tt.baseURL)
+		if err != nil {
+			t.Fatalf("WithEnterpriseURLs returned error: %v", err)
+		}
+		req, err := client.NewRequest("POST", client.graphQLURL(), nil)
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+		if got := req.URL.String(); got != tt.want {
+			t.Errorf("GraphQL URL for %v = %v, want %v", tt.baseURL, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIssuesService_ListByRepoIter(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch page := r.FormValue("page"); page {
+		case "", "1":
+			testFormValues(t, r, values{"state": "open"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/issues?page=2>; rel="next"`)
+			fmt.Fprint(w, `[{"number":1},{"number":2}]`)
+		case "2":
+			testFormValues(t, r, values{"state": "open", "page": "2"})
+			fmt.Fprint(w, `[{"number":3}]`)
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+
+	ctx := t.Context()
+	opts := &IssueListByRepoOptions{State: "open"}
+	var got []int
+	for issue, err := range client.Issues.ListByRepoIter(ctx, "o", "r", opts) {
+		if err != nil {
+			t.Fatalf("Issues.ListByRepoIter returned error: %v", err)
+		}
+		got = append(got, issue.GetNumber())
+	}
+
+	if want := []int{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("Issues.ListByRepoIter returned %v, want %v", got, want)
+	}
+	if opts.ListOptions.Page != 0 {
+		t.Errorf("Issues.ListByRepoIter modified opts.Page to %v", opts.ListOptions.Page)
+	}
+}
+
+func TestIssuesService_ListByRepoIter_break(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/issues?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"number":1},{"number":2}]`)
+	})
+
+	ctx := t.Context()
+	for issue, err := range client.Issues.ListByRepoIter(ctx, "o", "r", nil) {
+		if err != nil {
+			t.Fatalf("Issues.ListByRepoIter returned error: %v", err)
+		}
+		if issue.GetNumber() == 1 {
+			break
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Issues.ListByRepoIter made %v requests, want 1", calls)
+	}
+}
+
+func TestIssuesService_ListByRepoIter_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("page") == "2" {
+			http.Error(w, `{"message":"boom"}`, http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/issues?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"number":1}]`)
+	})
+
+	ctx := t.Context()
+	var got []int
+	var gotErr error
+	for issue, err := range client.Issues.ListByRepoIter(ctx, "o", "r", nil) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, issue.GetNumber())
+	}
+
+	if want := []int{1}; !cmp.Equal(got, want) {
+		t.Errorf("Issues.ListByRepoIter returned %v, want %v", got, want)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(gotErr, &errResp) || errResp.Response.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Issues.ListByRepoIter returned error %v, want 500 *ErrorResponse", gotErr)
+	}
+}
+
+func TestIssuesService_ListByRepoIter_contextCanceled(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://api.github.com/repos/o/r/issues?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"number":1}]`)
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	var got []int
+	var gotErr error
+	for issue, err := range client.Issues.ListByRepoIter(ctx, "o", "r", nil) {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		got = append(got, issue.GetNumber())
+		cancel()
+	}
+
+	if want := []int{1}; !cmp.Equal(got, want) {
+		t.Errorf("Issues.ListByRepoIter returned %v, want %v", got, want)
+	}
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Errorf("Issues.ListByRepoIter returned error %v, want %v", gotErr, context.Canceled)
+	}
+}
+
+func TestActionsService_ListRepositoryWorkflowRunsIter(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"per_page": "2"})
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/actions/runs?page=2&per_page=2>; rel="next"`)
+			fmt.Fprint(w, `{"total_count":3,"workflow_runs":[{"id":1},{"id":2}]}`)
+		case "2":
+			testFormValues(t, r, values{"page": "2", "per_page": "2"})
+			fmt.Fprint(w, `{"total_count":3,"workflow_runs":[{"id":3}]}`)
+		}
+	})
+
+	ctx := t.Context()
+	opts := &ListWorkflowRunsOptions{ListOptions: ListOptions{PerPage: 2}}
+	var got []int64
+	for run, err := range client.Actions.ListRepositoryWorkflowRunsIter(ctx, "o", "r", opts) {
+		if err != nil {
+			t.Fatalf("Actions.ListRepositoryWorkflowRunsIter returned error: %v", err)
+		}
+		got = append(got, run.GetID())
+	}
+
+	if want := []int64{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("Actions.ListRepositoryWorkflowRunsIter returned %v, want %v", got, want)
+	}
+}
+
+func TestRepositoriesService_ListHookDeliveriesIter(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/hooks/1/deliveries", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch cursor := r.FormValue("cursor"); cursor {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/hooks/1/deliveries?cursor=v1_2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "v1_2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected cursor %q", cursor)
+		}
+	})
+
+	ctx := t.Context()
+	var got []int64
+	for delivery, err := range client.Repositories.ListHookDeliveriesIter(ctx, "o", "r", 1, nil) {
+		if err != nil {
+			t.Fatalf("Repositories.ListHookDeliveriesIter returned error: %v", err)
+		}
+		got = append(got, delivery.GetID())
+	}
+
+	if want := []int64{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("Repositories.ListHookDeliveriesIter returned %v, want %v", got, want)
+	}
+}
+
+func TestSecretScanningService_ListAlertsForRepoIter(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/repos/o/r/secret-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch after := r.FormValue("after"); after {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/repos/o/r/secret-scanning/alerts?after=abc>; rel="next"`)
+			fmt.Fprint(w, `[{"number":1}]`)
+		case "abc":
+			fmt.Fprint(w, `[{"number":2}]`)
+		default:
+			t.Errorf("unexpected after %q", after)
+		}
+	})
+
+	ctx := t.Context()
+	var got []int
+	for alert, err := range client.SecretScanning.ListAlertsForRepoIter(ctx, "o", "r", nil) {
+		if err != nil {
+			t.Fatalf("SecretScanning.ListAlertsForRepoIter returned error: %v", err)
+		}
+		got = append(got, alert.GetNumber())
+	}
+
+	if want := []int{1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("SecretScanning.ListAlertsForRepoIter returned %v, want %v", got, want)
+	}
+}
+
+func TestUsersService_ListAllIter(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch since := r.FormValue("since"); since {
+		case "":
+			w.Header().Set("Link", `<https://api.github.com/users?since=2>; rel="next"`)
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected since %q", since)
+		}
+	})
+
+	ctx := t.Context()
+	var got []int64
+	for user, err := range client.Users.ListAllIter(ctx, nil) {
+		if err != nil {
+			t.Fatalf("Users.ListAllIter returned error: %v", err)
+		}
+		got = append(got, user.GetID())
+	}
+
+	if want := []int64{1, 2, 3}; !cmp.Equal(got, want) {
+		t.Errorf("Users.ListAllIter returned %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Observer observes the requests sent by a Client, such as to record metrics
+// or traces. See Client.Observer.
+//
+// Each attempt at sending a request is observed, including retries. Requests
+// that are not sent because a rate limit is known to be exceeded are not
+// observed.
+//
+// An Observer must be safe for concurrent use.
+type Observer interface {
+	// StartRequest is called before a request is sent. The request is sent
+	// with the returned context, which is also passed to EndRequest, so that
+	// it can carry a span or the start of a measurement.
+	StartRequest(ctx context.Context, r *ObservedRequest) context.Context
+
+	// EndRequest is called once a response has been received and checked,
+	// or the request failed. The result fields of r are set.
+	EndRequest(ctx context.Context, r *ObservedRequest)
+}
+
+// ObservedRequest describes a request to an Observer.
+type ObservedRequest struct {
+	// Method is the HTTP method of the request.
+	Method string
+
+	// Route is the path template of the API operation, such as
+	// "/repos/{owner}/{repo}/issues", or empty if the request does not match
+	// a known operation. Unlike the URL, it has a bounded number of values,
+	// which makes it suitable as a metric label.
+	Route string
+
+	// OperationID is the ID of the API operation in GitHub's OpenAPI
+	// description, such as "issues/list-for-repo", or empty if unknown.
+	OperationID string
+
+	// URL is the URL of the request, with any client secret redacted.
+	URL *url.URL
+
+	// Category is the rate limit category of the request.
+	Category RateLimitCategory
+
+	// StatusCode is the status code of the response, or 0 if no response
+	// was received. It is set for EndRequest.
+	StatusCode int
+
+	// Duration is the time it took to send the request and receive the
+	// response. It is set for EndRequest.
+	Duration time.Duration
+
+	// Rate is the rate limit of Category as reported by the response, whose
+	// Remaining field is the remaining budget. It is set for EndRequest.
+	Rate Rate
+
+	// CacheStatus is how the Client's Cache was used. It is set for
+	// EndRequest.
+	CacheStatus CacheStatus
+
+	// Err is the error returned for the request, if any. It is set for
+	// EndRequest.
+	Err error
+}
+
+// observeRequest starts observing req with the client's Observer, if any.
+// It returns the request to send, with the context returned by the Observer,
+// and a function to call with the result.
+func (c *Client) observeRequest(req *http.Request) (*http.Request, func(*Response, error)) {
+	if c.Observer == nil {
+		return req, func(*Response, error) {}
+	}
+
+	u := *req.URL
+	o := &ObservedRequest{
+		Method:   req.Method,
+		URL:      sanitizeURL(&u),
+		Category: GetRateLimitCategory(req.Method, c.apiPath(req.URL)),
+	}
+	if op := OperationFromContext(req.Context()); op != nil {
+		o.Route = op.Path
+		o.OperationID = op.ID
+	}
+	ctx := c.Observer.StartRequest(req.Context(), o)
+	req = req.WithContext(ctx)
+	start := time.Now()
+	return req, func(resp *Response, err error) {
+		o.Duration = time.Since(start)
+		o.Err = err
+		if resp != nil && resp.Response != nil {
+			o.StatusCode = resp.StatusCode
+			o.Rate = resp.Rate
+			o.CacheStatus = resp.CacheStatus
+			if resp.Rate.Resource == "graphql" {
+				o.Category = GraphqlCategory
+			}
+		}
+		c.Observer.EndRequest(ctx, o)
+	}
+}
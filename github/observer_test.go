@@ -0,0 +1,124 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+type observerKey struct{}
+
+// testObserver records the requests it observes.
+type testObserver struct {
+	mu       sync.Mutex
+	started  []string
+	observed []ObservedRequest
+}
+
+func (o *testObserver) StartRequest(ctx context.Context, r *ObservedRequest) context.Context {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.started = append(o.started, r.Method+" "+r.Route)
+	return context.WithValue(ctx, observerKey{}, r.Route)
+}
+
+func (o *testObserver) EndRequest(ctx context.Context, r *ObservedRequest) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if ctx.Value(observerKey{}) != r.Route {
+		panic("EndRequest was not called with the context returned by StartRequest")
+	}
+	o.observed = append(o.observed, *r)
+}
+
+func TestClient_Observer(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	obs := &testObserver{}
+	client.Observer = obs
+
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		if r.Context().Value(observerKey{}) != nil {
+			t.Error("Observer context leaked to the server")
+		}
+		w.Header().Set(headerRateResource, "core")
+		w.Header().Set(headerRateRemaining, "4999")
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/search/issues", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := t.Context()
+	_, _, err := client.Issues.ListByRepo(ctx, "o", "r", nil)
+	assertNilError(t, err)
+	_, _, err = client.Search.Issues(ctx, "q", nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Search.Issues returned error %v, want *ErrorResponse", err)
+	}
+
+	if len(obs.observed) != 2 {
+		t.Fatalf("Observer observed %v requests, want 2", len(obs.observed))
+	}
+	got := obs.observed[0]
+	if got.Method != "GET" || got.Route != "/repos/{owner}/{repo}/issues" || got.StatusCode != 200 ||
+		got.Category != CoreCategory || got.Rate.Remaining != 4999 || got.Err != nil || got.Duration <= 0 {
+		t.Errorf("Observer observed %+v, want GET /repos/{owner}/{repo}/issues", got)
+	}
+	got = obs.observed[1]
+	if got.Route != "/search/issues" || got.StatusCode != 404 || got.Category != SearchCategory || got.Err != err {
+		t.Errorf("Observer observed %+v, want GET /search/issues", got)
+	}
+	if want := []string{"GET /repos/{owner}/{repo}/issues", "GET /search/issues"}; fmt.Sprint(obs.started) != fmt.Sprint(want) {
+		t.Errorf("Observer started %v, want %v", obs.started, want)
+	}
+}
+
+func TestClient_Observer_transportError(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+	obs := &testObserver{}
+	client.Observer = obs
+	client.client.Transport = roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("boom")
+	})
+
+	req, err := client.NewRequest("GET", "user?client_secret=s", nil)
+	assertNilError(t, err)
+	if _, err := client.Do(t.Context(), req, nil); err == nil {
+		t.Fatal("Do returned nil error")
+	}
+	if len(obs.observed) != 1 {
+		t.Fatalf("Observer observed %v requests, want 1", len(obs.observed))
+	}
+	got := obs.observed[0]
+	if got.Route != "/user" || got.StatusCode != 0 || got.Err == nil || got.URL.Query().Get("client_secret") != "REDACTED" {
+		t.Errorf("Observer observed %+v, want failed GET /user", got)
+	}
+	if req.URL.Query().Get("client_secret") != "s" {
+		t.Errorf("Observer modified the request URL: %v", req.URL)
+	}
+}
+
+func TestRateLimitCategory_String(t *testing.T) {
+	t.Parallel()
+	for c, want := range map[RateLimitCategory]string{
+		CoreCategory:       "core",
+		CodeSearchCategory: "code_search",
+		AuditLogCategory:   "audit_log",
+		Categories:         "RateLimitCategory(11)",
+	} {
+		if got := c.String(); got != want {
+			t.Errorf("%v.String() = %q, want %q", uint8(c), got, want)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Operation is an operation of the GitHub API, as listed in GitHub's OpenAPI
+// description.
+//
+// The Client finds the operation of every request it sends, and stores it in
+// the context of the request, where middleware such as an http.RoundTripper
+// or an Observer can find it with OperationFromContext. Test servers can
+// find the operation of a request they receive with LookupOperation.
+type Operation struct {
+	// ID is the ID of the operation in GitHub's OpenAPI description, such as
+	// "repos/get-content". It is empty for operations whose ID is unknown,
+	// such as those that are not in the description.
+	ID string
+
+	// Method is the HTTP method of the operation, such as "GET".
+	Method string
+
+	// Path is the path template of the operation, such as
+	// "/repos/{owner}/{repo}/contents/{path}".
+	Path string
+}
+
+// Name returns the name of the operation, as used in the "meta:operation"
+// comments of the methods of the services, such as
+// "GET /repos/{owner}/{repo}/contents/{path}".
+func (o *Operation) Name() string {
+	return o.Method + " " + o.Path
+}
+
+type operationKey struct{}
+
+// OperationFromContext returns the API operation stored in the context of a
+// request sent by a Client, or nil if the request does not match any known
+// operation.
+func OperationFromContext(ctx context.Context) *Operation {
+	op, _ := ctx.Value(operationKey{}).(*Operation)
+	return op
+}
+
+// LookupOperation returns the API operation matching method and path, or nil
+// if none does. The path is relative to the root of the API, such as
+// "/repos/o/r/contents/README.md".
+func LookupOperation(method, path string) *Operation {
+	n := operations()[method]
+	if n == nil {
+		return nil
+	}
+	return n.match(strings.Split(strings.TrimPrefix(path, "/"), "/"))
+}
+
+// withOperation returns req with the API operation it matches, if any,
+// stored in its context.
+func (c *Client) withOperation(req *http.Request) *http.Request {
+	op := LookupOperation(req.Method, c.apiPath(req.URL))
+	if op == nil {
+		return req
+	}
+	return req.WithContext(context.WithValue(req.Context(), operationKey{}, op))
+}
+
+// apiPath returns the path of u relative to the client's BaseURL or
+// UploadURL, such as "/repos/o/r" for "https://ghes.example.com/api/v3/repos/o/r".
+func (c *Client) apiPath(u *url.URL) string {
+	for _, base := range []*url.URL{c.BaseURL, c.UploadURL} {
+		if base != nil && u.Host == base.Host && strings.HasPrefix(u.Path, base.Path) {
+			return "/" + strings.TrimPrefix(u.Path, base.Path)
+		}
+	}
+	return u.Path
+}
+
+// operationNode is a node of a tree of operations, with a child for each
+// path segment.
+type operationNode struct {
+	op     *Operation // The operation ending at this node, if any.
+	static map[string]*operationNode
+	param  *operationNode // The child for any segment, such as "{owner}".
+}
+
+// operations returns the trees of the operations of apiOperations, by
+// method.
+var operations = sync.OnceValue(func() map[string]*operationNode {
+	trees := make(map[string]*operationNode)
+	for i := range apiOperations {
+		op := &apiOperations[i]
+		n := trees[op.Method]
+		if n == nil {
+			n = &operationNode{}
+			trees[op.Method] = n
+		}
+		for _, seg := range strings.Split(strings.TrimPrefix(op.Path, "/"), "/") {
+			if strings.HasPrefix(seg, "{") {
+				if n.param == nil {
+					n.param = &operationNode{}
+				}
+				n = n.param
+				continue
+			}
+			if n.static == nil {
+				n.static = make(map[string]*operationNode)
+			}
+			if n.static[seg] == nil {
+				n.static[seg] = &operationNode{}
+			}
+			n = n.static[seg]
+		}
+		n.op = op
+	}
+	return trees
+})
+
+// match returns the operation matching segs below n, preferring static
+// segments to parameters. A parameter that ends an operation, and no other,
+// also matches the remaining segments, since some parameters, such as the
+// path of a file or the name of a ref, may contain slashes.
+func (n *operationNode) match(segs []string) *Operation {
+	if len(segs) == 0 {
+		return n.op
+	}
+	if child := n.static[segs[0]]; child != nil {
+		if op := child.match(segs[1:]); op != nil {
+			return op
+		}
+	}
+	// Only the last segment may be empty, such as for the root directory
+	// in "/repos/o/r/contents/".
+	if n.param == nil || (segs[0] == "" && len(segs) > 1) {
+		return nil
+	}
+	if op := n.param.match(segs[1:]); op != nil {
+		return op
+	}
+	if n.param.static == nil && n.param.param == nil {
+		return n.param.op
+	}
+	return nil
+}
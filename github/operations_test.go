@@ -0,0 +1,128 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"testing"
+)
+
+func TestClient_operationInContext(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	mux.HandleFunc("/repos/o/r/contents/a/b.go", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"type":"file","name":"b.go"}`)
+	})
+
+	var got *Operation
+	transport := client.client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client.client.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		got = OperationFromContext(req.Context())
+		return transport.RoundTrip(req)
+	})
+
+	_, _, _, err := client.Repositories.GetContents(t.Context(), "o", "r", "a/b.go", nil)
+	assertNilError(t, err)
+	want := &Operation{Method: "GET", Path: "/repos/{owner}/{repo}/contents/{path}"}
+	if got == nil || got.Method != want.Method || got.Path != want.Path {
+		t.Errorf("OperationFromContext = %v, want %v", got, want)
+	}
+
+	if op := OperationFromContext(t.Context()); op != nil {
+		t.Errorf("OperationFromContext of a context without operation = %v, want nil", op)
+	}
+}
+
+func TestLookupOperation_all(t *testing.T) {
+	t.Parallel()
+	// Every operation matches a path made from its path template.
+	param := regexp.MustCompile(`\{[^}]+\}`)
+	for _, want := range apiOperations {
+		p := param.ReplaceAllString(want.Path, "x")
+		if got := LookupOperation(want.Method, p); got == nil || *got != want {
+			t.Errorf("LookupOperation(%q, %q) = %v, want %v", want.Method, p, got, &want)
+		}
+	}
+}
+
+func TestOperation_Name(t *testing.T) {
+	t.Parallel()
+	op := &Operation{ID: "repos/get", Method: "GET", Path: "/repos/{owner}/{repo}"}
+	if got, want := op.Name(), "GET /repos/{owner}/{repo}"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+}
+
+func TestLookupOperation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		method, path, want string
+	}{
+		{"GET", "/", "/"},
+		{"GET", "/user", "/user"},
+		{"GET", "/repos/o/r", "/repos/{owner}/{repo}"},
+		{"PATCH", "/repos/o/r/issues/1", "/repos/{owner}/{repo}/issues/{issue_number}"},
+		{"GET", "/repos/o/r/pulls/comments", "/repos/{owner}/{repo}/pulls/comments"},
+		{"GET", "/repos/o/r/pulls/1/comments", "/repos/{owner}/{repo}/pulls/{pull_number}/comments"},
+		{"GET", "/repos/o/r/contents/a/b/c.go", "/repos/{owner}/{repo}/contents/{path}"},
+		{"GET", "/repos/o/r/git/ref/heads/main", "/repos/{owner}/{repo}/git/ref/{ref}"},
+		{"GET", "/repos/o/r/unknown/x", ""},
+		{"GET", "/repos/o/r/issues/1/unknown", ""},
+		{"GET", "/repos/o/r/contents/", "/repos/{owner}/{repo}/contents/{path}"},
+		{"TRACE", "/user", ""},
+	}
+	for _, tt := range tests {
+		var got string
+		if op := LookupOperation(tt.method, tt.path); op != nil {
+			got = op.Path
+		}
+		if got != tt.want {
+			t.Errorf("LookupOperation(%q, %q) = %q, want %q", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestLookupOperation_id(t *testing.T) {
+	t.Parallel()
+	op := LookupOperation("GET", "/repos/o/r/contents/x")
+	if op == nil || op.ID != "repos/get-content" {
+		t.Errorf("LookupOperation(GET, /repos/o/r/contents/x) = %v, want ID repos/get-content", op)
+	}
+
+	client, _, _ := setup(t)
+	req, err := client.NewRequest("GET", "repos/o/r/contents/x", nil)
+	assertNilError(t, err)
+	// The operation is stored in the context of the request when it is sent.
+	op = OperationFromContext(client.withOperation(req).Context())
+	if op == nil || op.ID != "repos/get-content" {
+		t.Errorf("OperationFromContext of a request made by NewRequest = %v, want ID repos/get-content", op)
+	}
+}
+
+func TestClient_apiPath(t *testing.T) {
+	t.Parallel()
+	client, err := NewClient(nil).WithEnterpriseURLs("https://ghes.example.com/api/v3/", "https://ghes.example.com/api/uploads/")
+	assertNilError(t, err)
+	tests := []struct {
+		url, want string
+	}{
+		{"https://ghes.example.com/api/v3/repos/o/r", "/repos/o/r"},
+		{"https://ghes.example.com/api/uploads/repos/o/r/releases/1/assets", "/repos/o/r/releases/1/assets"},
+		{"https://objects.example.com/api/v3/x", "/api/v3/x"},
+	}
+	for _, tt := range tests {
+		u, _ := url.Parse(tt.url)
+		if got := client.apiPath(u); got != tt.want {
+			t.Errorf("apiPath(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
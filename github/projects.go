@@ -0,0 +1,533 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ProjectsService handles communication with the Projects (v2) related
+// methods of the GitHub API.
+//
+// Projects are owned by either an organization or a user, and are identified
+// by their number within that owner. Activity on projects is delivered to
+// webhooks as ProjectV2Event and ProjectV2ItemEvent.
+//
+// GitHub API docs: https://docs.github.com/rest/projects
+type ProjectsService service
+
+// ListProjectsOptions specifies the optional parameters to the
+// ProjectsService.ListOrganizationProjects and
+// ProjectsService.ListUserProjects methods.
+type ListProjectsOptions struct {
+	// Query limits the results to projects matching the given search query.
+	Query string `url:"q,omitempty"`
+
+	ListCursorOptions
+}
+
+// ProjectV2Field represents a field of a project.
+type ProjectV2Field struct {
+	ID         *int64  `json:"id,omitempty"`
+	NodeID     *string `json:"node_id,omitempty"`
+	ProjectURL *string `json:"project_url,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	// DataType is the type of the values of the field. Possible values are:
+	// "assignees", "linked_pull_requests", "reviewers", "labels", "milestone",
+	// "repository", "title", "text", "single_select", "number", "date",
+	// "iteration", "issue_type", "parent_issue", or "sub_issues_progress".
+	DataType *string `json:"data_type,omitempty"`
+	// Options is only populated for "single_select" fields.
+	Options []*ProjectV2FieldOption `json:"options,omitempty"`
+	// Configuration is only populated for "iteration" fields.
+	Configuration *ProjectV2FieldConfiguration `json:"configuration,omitempty"`
+	CreatedAt     *Timestamp                   `json:"created_at,omitempty"`
+	UpdatedAt     *Timestamp                   `json:"updated_at,omitempty"`
+}
+
+// ProjectV2FieldOption represents an option of a single select project field.
+type ProjectV2FieldOption struct {
+	ID          *string               `json:"id,omitempty"`
+	Name        *ProjectV2TextContent `json:"name,omitempty"`
+	Color       *string               `json:"color,omitempty"`
+	Description *ProjectV2TextContent `json:"description,omitempty"`
+}
+
+// ProjectV2TextContent represents text in a project, both as entered and
+// rendered as HTML.
+type ProjectV2TextContent struct {
+	Raw  *string `json:"raw,omitempty"`
+	HTML *string `json:"html,omitempty"`
+}
+
+// ProjectV2FieldConfiguration represents the configuration of an iteration
+// project field.
+type ProjectV2FieldConfiguration struct {
+	// StartDay is the day of the week iterations start on, 1 being Monday.
+	StartDay *int `json:"start_day,omitempty"`
+	// Duration is the default length of iterations, in days.
+	Duration   *int                       `json:"duration,omitempty"`
+	Iterations []*ProjectV2FieldIteration `json:"iterations,omitempty"`
+}
+
+// ProjectV2FieldIteration represents an iteration of an iteration project
+// field.
+type ProjectV2FieldIteration struct {
+	ID    *string               `json:"id,omitempty"`
+	Title *ProjectV2TextContent `json:"title,omitempty"`
+	// StartDate is formatted as YYYY-MM-DD.
+	StartDate *string `json:"start_date,omitempty"`
+	// Duration is the length of the iteration, in days.
+	Duration *int `json:"duration,omitempty"`
+}
+
+// ProjectV2ItemFieldValue represents the value of a field of a project item.
+type ProjectV2ItemFieldValue struct {
+	ID   *int64  `json:"id,omitempty"`
+	Name *string `json:"name,omitempty"`
+	// Type is the data type of the field, as in ProjectV2Field.DataType.
+	Type *string `json:"type,omitempty"`
+	// Value holds the JSON encoded value, whose shape depends on Type. For
+	// example, it is a string for "text" and "date" fields, a number for
+	// "number" fields, and an object for "single_select" and "iteration"
+	// fields.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ProjectV2DraftIssue represents a draft issue in a project.
+type ProjectV2DraftIssue struct {
+	ID        *int64     `json:"id,omitempty"`
+	NodeID    *string    `json:"node_id,omitempty"`
+	Title     *string    `json:"title,omitempty"`
+	Body      *string    `json:"body,omitempty"`
+	User      *User      `json:"user,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
+}
+
+// ParseContent parses the content of the project item. Depending on
+// ContentType, it returns an *Issue, a *PullRequest, or a
+// *ProjectV2DraftIssue. It returns nil if the item has no content, as is the
+// case for items delivered in webhook payloads.
+func (p *ProjectV2Item) ParseContent() (any, error) {
+	if len(p.Content) == 0 {
+		return nil, nil
+	}
+
+	var content any
+	switch p.GetContentType() {
+	case "Issue":
+		content = &Issue{}
+	case "PullRequest":
+		content = &PullRequest{}
+	case "DraftIssue":
+		content = &ProjectV2DraftIssue{}
+	default:
+		return nil, fmt.Errorf("unknown project item content type %q", p.GetContentType())
+	}
+
+	if err := json.Unmarshal(p.Content, content); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// ListProjectItemsOptions specifies the optional parameters to the
+// ProjectsService.ListOrganizationProjectItems and
+// ProjectsService.ListUserProjectItems methods.
+type ListProjectItemsOptions struct {
+	// Query limits the results to items matching the given filter, using the
+	// same syntax as project views.
+	Query string `url:"q,omitempty"`
+
+	// Fields lists the IDs of the fields whose values are included in
+	// ProjectV2Item.Fields. By default, only the title field is included.
+	Fields []int64 `url:"fields,comma,omitempty"`
+
+	ListCursorOptions
+}
+
+// GetProjectItemOptions specifies the optional parameters to the
+// ProjectsService.GetOrganizationProjectItem and
+// ProjectsService.GetUserProjectItem methods.
+type GetProjectItemOptions struct {
+	// Fields lists the IDs of the fields whose values are included in
+	// ProjectV2Item.Fields. By default, only the title field is included.
+	Fields []int64 `url:"fields,comma,omitempty"`
+}
+
+// AddProjectItemOptions specifies the issue or pull request to add to a
+// project.
+type AddProjectItemOptions struct {
+	// Type is either "Issue" or "PullRequest".
+	Type string `json:"type"`
+	// ID is the ID of the issue or pull request, as in Issue.ID.
+	ID int64 `json:"id"`
+}
+
+// ProjectV2ItemFieldUpdate sets the value of a field of a project item.
+type ProjectV2ItemFieldUpdate struct {
+	// ID is the ID of the field, as in ProjectV2Field.ID.
+	ID int64 `json:"id"`
+	// Value is the new value of the field. Depending on the field's data
+	// type, it must be:
+	//
+	//   - "text": a string,
+	//   - "number": a number,
+	//   - "date": a string formatted as YYYY-MM-DD,
+	//   - "single_select": the ID of a ProjectV2FieldOption,
+	//   - "iteration": the ID of a ProjectV2FieldIteration.
+	//
+	// A nil Value clears the field.
+	Value any `json:"value"`
+}
+
+// UpdateProjectItemOptions specifies the field values to update on a project
+// item.
+type UpdateProjectItemOptions struct {
+	Fields []*ProjectV2ItemFieldUpdate `json:"fields"`
+}
+
+func (s *ProjectsService) listProjects(ctx context.Context, u string, opts *ListProjectsOptions) ([]*ProjectV2, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projects []*ProjectV2
+	resp, err := s.client.Do(ctx, req, &projects)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return projects, resp, nil
+}
+
+func (s *ProjectsService) getProject(ctx context.Context, u string) (*ProjectV2, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := new(ProjectV2)
+	resp, err := s.client.Do(ctx, req, project)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return project, resp, nil
+}
+
+func (s *ProjectsService) listProjectFields(ctx context.Context, u string, opts *ListCursorOptions) ([]*ProjectV2Field, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fields []*ProjectV2Field
+	resp, err := s.client.Do(ctx, req, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return fields, resp, nil
+}
+
+func (s *ProjectsService) getProjectField(ctx context.Context, u string) (*ProjectV2Field, *Response, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	field := new(ProjectV2Field)
+	resp, err := s.client.Do(ctx, req, field)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return field, resp, nil
+}
+
+func (s *ProjectsService) listProjectItems(ctx context.Context, u string, opts *ListProjectItemsOptions) ([]*ProjectV2Item, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []*ProjectV2Item
+	resp, err := s.client.Do(ctx, req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return items, resp, nil
+}
+
+func (s *ProjectsService) getProjectItem(ctx context.Context, u string, opts *GetProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item := new(ProjectV2Item)
+	resp, err := s.client.Do(ctx, req, item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item, resp, nil
+}
+
+func (s *ProjectsService) addProjectItem(ctx context.Context, u string, opts *AddProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item := new(ProjectV2Item)
+	resp, err := s.client.Do(ctx, req, item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item, resp, nil
+}
+
+func (s *ProjectsService) updateProjectItem(ctx context.Context, u string, opts *UpdateProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	req, err := s.client.NewRequest("PATCH", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	item := new(ProjectV2Item)
+	resp, err := s.client.Do(ctx, req, item)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return item, resp, nil
+}
+
+func (s *ProjectsService) deleteProjectItem(ctx context.Context, u string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListOrganizationProjects lists the projects owned by an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/projects#list-projects-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2
+func (s *ProjectsService) ListOrganizationProjects(ctx context.Context, org string, opts *ListProjectsOptions) ([]*ProjectV2, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2", org)
+	return s.listProjects(ctx, u, opts)
+}
+
+// GetOrganizationProject gets a project owned by an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/projects#get-project-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}
+func (s *ProjectsService) GetOrganizationProject(ctx context.Context, org string, projectNumber int) (*ProjectV2, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v", org, projectNumber)
+	return s.getProject(ctx, u)
+}
+
+// ListOrganizationProjectFields lists the fields of a project owned by an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#list-project-fields-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/fields
+func (s *ProjectsService) ListOrganizationProjectFields(ctx context.Context, org string, projectNumber int, opts *ListCursorOptions) ([]*ProjectV2Field, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/fields", org, projectNumber)
+	return s.listProjectFields(ctx, u, opts)
+}
+
+// GetOrganizationProjectField gets a field of a project owned by an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#get-project-field-for-organization
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/fields/{field_id}
+func (s *ProjectsService) GetOrganizationProjectField(ctx context.Context, org string, projectNumber int, fieldID int64) (*ProjectV2Field, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/fields/%v", org, projectNumber, fieldID)
+	return s.getProjectField(ctx, u)
+}
+
+// ListOrganizationProjectItems lists the items of a project owned by an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#list-items-for-an-organization-owned-project
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/items
+func (s *ProjectsService) ListOrganizationProjectItems(ctx context.Context, org string, projectNumber int, opts *ListProjectItemsOptions) ([]*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items", org, projectNumber)
+	return s.listProjectItems(ctx, u, opts)
+}
+
+// GetOrganizationProjectItem gets an item of a project owned by an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#get-an-item-for-an-organization-owned-project
+//
+//meta:operation GET /orgs/{org}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) GetOrganizationProjectItem(ctx context.Context, org string, projectNumber int, itemID int64, opts *GetProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items/%v", org, projectNumber, itemID)
+	return s.getProjectItem(ctx, u, opts)
+}
+
+// AddOrganizationProjectItem adds an issue or pull request to a project
+// owned by an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#add-item-to-organization-owned-project
+//
+//meta:operation POST /orgs/{org}/projectsV2/{project_number}/items
+func (s *ProjectsService) AddOrganizationProjectItem(ctx context.Context, org string, projectNumber int, opts *AddProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items", org, projectNumber)
+	return s.addProjectItem(ctx, u, opts)
+}
+
+// UpdateOrganizationProjectItem updates the field values of an item of a
+// project owned by an organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#update-project-item-for-organization
+//
+//meta:operation PATCH /orgs/{org}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) UpdateOrganizationProjectItem(ctx context.Context, org string, projectNumber int, itemID int64, opts *UpdateProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items/%v", org, projectNumber, itemID)
+	return s.updateProjectItem(ctx, u, opts)
+}
+
+// DeleteOrganizationProjectItem removes an item from a project owned by an
+// organization.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#delete-project-item-for-organization
+//
+//meta:operation DELETE /orgs/{org}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) DeleteOrganizationProjectItem(ctx context.Context, org string, projectNumber int, itemID int64) (*Response, error) {
+	u := fmt.Sprintf("orgs/%v/projectsV2/%v/items/%v", org, projectNumber, itemID)
+	return s.deleteProjectItem(ctx, u)
+}
+
+// ListUserProjects lists the projects owned by a user.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/projects#list-projects-for-user
+//
+//meta:operation GET /users/{username}/projectsV2
+func (s *ProjectsService) ListUserProjects(ctx context.Context, username string, opts *ListProjectsOptions) ([]*ProjectV2, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2", username)
+	return s.listProjects(ctx, u, opts)
+}
+
+// GetUserProject gets a project owned by a user.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/projects#get-project-for-user
+//
+//meta:operation GET /users/{username}/projectsV2/{project_number}
+func (s *ProjectsService) GetUserProject(ctx context.Context, username string, projectNumber int) (*ProjectV2, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v", username, projectNumber)
+	return s.getProject(ctx, u)
+}
+
+// ListUserProjectFields lists the fields of a project owned by a user.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#list-project-fields-for-user
+//
+//meta:operation GET /users/{username}/projectsV2/{project_number}/fields
+func (s *ProjectsService) ListUserProjectFields(ctx context.Context, username string, projectNumber int, opts *ListCursorOptions) ([]*ProjectV2Field, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/fields", username, projectNumber)
+	return s.listProjectFields(ctx, u, opts)
+}
+
+// GetUserProjectField gets a field of a project owned by a user.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/fields#get-project-field-for-user
+//
+//meta:operation GET /users/{username}/projectsV2/{project_number}/fields/{field_id}
+func (s *ProjectsService) GetUserProjectField(ctx context.Context, username string, projectNumber int, fieldID int64) (*ProjectV2Field, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/fields/%v", username, projectNumber, fieldID)
+	return s.getProjectField(ctx, u)
+}
+
+// ListUserProjectItems lists the items of a project owned by a user.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#list-items-for-a-user-owned-project
+//
+//meta:operation GET /users/{username}/projectsV2/{project_number}/items
+func (s *ProjectsService) ListUserProjectItems(ctx context.Context, username string, projectNumber int, opts *ListProjectItemsOptions) ([]*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items", username, projectNumber)
+	return s.listProjectItems(ctx, u, opts)
+}
+
+// GetUserProjectItem gets an item of a project owned by a user.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#get-an-item-for-a-user-owned-project
+//
+//meta:operation GET /users/{username}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) GetUserProjectItem(ctx context.Context, username string, projectNumber int, itemID int64, opts *GetProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items/%v", username, projectNumber, itemID)
+	return s.getProjectItem(ctx, u, opts)
+}
+
+// AddUserProjectItem adds an issue or pull request to a project owned by a
+// user.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#add-item-to-user-owned-project
+//
+//meta:operation POST /users/{username}/projectsV2/{project_number}/items
+func (s *ProjectsService) AddUserProjectItem(ctx context.Context, username string, projectNumber int, opts *AddProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items", username, projectNumber)
+	return s.addProjectItem(ctx, u, opts)
+}
+
+// UpdateUserProjectItem updates the field values of an item of a project
+// owned by a user.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#update-project-item-for-user
+//
+//meta:operation PATCH /users/{username}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) UpdateUserProjectItem(ctx context.Context, username string, projectNumber int, itemID int64, opts *UpdateProjectItemOptions) (*ProjectV2Item, *Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items/%v", username, projectNumber, itemID)
+	return s.updateProjectItem(ctx, u, opts)
+}
+
+// DeleteUserProjectItem removes an item from a project owned by a user.
+//
+// GitHub API docs: https://docs.github.com/rest/projects/items#delete-project-item-for-user
+//
+//meta:operation DELETE /users/{username}/projectsV2/{project_number}/items/{item_id}
+func (s *ProjectsService) DeleteUserProjectItem(ctx context.Context, username string, projectNumber int, itemID int64) (*Response, error) {
+	u := fmt.Sprintf("users/%v/projectsV2/%v/items/%v", username, projectNumber, itemID)
+	return s.deleteProjectItem(ctx, u)
+}
@@ -0,0 +1,473 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestProjectsService_ListOrganizationProjects(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/projectsV2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "roadmap", "after": "c", "per_page": "2"})
+		fmt.Fprint(w, `[{"id":1,"number":2,"title":"Roadmap","is_template":false}]`)
+	})
+
+	ctx := t.Context()
+	opts := &ListProjectsOptions{Query: "roadmap", ListCursorOptions: ListCursorOptions{After: "c", PerPage: 2}}
+	projects, _, err := client.Projects.ListOrganizationProjects(ctx, "o", opts)
+	if err != nil {
+		t.Errorf("Projects.ListOrganizationProjects returned error: %v", err)
+	}
+
+	want := []*ProjectV2{{ID: Ptr(int64(1)), Number: Ptr(2), Title: Ptr("Roadmap"), IsTemplate: Ptr(false)}}
+	if !cmp.Equal(projects, want) {
+		t.Errorf("Projects.ListOrganizationProjects returned %+v, want %+v", projects, want)
+	}
+
+	const methodName = "ListOrganizationProjects"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListOrganizationProjects(ctx, "\n", opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListOrganizationProjects(ctx, "o", opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_GetUserProject(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/users/u/projectsV2/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"number":2}`)
+	})
+
+	ctx := t.Context()
+	project, _, err := client.Projects.GetUserProject(ctx, "u", 2)
+	if err != nil {
+		t.Errorf("Projects.GetUserProject returned error: %v", err)
+	}
+
+	want := &ProjectV2{ID: Ptr(int64(1)), Number: Ptr(2)}
+	if !cmp.Equal(project, want) {
+		t.Errorf("Projects.GetUserProject returned %+v, want %+v", project, want)
+	}
+
+	const methodName = "GetUserProject"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.GetUserProject(ctx, "\n", 2)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.GetUserProject(ctx, "u", 2)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_ListOrganizationProjectFields(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/projectsV2/2/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{
+				"id": 1,
+				"name": "Status",
+				"data_type": "single_select",
+				"options": [{"id": "a", "name": {"raw": "Todo", "html": "Todo"}, "color": "GRAY"}]
+			},
+			{
+				"id": 2,
+				"name": "Sprint",
+				"data_type": "iteration",
+				"configuration": {
+					"start_day": 1,
+					"duration": 14,
+					"iterations": [{"id": "b", "title": {"raw": "Sprint 1"}, "start_date": "2025-01-06", "duration": 14}]
+				}
+			}
+		]`)
+	})
+
+	ctx := t.Context()
+	fields, _, err := client.Projects.ListOrganizationProjectFields(ctx, "o", 2, nil)
+	if err != nil {
+		t.Errorf("Projects.ListOrganizationProjectFields returned error: %v", err)
+	}
+
+	want := []*ProjectV2Field{
+		{
+			ID:       Ptr(int64(1)),
+			Name:     Ptr("Status"),
+			DataType: Ptr("single_select"),
+			Options: []*ProjectV2FieldOption{{
+				ID:    Ptr("a"),
+				Name:  &ProjectV2TextContent{Raw: Ptr("Todo"), HTML: Ptr("Todo")},
+				Color: Ptr("GRAY"),
+			}},
+		},
+		{
+			ID:       Ptr(int64(2)),
+			Name:     Ptr("Sprint"),
+			DataType: Ptr("iteration"),
+			Configuration: &ProjectV2FieldConfiguration{
+				StartDay: Ptr(1),
+				Duration: Ptr(14),
+				Iterations: []*ProjectV2FieldIteration{{
+					ID:        Ptr("b"),
+					Title:     &ProjectV2TextContent{Raw: Ptr("Sprint 1")},
+					StartDate: Ptr("2025-01-06"),
+					Duration:  Ptr(14),
+				}},
+			},
+		},
+	}
+	if !cmp.Equal(fields, want) {
+		t.Errorf("Projects.ListOrganizationProjectFields returned %+v, want %+v", fields, want)
+	}
+
+	const methodName = "ListOrganizationProjectFields"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListOrganizationProjectFields(ctx, "\n", 2, nil)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListOrganizationProjectFields(ctx, "o", 2, nil)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_GetUserProjectField(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/users/u/projectsV2/2/fields/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":3,"data_type":"number"}`)
+	})
+
+	ctx := t.Context()
+	field, _, err := client.Projects.GetUserProjectField(ctx, "u", 2, 3)
+	if err != nil {
+		t.Errorf("Projects.GetUserProjectField returned error: %v", err)
+	}
+
+	want := &ProjectV2Field{ID: Ptr(int64(3)), DataType: Ptr("number")}
+	if !cmp.Equal(field, want) {
+		t.Errorf("Projects.GetUserProjectField returned %+v, want %+v", field, want)
+	}
+
+	const methodName = "GetUserProjectField"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.GetUserProjectField(ctx, "\n", 2, 3)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.GetUserProjectField(ctx, "u", 2, 3)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_ListUserProjectItems(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/users/u/projectsV2/2/items", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"q": "is:open", "fields": "1,2"})
+		fmt.Fprint(w, `[{
+			"id": 10,
+			"content_type": "Issue",
+			"content": {"number": 5},
+			"fields": [{"id": 1, "name": "Status", "type": "single_select", "value": {"id": "a"}}]
+		}]`)
+	})
+
+	ctx := t.Context()
+	opts := &ListProjectItemsOptions{Query: "is:open", Fields: []int64{1, 2}}
+	items, _, err := client.Projects.ListUserProjectItems(ctx, "u", 2, opts)
+	if err != nil {
+		t.Errorf("Projects.ListUserProjectItems returned error: %v", err)
+	}
+
+	want := []*ProjectV2Item{{
+		ID:          Ptr(int64(10)),
+		ContentType: Ptr("Issue"),
+		Content:     json.RawMessage(`{"number": 5}`),
+		Fields: []*ProjectV2ItemFieldValue{{
+			ID:    Ptr(int64(1)),
+			Name:  Ptr("Status"),
+			Type:  Ptr("single_select"),
+			Value: json.RawMessage(`{"id": "a"}`),
+		}},
+	}}
+	if !cmp.Equal(items, want) {
+		t.Errorf("Projects.ListUserProjectItems returned %+v, want %+v", items, want)
+	}
+
+	const methodName = "ListUserProjectItems"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.ListUserProjectItems(ctx, "\n", 2, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.ListUserProjectItems(ctx, "u", 2, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_GetOrganizationProjectItem(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/projectsV2/2/items/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"fields": "1"})
+		fmt.Fprint(w, `{"id":10,"content_type":"PullRequest","content":{"number":7}}`)
+	})
+
+	ctx := t.Context()
+	opts := &GetProjectItemOptions{Fields: []int64{1}}
+	item, _, err := client.Projects.GetOrganizationProjectItem(ctx, "o", 2, 10, opts)
+	if err != nil {
+		t.Errorf("Projects.GetOrganizationProjectItem returned error: %v", err)
+	}
+
+	content, err := item.ParseContent()
+	if err != nil {
+		t.Errorf("ParseContent returned error: %v", err)
+	}
+	if want := (&PullRequest{Number: Ptr(7)}); !cmp.Equal(content, want) {
+		t.Errorf("ParseContent returned %+v, want %+v", content, want)
+	}
+
+	const methodName = "GetOrganizationProjectItem"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.GetOrganizationProjectItem(ctx, "\n", 2, 10, opts)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.GetOrganizationProjectItem(ctx, "o", 2, 10, opts)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_AddOrganizationProjectItem(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := &AddProjectItemOptions{Type: "Issue", ID: 42}
+
+	mux.HandleFunc("/orgs/o/projectsV2/2/items", func(w http.ResponseWriter, r *http.Request) {
+		v := new(AddProjectItemOptions)
+		assertNilError(t, json.NewDecoder(r.Body).Decode(v))
+
+		testMethod(t, r, "POST")
+		if !cmp.Equal(v, input) {
+			t.Errorf("Request body = %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"id":10,"content_type":"Issue"}`)
+	})
+
+	ctx := t.Context()
+	item, _, err := client.Projects.AddOrganizationProjectItem(ctx, "o", 2, input)
+	if err != nil {
+		t.Errorf("Projects.AddOrganizationProjectItem returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Ptr(int64(10)), ContentType: Ptr("Issue")}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.AddOrganizationProjectItem returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "AddOrganizationProjectItem"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.AddOrganizationProjectItem(ctx, "\n", 2, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.AddOrganizationProjectItem(ctx, "o", 2, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_UpdateUserProjectItem(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	input := &UpdateProjectItemOptions{
+		Fields: []*ProjectV2ItemFieldUpdate{
+			{ID: 1, Value: "a"},
+			{ID: 2, Value: 3.5},
+			{ID: 3, Value: nil},
+		},
+	}
+
+	mux.HandleFunc("/users/u/projectsV2/2/items/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testBody(t, r, `{"fields":[{"id":1,"value":"a"},{"id":2,"value":3.5},{"id":3,"value":null}]}`+"\n")
+		fmt.Fprint(w, `{"id":10}`)
+	})
+
+	ctx := t.Context()
+	item, _, err := client.Projects.UpdateUserProjectItem(ctx, "u", 2, 10, input)
+	if err != nil {
+		t.Errorf("Projects.UpdateUserProjectItem returned error: %v", err)
+	}
+
+	want := &ProjectV2Item{ID: Ptr(int64(10))}
+	if !cmp.Equal(item, want) {
+		t.Errorf("Projects.UpdateUserProjectItem returned %+v, want %+v", item, want)
+	}
+
+	const methodName = "UpdateUserProjectItem"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Projects.UpdateUserProjectItem(ctx, "\n", 2, 10, input)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		got, resp, err := client.Projects.UpdateUserProjectItem(ctx, "u", 2, 10, input)
+		if got != nil {
+			t.Errorf("testNewRequestAndDoFailure %v = %#v, want nil", methodName, got)
+		}
+		return resp, err
+	})
+}
+
+func TestProjectsService_DeleteOrganizationProjectItem(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	mux.HandleFunc("/orgs/o/projectsV2/2/items/10", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := t.Context()
+	_, err := client.Projects.DeleteOrganizationProjectItem(ctx, "o", 2, 10)
+	if err != nil {
+		t.Errorf("Projects.DeleteOrganizationProjectItem returned error: %v", err)
+	}
+
+	const methodName = "DeleteOrganizationProjectItem"
+	testBadOptions(t, methodName, func() (err error) {
+		_, err = client.Projects.DeleteOrganizationProjectItem(ctx, "\n", 2, 10)
+		return err
+	})
+
+	testNewRequestAndDoFailure(t, methodName, client, func() (*Response, error) {
+		return client.Projects.DeleteOrganizationProjectItem(ctx, "o", 2, 10)
+	})
+}
+
+func TestProjectV2Item_ParseContent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		item    *ProjectV2Item
+		want    any
+		wantErr bool
+	}{
+		{
+			name: "no content",
+			item: &ProjectV2Item{ContentType: Ptr("Issue")},
+		},
+		{
+			name: "issue",
+			item: &ProjectV2Item{ContentType: Ptr("Issue"), Content: json.RawMessage(`{"number":1}`)},
+			want: &Issue{Number: Ptr(1)},
+		},
+		{
+			name: "draft issue",
+			item: &ProjectV2Item{ContentType: Ptr("DraftIssue"), Content: json.RawMessage(`{"title":"t"}`)},
+			want: &ProjectV2DraftIssue{Title: Ptr("t")},
+		},
+		{
+			name:    "unknown type",
+			item:    &ProjectV2Item{ContentType: Ptr("Other"), Content: json.RawMessage(`{}`)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid content",
+			item:    &ProjectV2Item{ContentType: Ptr("Issue"), Content: json.RawMessage(`[]`)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.item.ParseContent()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseContent returned error %v, wantErr %v", err, tt.wantErr)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("ParseContent returned %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjectV2Field_Marshal(t *testing.T) {
+	t.Parallel()
+	testJSONMarshal(t, &ProjectV2Field{}, "{}")
+
+	u := &ProjectV2Field{
+		ID:       Ptr(int64(1)),
+		Name:     Ptr("Estimate"),
+		DataType: Ptr("number"),
+	}
+
+	want := `{
+		"id": 1,
+		"name": "Estimate",
+		"data_type": "number"
+	}`
+
+	testJSONMarshal(t, u, want)
+}
@@ -370,6 +370,31 @@ func (s *RepositoriesService) GetArchiveLink(ctx context.Context, owner, repo st
 	return s.getArchiveLinkWithoutRateLimit(ctx, u, maxRedirects)
 }
 
+// DownloadArchive downloads a tarball or zipball archive of a repository. The
+// archive is fetched from the URL returned by GetArchiveLink, through the
+// transport of the client. It is the caller's responsibility to close the
+// Archive.
+//
+// The URL is usually a signed URL on another host than the API, which is
+// fetched without the credentials added by Client.WithAuthToken, AppTransport,
+// InstallationTransport and TokenPoolTransport. Other transports adding
+// credentials, such as those of golang.org/x/oauth2, are used as is, and
+// should only add them to requests for the API.
+//
+// GitHub API docs: https://docs.github.com/rest/repos/contents#download-a-repository-archive-tar
+// GitHub API docs: https://docs.github.com/rest/repos/contents#download-a-repository-archive-zip
+//
+//meta:operation GET /repos/{owner}/{repo}/tarball/{ref}
+//meta:operation GET /repos/{owner}/{repo}/zipball/{ref}
+func (s *RepositoriesService) DownloadArchive(ctx context.Context, owner, repo string, archiveformat ArchiveFormat, opts *RepositoryContentGetOptions, maxRedirects int) (*Archive, *Response, error) {
+	u, resp, err := s.GetArchiveLink(ctx, owner, repo, archiveformat, opts, maxRedirects)
+	if err != nil {
+		return nil, resp, err
+	}
+	archive, err := s.client.downloadArchive(ctx, u, archiveformat)
+	return archive, resp, err
+}
+
 func (s *RepositoriesService) getArchiveLinkWithoutRateLimit(ctx context.Context, u string, maxRedirects int) (*url.URL, *Response, error) {
 	resp, err := s.client.roundTripWithOptionalFollowRedirect(ctx, u, maxRedirects)
 	if err != nil {
@@ -10,7 +10,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -1004,6 +1008,93 @@ func TestRepositoriesService_GetArchiveLink_StatusMovedPermanently_followRedirec
 	}
 }
 
+func TestRepositoriesService_DownloadArchive(t *testing.T) {
+	t.Parallel()
+	client, mux, serverURL := setup(t)
+
+	mux.HandleFunc("/repos/o/r/tarball/yo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.Redirect(w, r, serverURL+baseURLPath+"/archive", http.StatusFound)
+	})
+	mux.HandleFunc("/archive", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		_, _ = w.Write(testTarball(t, testArchiveEntries))
+	})
+
+	ctx := t.Context()
+	opts := &RepositoryContentGetOptions{Ref: "yo"}
+	archive, _, err := client.Repositories.DownloadArchive(ctx, "o", "r", Tarball, opts, 1)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadArchive returned error: %v", err)
+	}
+	defer archive.Close()
+	dir := t.TempDir()
+	if err := archive.Extract(dir); err != nil {
+		t.Fatalf("Archive.Extract returned error: %v", err)
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "repo-sha", "README.md"))
+	if err != nil {
+		t.Fatalf("reading extracted file returned error: %v", err)
+	}
+	if string(b) != "# Repo" {
+		t.Errorf("extracted README.md = %q, want %q", b, "# Repo")
+	}
+
+	const methodName = "DownloadArchive"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.DownloadArchive(ctx, "\n", "\n", Tarball, opts, 1)
+		return err
+	})
+}
+
+func TestRepositoriesService_DownloadArchive_otherHost(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	var hosts []string
+	client.client.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		hosts = append(hosts, req.URL.Host)
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	client = client.WithAuthToken("token")
+
+	// Signed URLs on other hosts must not receive the credentials of the
+	// client, but are still fetched through its transport.
+	blobs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("Authorization header = %q, want none", got)
+		}
+		_, _ = w.Write(testTarball(t, testArchiveEntries))
+	}))
+	t.Cleanup(blobs.Close)
+	mux.HandleFunc("/repos/o/r/tarball/yo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Authorization", "Bearer token")
+		http.Redirect(w, r, blobs.URL+"/archive?sig=s", http.StatusFound)
+	})
+
+	ctx := t.Context()
+	opts := &RepositoryContentGetOptions{Ref: "yo"}
+	archive, _, err := client.Repositories.DownloadArchive(ctx, "o", "r", Tarball, opts, 1)
+	if err != nil {
+		t.Fatalf("Repositories.DownloadArchive returned error: %v", err)
+	}
+	defer archive.Close()
+	var names []string
+	for entry, err := range archive.Entries() {
+		if err != nil {
+			t.Fatalf("Archive.Entries returned error: %v", err)
+		}
+		names = append(names, entry.Name)
+	}
+	if len(names) == 0 {
+		t.Error("Archive.Entries returned no entries")
+	}
+	if want := []string{client.BaseURL.Host, strings.TrimPrefix(blobs.URL, "http://")}; !cmp.Equal(hosts, want) {
+		t.Errorf("transport sent requests to %v, want %v", hosts, want)
+	}
+}
+
 func TestRepositoriesService_GetContents_NoTrailingSlashInDirectoryApiPath(t *testing.T) {
 	t.Parallel()
 	client, mux, _ := setup(t)
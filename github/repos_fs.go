@@ -0,0 +1,488 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+RepositoryFS is a read-only fs.FS over the files of a repository at a commit,
+as returned by RepositoriesService.FS. It implements fs.ReadDirFS,
+fs.ReadFileFS and fs.StatFS, so it can be used with fs.WalkDir,
+template.ParseFS and other functions working on file systems:
+
+	fsys, _, err := client.Repositories.FS(ctx, "owner", "repo", "main")
+	if err != nil {
+		return err
+	}
+	err = fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		...
+	})
+
+The tree of the commit is fetched when the RepositoryFS is created. The
+contents of files are fetched when they are first read, and cached by blob
+SHA. If the tree is too large to be fetched at once, directories are also
+fetched when they are first read.
+
+Since the methods of fs.FS take no context, the requests made to read files
+and directories use the context of the RepositoryFS, which is
+context.Background() unless set with WithContext:
+
+	data, err := fs.ReadFile(fsys.WithContext(ctx), "go.mod")
+
+Symbolic links are reported with fs.ModeSymlink, and reading them returns
+their target. Submodules are reported with fs.ModeIrregular and are empty.
+The RepositoryFS is safe for concurrent use.
+*/
+type RepositoryFS struct {
+	*repoFS
+	ctx context.Context // For the requests made by reads. See WithContext.
+}
+
+// repoFS holds the state of a RepositoryFS, shared by its copies made with
+// WithContext.
+type repoFS struct {
+	client *Client
+	owner  string
+	repo   string
+	sha    string
+
+	mu    sync.Mutex
+	dirs  map[string]*repoFSDir // By path, with "." for the root.
+	blobs map[string][]byte     // By SHA.
+}
+
+// repoFSDir is a directory of a RepositoryFS.
+type repoFSDir struct {
+	sha     string
+	loaded  bool
+	entries []*repoFSEntry // Sorted by name.
+}
+
+// repoFSEntry is a file or directory of a RepositoryFS. It implements
+// fs.FileInfo.
+type repoFSEntry struct {
+	name  string
+	mode  fs.FileMode
+	entry *TreeEntry
+}
+
+// FS returns a read-only file system over the files of a repository at ref,
+// which can be a branch, a tag or a commit SHA. If ref is empty, the default
+// branch is used.
+//
+// ctx is only used for the requests made by FS itself. Use
+// RepositoryFS.WithContext to set the context of the requests made to read
+// files after FS returns.
+//
+// GitHub API docs: https://docs.github.com/rest/commits/commits#get-a-commit
+// GitHub API docs: https://docs.github.com/rest/git/blobs#get-a-blob
+// GitHub API docs: https://docs.github.com/rest/git/trees#get-a-tree
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}
+//meta:operation GET /repos/{owner}/{repo}/git/blobs/{file_sha}
+//meta:operation GET /repos/{owner}/{repo}/git/trees/{tree_sha}
+func (s *RepositoriesService) FS(ctx context.Context, owner, repo, ref string) (*RepositoryFS, *Response, error) {
+	if ref == "" {
+		ref = "HEAD"
+	}
+	// Resolve ref first, so that the file system does not change if ref is
+	// updated while it is in use.
+	sha, resp, err := s.GetCommitSHA1(ctx, owner, repo, ref, "")
+	if err != nil {
+		return nil, resp, err
+	}
+	fsys := &RepositoryFS{
+		repoFS: &repoFS{
+			client: s.client,
+			owner:  owner,
+			repo:   repo,
+			sha:    sha,
+			dirs:   make(map[string]*repoFSDir),
+			blobs:  make(map[string][]byte),
+		},
+		ctx: context.Background(),
+	}
+
+	tree, resp, err := s.client.Git.GetTree(ctx, owner, repo, sha, true)
+	if err != nil {
+		return nil, resp, err
+	}
+	fsys.dirs["."] = &repoFSDir{sha: tree.GetSHA()}
+	// If the tree is truncated, directories are loaded when needed.
+	if !tree.GetTruncated() {
+		fsys.addEntries(".", tree.Entries, true)
+	}
+	return fsys, resp, nil
+}
+
+// SHA returns the SHA of the commit the file system is at.
+func (fsys *RepositoryFS) SHA() string {
+	return fsys.sha
+}
+
+// WithContext returns a copy of fsys that uses ctx for the requests made to
+// read files and directories. The copy shares the files and directories
+// already fetched with fsys. The provided ctx must be non-nil.
+func (fsys *RepositoryFS) WithContext(ctx context.Context) *RepositoryFS {
+	if ctx == nil {
+		panic("nil context")
+	}
+	return &RepositoryFS{repoFS: fsys.repoFS, ctx: ctx}
+}
+
+// addEntries adds the entries of a tree fetched for dir, and marks dir as
+// loaded. If recursive is true, the entries are those of dir and all its
+// subdirectories, which are marked as loaded too. fsys.mu must be held.
+func (fsys *RepositoryFS) addEntries(dir string, entries []*TreeEntry, recursive bool) {
+	loaded := []*repoFSDir{fsys.dirs[dir]}
+	for _, e := range entries {
+		name := path.Join(dir, e.GetPath())
+		parent := fsys.dirs[path.Dir(name)]
+		if parent == nil {
+			// Recursive trees list directories before their contents, so
+			// this only happens for malformed trees.
+			continue
+		}
+		parent.entries = append(parent.entries, &repoFSEntry{name: path.Base(name), mode: treeEntryMode(e), entry: e})
+		if e.GetType() == "tree" {
+			d := &repoFSDir{sha: e.GetSHA()}
+			fsys.dirs[name] = d
+			if recursive {
+				loaded = append(loaded, d)
+			}
+		}
+	}
+
+	for _, d := range loaded {
+		slices.SortFunc(d.entries, func(a, b *repoFSEntry) int {
+			return strings.Compare(a.name, b.name)
+		})
+		d.loaded = true
+	}
+}
+
+// treeEntryMode returns the fs.FileMode of a tree entry.
+func treeEntryMode(e *TreeEntry) fs.FileMode {
+	switch e.GetMode() {
+	case "040000":
+		return fs.ModeDir | 0o555
+	case "100755":
+		return 0o555
+	case "120000":
+		return fs.ModeSymlink | 0o777
+	case "160000":
+		return fs.ModeIrregular
+	default:
+		return 0o444
+	}
+}
+
+// dir returns the directory at name, fetching it if it was not loaded yet.
+func (fsys *RepositoryFS) dir(name string) (*repoFSDir, error) {
+	fsys.mu.Lock()
+	d := fsys.dirs[name]
+	fsys.mu.Unlock()
+	if d == nil {
+		// The parent directory may not be loaded yet.
+		if name == "." {
+			return nil, fs.ErrNotExist
+		}
+		e, err := fsys.lookup(name)
+		if err != nil {
+			return nil, err
+		}
+		if !e.mode.IsDir() {
+			return nil, errors.New("not a directory")
+		}
+		fsys.mu.Lock()
+		d = fsys.dirs[name]
+		fsys.mu.Unlock()
+	}
+
+	fsys.mu.Lock()
+	loaded := d.loaded
+	fsys.mu.Unlock()
+	if loaded {
+		return d, nil
+	}
+	tree, _, err := fsys.client.Git.GetTree(fsys.ctx, fsys.owner, fsys.repo, d.sha, false)
+	if err != nil {
+		return nil, err
+	}
+	fsys.mu.Lock()
+	defer fsys.mu.Unlock()
+	if !d.loaded {
+		fsys.addEntries(name, tree.Entries, false)
+	}
+	return d, nil
+}
+
+// lookup returns the file or directory at name, other than the root.
+func (fsys *RepositoryFS) lookup(name string) (*repoFSEntry, error) {
+	parent, err := fsys.dir(path.Dir(name))
+	if err != nil {
+		return nil, err
+	}
+	base := path.Base(name)
+	i, ok := slices.BinarySearchFunc(parent.entries, base, func(e *repoFSEntry, name string) int {
+		return strings.Compare(e.name, name)
+	})
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return parent.entries[i], nil
+}
+
+// stat returns the file or directory at name.
+func (fsys *RepositoryFS) stat(op, name string) (*repoFSEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		fsys.mu.Lock()
+		defer fsys.mu.Unlock()
+		return &repoFSEntry{name: ".", mode: fs.ModeDir | 0o555, entry: &TreeEntry{SHA: Ptr(fsys.dirs["."].sha), Type: Ptr("tree")}}, nil
+	}
+	e, err := fsys.lookup(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+	return e, nil
+}
+
+// blob returns the contents of the blob with the given SHA, fetching it if it
+// is not cached yet.
+func (fsys *RepositoryFS) blob(sha string) ([]byte, error) {
+	fsys.mu.Lock()
+	b, ok := fsys.blobs[sha]
+	fsys.mu.Unlock()
+	if ok {
+		return b, nil
+	}
+	b, _, err := fsys.client.Git.GetBlobRaw(fsys.ctx, fsys.owner, fsys.repo, sha)
+	if err != nil {
+		return nil, err
+	}
+	fsys.mu.Lock()
+	fsys.blobs[sha] = b
+	fsys.mu.Unlock()
+	return b, nil
+}
+
+// Open implements fs.FS.
+func (fsys *RepositoryFS) Open(name string) (fs.File, error) {
+	e, err := fsys.stat("open", name)
+	if err != nil {
+		return nil, err
+	}
+	if e.IsDir() {
+		return &repoFSDirFile{fsys: fsys, name: name, info: e}, nil
+	}
+	return &repoFSFile{fsys: fsys, name: name, info: e}, nil
+}
+
+// Stat implements fs.StatFS.
+func (fsys *RepositoryFS) Stat(name string) (fs.FileInfo, error) {
+	e, err := fsys.stat("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// ReadDir implements fs.ReadDirFS.
+func (fsys *RepositoryFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+	d, err := fsys.dir(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: err}
+	}
+	entries := make([]fs.DirEntry, len(d.entries))
+	for i, e := range d.entries {
+		entries[i] = fs.FileInfoToDirEntry(e)
+	}
+	return entries, nil
+}
+
+// ReadFile implements fs.ReadFileFS.
+func (fsys *RepositoryFS) ReadFile(name string) ([]byte, error) {
+	e, err := fsys.stat("read", name)
+	if err != nil {
+		return nil, err
+	}
+	if e.IsDir() {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: errors.New("is a directory")}
+	}
+	if e.mode&fs.ModeIrregular != 0 {
+		return []byte{}, nil
+	}
+	b, err := fsys.blob(e.entry.GetSHA())
+	if err != nil {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: err}
+	}
+	return slices.Clone(b), nil
+}
+
+// Name implements fs.FileInfo.
+func (e *repoFSEntry) Name() string { return e.name }
+
+// Size implements fs.FileInfo.
+func (e *repoFSEntry) Size() int64 { return int64(e.entry.GetSize()) }
+
+// Mode implements fs.FileInfo.
+func (e *repoFSEntry) Mode() fs.FileMode { return e.mode }
+
+// ModTime implements fs.FileInfo. It returns the zero time, since the
+// modification time of files is not tracked by Git.
+func (e *repoFSEntry) ModTime() time.Time { return time.Time{} }
+
+// IsDir implements fs.FileInfo.
+func (e *repoFSEntry) IsDir() bool { return e.mode.IsDir() }
+
+// Sys implements fs.FileInfo. It returns the *TreeEntry of the file.
+func (e *repoFSEntry) Sys() any { return e.entry }
+
+// repoFSFile is an open file of a RepositoryFS. Its contents are fetched when
+// it is first read.
+type repoFSFile struct {
+	fsys   *RepositoryFS
+	name   string
+	info   *repoFSEntry
+	reader *bytes.Reader
+	closed bool
+}
+
+// load fetches the contents of the file, if needed.
+func (f *repoFSFile) load(op string) error {
+	if f.closed {
+		return &fs.PathError{Op: op, Path: f.name, Err: fs.ErrClosed}
+	}
+	if f.reader != nil {
+		return nil
+	}
+	if f.info.mode&fs.ModeIrregular != 0 {
+		f.reader = bytes.NewReader(nil)
+		return nil
+	}
+	b, err := f.fsys.blob(f.info.entry.GetSHA())
+	if err != nil {
+		return &fs.PathError{Op: op, Path: f.name, Err: err}
+	}
+	f.reader = bytes.NewReader(b)
+	return nil
+}
+
+// Stat implements fs.File.
+func (f *repoFSFile) Stat() (fs.FileInfo, error) {
+	if f.closed {
+		return nil, &fs.PathError{Op: "stat", Path: f.name, Err: fs.ErrClosed}
+	}
+	return f.info, nil
+}
+
+// Read implements fs.File.
+func (f *repoFSFile) Read(p []byte) (int, error) {
+	if err := f.load("read"); err != nil {
+		return 0, err
+	}
+	return f.reader.Read(p)
+}
+
+// ReadAt implements io.ReaderAt.
+func (f *repoFSFile) ReadAt(p []byte, off int64) (int, error) {
+	if err := f.load("read"); err != nil {
+		return 0, err
+	}
+	return f.reader.ReadAt(p, off)
+}
+
+// Seek implements io.Seeker.
+func (f *repoFSFile) Seek(offset int64, whence int) (int64, error) {
+	if err := f.load("seek"); err != nil {
+		return 0, err
+	}
+	return f.reader.Seek(offset, whence)
+}
+
+// Close implements fs.File.
+func (f *repoFSFile) Close() error {
+	if f.closed {
+		return &fs.PathError{Op: "close", Path: f.name, Err: fs.ErrClosed}
+	}
+	f.closed = true
+	return nil
+}
+
+// repoFSDirFile is an open directory of a RepositoryFS.
+type repoFSDirFile struct {
+	fsys    *RepositoryFS
+	name    string
+	info    *repoFSEntry
+	entries []fs.DirEntry // nil until ReadDir is first called.
+	offset  int
+	closed  bool
+}
+
+// Stat implements fs.File.
+func (d *repoFSDirFile) Stat() (fs.FileInfo, error) {
+	if d.closed {
+		return nil, &fs.PathError{Op: "stat", Path: d.name, Err: fs.ErrClosed}
+	}
+	return d.info, nil
+}
+
+// Read implements fs.File.
+func (d *repoFSDirFile) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: errors.New("is a directory")}
+}
+
+// ReadDir implements fs.ReadDirFile.
+func (d *repoFSDirFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	if d.closed {
+		return nil, &fs.PathError{Op: "readdir", Path: d.name, Err: fs.ErrClosed}
+	}
+	if d.entries == nil {
+		entries, err := d.fsys.ReadDir(d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries = entries
+	}
+
+	rest := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(rest))
+	d.offset += n
+	return rest[:n], nil
+}
+
+// Close implements fs.File.
+func (d *repoFSDirFile) Close() error {
+	if d.closed {
+		return &fs.PathError{Op: "close", Path: d.name, Err: fs.ErrClosed}
+	}
+	d.closed = true
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+)
+
+// setupRepoFS registers handlers serving a repository with a few files at
+// the commit c. If truncated is true, recursive trees are reported as
+// truncated. It returns the number of blob requests received.
+func setupRepoFS(t *testing.T, mux *http.ServeMux, truncated bool) *atomic.Int32 {
+	t.Helper()
+	mux.HandleFunc("/repos/o/r/commits/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", mediaTypeV3SHA)
+		fmt.Fprint(w, "c")
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/c", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"recursive": "1"})
+		if truncated {
+			fmt.Fprint(w, `{"sha":"root","truncated":true,"tree":[
+				{"path":"README.md","mode":"100644","type":"blob","sha":"readme","size":6}
+			]}`)
+			return
+		}
+		fmt.Fprint(w, `{"sha":"root","tree":[
+			{"path":"README.md","mode":"100644","type":"blob","sha":"readme","size":6},
+			{"path":"docs","mode":"040000","type":"tree","sha":"docs"},
+			{"path":"docs/a.md","mode":"100644","type":"blob","sha":"a","size":1},
+			{"path":"docs/sub","mode":"040000","type":"tree","sha":"sub"},
+			{"path":"docs/sub/run.sh","mode":"100755","type":"blob","sha":"run","size":3},
+			{"path":"docs/sub/copy.md","mode":"100644","type":"blob","sha":"a","size":1},
+			{"path":"link","mode":"120000","type":"blob","sha":"link","size":9}
+		]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/root", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"root","tree":[
+			{"path":"README.md","mode":"100644","type":"blob","sha":"readme","size":6},
+			{"path":"docs","mode":"040000","type":"tree","sha":"docs"},
+			{"path":"link","mode":"120000","type":"blob","sha":"link","size":9}
+		]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/docs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"docs","tree":[
+			{"path":"sub","mode":"040000","type":"tree","sha":"sub"},
+			{"path":"a.md","mode":"100644","type":"blob","sha":"a","size":1}
+		]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/sub", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sha":"sub","tree":[
+			{"path":"run.sh","mode":"100755","type":"blob","sha":"run","size":3},
+			{"path":"copy.md","mode":"100644","type":"blob","sha":"a","size":1}
+		]}`)
+	})
+
+	var blobRequests atomic.Int32
+	blobs := map[string]string{"readme": "# Repo", "a": "a", "run": "run", "link": "README.md"}
+	for sha, content := range blobs {
+		mux.HandleFunc("/repos/o/r/git/blobs/"+sha, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testHeader(t, r, "Accept", "application/vnd.github.v3.raw")
+			blobRequests.Add(1)
+			fmt.Fprint(w, content)
+		})
+	}
+	return &blobRequests
+}
+
+func TestRepositoriesService_FS(t *testing.T) {
+	t.Parallel()
+	for _, truncated := range []bool{false, true} {
+		t.Run(fmt.Sprintf("truncated=%v", truncated), func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+			blobRequests := setupRepoFS(t, mux, truncated)
+
+			fsys, _, err := client.Repositories.FS(t.Context(), "o", "r", "main")
+			if err != nil {
+				t.Fatalf("Repositories.FS returned error: %v", err)
+			}
+			if got := fsys.SHA(); got != "c" {
+				t.Errorf("SHA = %v, want c", got)
+			}
+			if got := blobRequests.Load(); got != 0 {
+				t.Errorf("Repositories.FS fetched %v blobs, want none", got)
+			}
+
+			if err := fstest.TestFS(fsys, "README.md", "docs/a.md", "docs/sub/run.sh", "docs/sub/copy.md", "link"); err != nil {
+				t.Error(err)
+			}
+			// Blobs are fetched once, and files with the same contents
+			// share them.
+			if got := blobRequests.Load(); got != 3 {
+				t.Errorf("file system fetched %v blobs, want 3", got)
+			}
+
+			info, err := fs.Stat(fsys, "docs/sub/run.sh")
+			if err != nil {
+				t.Fatalf("Stat returned error: %v", err)
+			}
+			if info.Mode() != 0o555 || info.Size() != 3 {
+				t.Errorf("Stat = mode %v, size %v, want mode %v, size 3", info.Mode(), info.Size(), fs.FileMode(0o555))
+			}
+			if e, ok := info.Sys().(*TreeEntry); !ok || e.GetSHA() != "run" {
+				t.Errorf("Stat.Sys = %v, want tree entry with SHA run", info.Sys())
+			}
+			info, err = fs.Stat(fsys, "link")
+			if err != nil {
+				t.Fatalf("Stat returned error: %v", err)
+			}
+			if info.Mode()&fs.ModeSymlink == 0 {
+				t.Errorf("Stat(link) mode = %v, want symbolic link", info.Mode())
+			}
+			target, err := fs.ReadFile(fsys, "link")
+			if err != nil {
+				t.Fatalf("ReadFile returned error: %v", err)
+			}
+			if string(target) != "README.md" {
+				t.Errorf("ReadFile(link) = %q, want README.md", target)
+			}
+
+			if _, err := fs.ReadFile(fsys, "docs/missing.md"); !errors.Is(err, fs.ErrNotExist) {
+				t.Errorf("ReadFile returned error %v, want %v", err, fs.ErrNotExist)
+			}
+			if _, err := fs.ReadDir(fsys, "README.md/x"); err == nil {
+				t.Error("ReadDir of a file returned nil error")
+			}
+		})
+	}
+}
+
+func TestRepositoryFS_WithContext(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	blobRequests := setupRepoFS(t, mux, false)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	fsys, _, err := client.Repositories.FS(ctx, "o", "r", "main")
+	if err != nil {
+		t.Fatalf("Repositories.FS returned error: %v", err)
+	}
+	// The context of FS is not used after it returns.
+	cancel()
+	if _, err := fs.ReadFile(fsys, "README.md"); err != nil {
+		t.Fatalf("ReadFile returned error: %v", err)
+	}
+
+	canceled := fsys.WithContext(ctx)
+	if _, err := fs.ReadFile(canceled, "docs/a.md"); !errors.Is(err, context.Canceled) {
+		t.Errorf("ReadFile with a canceled context returned error %v, want %v", err, context.Canceled)
+	}
+	// Files already fetched are shared.
+	if _, err := fs.ReadFile(canceled, "README.md"); err != nil {
+		t.Errorf("ReadFile of a fetched file returned error: %v", err)
+	}
+	if got := blobRequests.Load(); got != 1 {
+		t.Errorf("file system fetched %v blobs, want 1", got)
+	}
+}
+
+func TestRepositoriesService_FS_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	mux.HandleFunc("/repos/o/r/commits/HEAD", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "c")
+	})
+	mux.HandleFunc("/repos/o/r/git/trees/c", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"sha":"root","tree":[{"path":"f","mode":"100644","type":"blob","sha":"f"}]}`)
+	})
+	mux.HandleFunc("/repos/o/r/git/blobs/f", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	ctx := t.Context()
+	fsys, _, err := client.Repositories.FS(ctx, "o", "r", "")
+	if err != nil {
+		t.Fatalf("Repositories.FS returned error: %v", err)
+	}
+	_, err = fsys.ReadFile("f")
+	var pathErr *fs.PathError
+	var errResp *ErrorResponse
+	if !errors.As(err, &pathErr) || !errors.As(err, &errResp) {
+		t.Errorf("ReadFile returned error %v, want *fs.PathError wrapping *ErrorResponse", err)
+	}
+
+	const methodName = "FS"
+	testBadOptions(t, methodName, func() (err error) {
+		_, _, err = client.Repositories.FS(ctx, "\n", "\n", "\n")
+		return err
+	})
+}
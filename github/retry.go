@@ -0,0 +1,216 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultRetryMinBackoff = time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// defaultRetryableStatusCodes are the HTTP status codes of responses that are
+// retried when RetryPolicy.RetryableStatusCodes is nil.
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy configures how a Client retries requests that failed with a
+// transient error. Requests are retried when
+//
+//   - the request timed out, or the connection was reset or closed before
+//     the response was complete,
+//   - GitHub responded with one of the RetryableStatusCodes, or
+//   - a secondary rate limit was hit (*AbuseRateLimitError).
+//
+// Primary rate limit errors (*RateLimitError) are never retried since the
+// limit may not reset for up to an hour. Use the
+// SleepUntilPrimaryRateLimitResetWhenRateLimited context value instead.
+//
+// Between attempts, the client waits for the duration given by the
+// Retry-After header or AbuseRateLimitError.RetryAfter if present. Otherwise
+// it uses an exponential backoff with jitter, starting at MinBackoff and
+// capped at MaxBackoff.
+//
+// Use it by setting Client.RetryPolicy:
+//
+//	client := github.NewClient(nil)
+//	client.RetryPolicy = &github.RetryPolicy{MaxAttempts: 4}
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a request,
+	// including the first one. Values less than 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff is the base delay before the first retry. It doubles with
+	// every subsequent attempt. Defaults to 1 second.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the computed backoff delay. It does not cap delays
+	// requested by GitHub through Retry-After. Defaults to 30 seconds.
+	MaxBackoff time.Duration
+
+	// RetryableStatusCodes lists the HTTP status codes that are considered
+	// transient. Defaults to 429, 502, 503 and 504.
+	RetryableStatusCodes []int
+
+	// RetryNonIdempotent allows retrying POST and PATCH requests. By default,
+	// only requests with idempotent methods (GET, HEAD, OPTIONS, TRACE, PUT
+	// and DELETE) are retried, since a failed POST may still have been
+	// applied.
+	RetryNonIdempotent bool
+}
+
+// retryDelay reports whether req, whose attempt number attempt failed with
+// err, should be retried and how long to wait before doing so.
+func (p *RetryPolicy) retryDelay(req *http.Request, attempt int, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || !p.canRetry(req) {
+		return 0, false
+	}
+
+	var abuseErr *AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		if abuseErr.RetryAfter != nil {
+			return max(*abuseErr.RetryAfter, 0), true
+		}
+		return p.backoff(attempt), true
+	}
+
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return 0, false
+	}
+
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		if errResp.Response == nil || !p.isRetryableStatus(errResp.Response.StatusCode) {
+			return 0, false
+		}
+		if d, ok := parseRetryAfter(errResp.Response.Header.Get(headerRetryAfter)); ok {
+			return d, true
+		}
+		return p.backoff(attempt), true
+	}
+
+	if isTransientNetworkError(err) {
+		return p.backoff(attempt), true
+	}
+
+	return 0, false
+}
+
+// isTransientNetworkError reports whether err, returned by the transport and
+// wrapped in a *url.Error by http.Client, may not happen again: a timeout, or
+// a connection that was reset or closed before the response was complete.
+// Other errors, such as TLS certificate or malformed URL errors, are not.
+func isTransientNetworkError(err error) bool {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return false
+	}
+	return urlErr.Timeout() ||
+		errors.Is(urlErr.Err, syscall.ECONNRESET) ||
+		errors.Is(urlErr.Err, io.EOF) ||
+		errors.Is(urlErr.Err, io.ErrUnexpectedEOF)
+}
+
+// canRetry reports whether req may be sent again.
+func (p *RetryPolicy) canRetry(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE", "TRACE":
+	default:
+		if !p.RetryNonIdempotent {
+			return false
+		}
+	}
+	// The body must be replayable.
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func (p *RetryPolicy) isRetryableStatus(code int) bool {
+	codes := p.RetryableStatusCodes
+	if codes == nil {
+		codes = defaultRetryableStatusCodes
+	}
+	return slices.Contains(codes, code)
+}
+
+// backoff returns the delay before retrying after the given attempt. It grows
+// exponentially with full jitter on the upper half of the interval.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultRetryMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	d := minBackoff
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, maxBackoff)
+
+	half := d / 2
+	return half + rand.N(d-half+1) //nolint:gosec // jitter does not need to be cryptographically secure.
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// rewindRequest returns a copy of req with a fresh body, suitable for sending
+// req again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req2 := req.Clone(req.Context())
+	req2.Body = body
+	return req2, nil
+}
+
+// sleepWithContext waits for d to elapse or for ctx to be done, whichever
+// happens first.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
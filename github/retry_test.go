@@ -0,0 +1,408 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_getRetried(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	var calls int
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := t.Context()
+	repo, _, err := client.Repositories.Get(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if repo.GetID() != 1 {
+		t.Errorf("Repositories.Get returned ID %v, want 1", repo.GetID())
+	}
+	if calls != 3 {
+		t.Errorf("server received %v requests, want 3", calls)
+	}
+}
+
+func TestRetryPolicy_maxAttempts(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}
+
+	var calls int
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx := t.Context()
+	_, resp, err := client.Repositories.Get(ctx, "o", "r")
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Repositories.Get returned error %v, want *ErrorResponse", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Repositories.Get returned status %v, want %v", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+	if calls != 2 {
+		t.Errorf("server received %v requests, want 2", calls)
+	}
+}
+
+func TestRetryPolicy_notRetryableStatus(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	var calls int
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	ctx := t.Context()
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err == nil {
+		t.Fatal("Repositories.Get returned nil error, want error")
+	}
+	if calls != 1 {
+		t.Errorf("server received %v requests, want 1", calls)
+	}
+}
+
+func TestRetryPolicy_nonIdempotent(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	var calls int
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"t"}`+"\n")
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"number":1}`)
+	})
+
+	ctx := t.Context()
+	input := &IssueRequest{Title: Ptr("t")}
+	if _, _, err := client.Issues.Create(ctx, "o", "r", input); err == nil {
+		t.Fatal("Issues.Create returned nil error, want error")
+	}
+	if calls != 1 {
+		t.Errorf("server received %v requests, want 1", calls)
+	}
+
+	client.RetryPolicy.RetryNonIdempotent = true
+	calls = 0
+	issue, _, err := client.Issues.Create(ctx, "o", "r", input)
+	if err != nil {
+		t.Fatalf("Issues.Create returned error: %v", err)
+	}
+	if issue.GetNumber() != 1 {
+		t.Errorf("Issues.Create returned number %v, want 1", issue.GetNumber())
+	}
+	if calls != 2 {
+		t.Errorf("server received %v requests, want 2", calls)
+	}
+}
+
+func TestRetryPolicy_abuseRateLimit(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Hour}
+
+	var calls int
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set(headerRetryAfter, "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{
+   "message": "You have triggered an abuse detection mechanism and have been temporarily blocked from content creation. Please retry your request again later.",
+   "documentation_url": "https://docs.github.com/en/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"
+}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := t.Context()
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("server received %v requests, want 2", calls)
+	}
+}
+
+func TestRetryPolicy_primaryRateLimitNotRetried(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}
+
+	var calls int
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"API rate limit exceeded"}`)
+	})
+
+	ctx := t.Context()
+	_, _, err := client.Repositories.Get(ctx, "o", "r")
+	if !errors.As(err, new(*RateLimitError)) {
+		t.Fatalf("Repositories.Get returned error %v, want *RateLimitError", err)
+	}
+	if calls != 1 {
+		t.Errorf("server received %v requests, want 1", calls)
+	}
+}
+
+func TestRetryPolicy_connectionError(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}
+
+	var calls int
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Fatal(err)
+			}
+			conn.Close()
+			return
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx := t.Context()
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("server received %v requests, want 2", calls)
+	}
+}
+
+func TestRetryPolicy_contextCanceledDuringBackoff(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, _, err := client.Repositories.Get(ctx, "o", "r")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Repositories.Get returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRetryPolicy_retryDelay(t *testing.T) {
+	t.Parallel()
+	get := &http.Request{Method: "GET"}
+	resp := func(code int, retryAfter string) *http.Response {
+		r := &http.Response{StatusCode: code, Header: http.Header{}}
+		if retryAfter != "" {
+			r.Header.Set(headerRetryAfter, retryAfter)
+		}
+		return r
+	}
+	policy := &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Second, MaxBackoff: time.Second}
+
+	tests := []struct {
+		name      string
+		policy    *RetryPolicy
+		req       *http.Request
+		attempt   int
+		err       error
+		wantDelay time.Duration
+		wantRetry bool
+		backoff   bool // The delay is a backoff of up to wantDelay.
+	}{
+		{
+			name: "nil policy",
+			req:  get,
+			err:  &ErrorResponse{Response: resp(502, "")},
+		},
+		{
+			name:    "last attempt",
+			policy:  policy,
+			req:     get,
+			attempt: 3,
+			err:     &ErrorResponse{Response: resp(502, "")},
+		},
+		{
+			name:      "retry after seconds",
+			policy:    policy,
+			req:       get,
+			attempt:   1,
+			err:       &ErrorResponse{Response: resp(503, "7")},
+			wantDelay: 7 * time.Second,
+			wantRetry: true,
+		},
+		{
+			name:      "abuse retry after",
+			policy:    policy,
+			req:       get,
+			attempt:   1,
+			err:       &AbuseRateLimitError{RetryAfter: Ptr(5 * time.Second)},
+			wantDelay: 5 * time.Second,
+			wantRetry: true,
+		},
+		{
+			name:    "custom status codes",
+			policy:  &RetryPolicy{MaxAttempts: 3, RetryableStatusCodes: []int{500}},
+			req:     get,
+			attempt: 1,
+			err:     &ErrorResponse{Response: resp(502, "1")},
+		},
+		{
+			name:    "unreplayable body",
+			policy:  &RetryPolicy{MaxAttempts: 3, RetryNonIdempotent: true},
+			req:     &http.Request{Method: "POST", Body: io.NopCloser(strings.NewReader("x"))},
+			attempt: 1,
+			err:     &url.Error{Op: "Post", URL: "u", Err: syscall.ECONNRESET},
+		},
+		{
+			name:      "connection reset",
+			backoff:   true,
+			policy:    policy,
+			req:       get,
+			attempt:   1,
+			err:       &url.Error{Op: "Get", URL: "u", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}},
+			wantDelay: time.Second,
+			wantRetry: true,
+		},
+		{
+			name:      "unexpected EOF",
+			backoff:   true,
+			policy:    policy,
+			req:       get,
+			attempt:   1,
+			err:       &url.Error{Op: "Get", URL: "u", Err: io.ErrUnexpectedEOF},
+			wantDelay: time.Second,
+			wantRetry: true,
+		},
+		{
+			name:      "timeout",
+			backoff:   true,
+			policy:    policy,
+			req:       get,
+			attempt:   1,
+			err:       &url.Error{Op: "Get", URL: "u", Err: &net.DNSError{IsTimeout: true}},
+			wantDelay: time.Second,
+			wantRetry: true,
+		},
+		{
+			name:    "certificate error",
+			policy:  policy,
+			req:     get,
+			attempt: 1,
+			err:     &url.Error{Op: "Get", URL: "u", Err: &tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}},
+		},
+		{
+			name:    "malformed URL",
+			policy:  policy,
+			req:     get,
+			attempt: 1,
+			err:     &url.Error{Op: "parse", URL: "%", Err: url.EscapeError("%")},
+		},
+		{
+			name:    "other error",
+			policy:  policy,
+			req:     get,
+			attempt: 1,
+			err:     errors.New("boom"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			delay, retry := tt.policy.retryDelay(tt.req, tt.attempt, tt.err)
+			if retry != tt.wantRetry {
+				t.Errorf("retryDelay returned retry %v, want %v", retry, tt.wantRetry)
+			}
+			if tt.backoff && delay >= tt.wantDelay/2 && delay <= tt.wantDelay {
+				return
+			}
+			if delay != tt.wantDelay {
+				t.Errorf("retryDelay returned delay %v, want %v", delay, tt.wantDelay)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	t.Parallel()
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, want := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		4: 800 * time.Millisecond,
+		5: time.Second,
+		9: time.Second,
+	} {
+		for range 10 {
+			got := p.backoff(attempt)
+			if got < want/2 || got > want {
+				t.Errorf("backoff(%v) = %v, want between %v and %v", attempt, got, want/2, want)
+			}
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+	if _, ok := parseRetryAfter(""); ok {
+		t.Error("parseRetryAfter(\"\") returned ok")
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("parseRetryAfter(\"soon\") returned ok")
+	}
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Errorf("parseRetryAfter(\"3\") = %v, %v, want 3s, true", d, ok)
+	}
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(date); !ok || d <= 0 || d > time.Minute {
+		t.Errorf("parseRetryAfter(%q) = %v, %v, want (0, 1m], true", date, d, ok)
+	}
+}
@@ -32,6 +32,9 @@ import (
 // For example, querying with "language:c++" and "leveldb", then query should be
 // "language:c++ leveldb" but not "language:c+++leveldb".
 //
+// The search package of this module builds such query strings, escaping
+// their values, and parses existing ones.
+//
 // GitHub API docs: https://docs.github.com/rest/search/
 type SearchService service
 
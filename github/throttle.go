@@ -0,0 +1,232 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultThrottleMutationInterval = time.Second
+
+// Throttle paces the requests of a Client so that the rate limit budget is
+// spread evenly until it resets, instead of being exhausted in a burst.
+//
+// Before each request, the client waits so that the remaining requests of
+// the rate limit category of the endpoint (core, search, graphql, ...), as
+// reported by the most recent response, are evenly spaced until the rate
+// limit resets. No delay is added until a response in that category has been
+// received.
+//
+// Following GitHub's best practices to avoid secondary rate limits, the
+// Throttle also limits the number of concurrent requests and waits between
+// requests that modify resources. See
+// https://docs.github.com/rest/using-the-rest-api/best-practices-for-using-the-rest-api
+//
+// Requests with the BypassRateLimitCheck context value are not throttled.
+//
+// Use it by setting Client.Throttle:
+//
+//	client := github.NewClient(nil)
+//	client.Throttle = &github.Throttle{}
+//
+// A Throttle must not be copied or modified after first use. It may be
+// shared by clients using the same credentials.
+type Throttle struct {
+	// MaxConcurrent is the maximum number of requests in flight at once.
+	// Defaults to 1, since GitHub recommends making requests serially. A
+	// negative value removes the limit.
+	MaxConcurrent int
+
+	// MutationInterval is the minimum delay between the start of two
+	// requests with a POST, PATCH, PUT or DELETE method. Defaults to 1
+	// second, as GitHub recommends. A negative value removes the delay.
+	MutationInterval time.Duration
+
+	// MaxDelay, if positive, caps the delay added before a single request
+	// to pace the rate limit budget. It does not cap the wait for a
+	// concurrency slot or for MutationInterval.
+	MaxDelay time.Duration
+
+	once  sync.Once
+	slots chan struct{}
+
+	mu           sync.Mutex
+	last         [Categories]time.Time
+	nextMutation time.Time
+	stats        ThrottleStats
+}
+
+// ThrottleStats reports the activity of a Throttle.
+type ThrottleStats struct {
+	// InFlight is the number of requests currently being sent.
+	InFlight int
+	// Waiting is the number of requests currently delayed by the Throttle.
+	Waiting int
+	// Delayed is the number of requests that have been delayed.
+	Delayed int64
+	// TotalDelay is the total time requests have been delayed.
+	TotalDelay time.Duration
+}
+
+// Stats returns the current activity of the Throttle.
+func (t *Throttle) Stats() ThrottleStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stats
+}
+
+// RateBudget describes the remaining rate limit budget of a category.
+type RateBudget struct {
+	// Rate is the rate limit as reported by the most recent response in
+	// the category. It is the zero Rate if none was received yet.
+	Rate Rate
+
+	// Interval is the delay between requests that spreads the remaining
+	// requests evenly until the rate limit resets. It is zero if the rate
+	// limit is unknown or has already reset.
+	Interval time.Duration
+}
+
+// RateBudget returns the remaining rate limit budget of category, as
+// tracked from the most recent API responses. It does not make any request.
+func (c *Client) RateBudget(category RateLimitCategory) RateBudget {
+	c.rateMu.Lock()
+	rate := c.rateLimits[category]
+	c.rateMu.Unlock()
+	return RateBudget{Rate: rate, Interval: paceInterval(rate, time.Now())}
+}
+
+// paceInterval returns the delay between requests that spreads the remaining
+// requests of rate evenly until it resets.
+func paceInterval(rate Rate, now time.Time) time.Duration {
+	if rate.Remaining <= 0 || rate.Reset.Time.IsZero() || !rate.Reset.After(now) {
+		return 0
+	}
+	return rate.Reset.Sub(now) / time.Duration(rate.Remaining)
+}
+
+// wait blocks until req, in the given rate limit category of c, may be sent.
+// On success, the returned function must be called once the request has
+// completed. It may be called more than once.
+func (t *Throttle) wait(ctx context.Context, c *Client, req *http.Request, category RateLimitCategory) (func(), error) {
+	t.once.Do(func() {
+		n := t.MaxConcurrent
+		if n == 0 {
+			n = 1
+		}
+		if n > 0 {
+			t.slots = make(chan struct{}, n)
+		}
+	})
+
+	start := time.Now()
+	t.mu.Lock()
+	t.stats.Waiting++
+	t.mu.Unlock()
+
+	delayed := false
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		default:
+			delayed = true
+			select {
+			case t.slots <- struct{}{}:
+			case <-ctx.Done():
+				t.done(start, delayed, false)
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	// The rate is read once a slot is acquired, so that it reflects the
+	// response to the previous request.
+	c.rateMu.Lock()
+	rate := c.rateLimits[category]
+	c.rateMu.Unlock()
+	if d := t.reserve(req, category, rate); d > 0 {
+		delayed = true
+		if err := sleepWithContext(ctx, d); err != nil {
+			t.release()
+			t.done(start, delayed, false)
+			return nil, err
+		}
+	}
+	t.done(start, delayed, true)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.mu.Lock()
+			t.stats.InFlight--
+			t.mu.Unlock()
+			t.release()
+		})
+	}, nil
+}
+
+// reserve reserves the next time slot for req and returns how long to wait
+// for it.
+func (t *Throttle) reserve(req *http.Request, category RateLimitCategory, rate Rate) time.Duration {
+	now := time.Now()
+	interval := paceInterval(rate, now)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	at := now
+	if next := t.last[category].Add(interval); next.After(at) {
+		at = next
+	}
+	if t.MaxDelay > 0 && at.Sub(now) > t.MaxDelay {
+		at = now.Add(t.MaxDelay)
+	}
+
+	mutationInterval := t.MutationInterval
+	if mutationInterval == 0 {
+		mutationInterval = defaultThrottleMutationInterval
+	}
+	if mutationInterval > 0 && isMutation(req) {
+		if t.nextMutation.After(at) {
+			at = t.nextMutation
+		}
+		t.nextMutation = at.Add(mutationInterval)
+	}
+	t.last[category] = at
+	return at.Sub(now)
+}
+
+// release frees the concurrency slot held by a request.
+func (t *Throttle) release() {
+	if t.slots != nil {
+		<-t.slots
+	}
+}
+
+// done updates the stats once a request stopped waiting since start.
+func (t *Throttle) done(start time.Time, delayed, sent bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stats.Waiting--
+	if sent {
+		t.stats.InFlight++
+	}
+	if delayed {
+		t.stats.Delayed++
+		t.stats.TotalDelay += time.Since(start)
+	}
+}
+
+// isMutation reports whether req modifies resources.
+func isMutation(req *http.Request) bool {
+	switch req.Method {
+	case "POST", "PATCH", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setRateHeaders sets the rate limit headers of a response with remaining
+// requests until reset.
+func setRateHeaders(w http.ResponseWriter, remaining int, reset time.Time) {
+	w.Header().Set(headerRateLimit, "5000")
+	w.Header().Set(headerRateRemaining, strconv.Itoa(remaining))
+	w.Header().Set(headerRateReset, strconv.FormatInt(reset.Unix(), 10))
+}
+
+func TestThrottle_pacing(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.Throttle = &Throttle{}
+
+	reset := time.Now().Add(2 * time.Second)
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		setRateHeaders(w, 20, reset)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := t.Context()
+	start := time.Now()
+	for range 3 {
+		if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+			t.Fatalf("Repositories.Get returned error: %v", err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	// The 20 remaining requests are spread over at least a second, so each
+	// request after the first waits at least 50ms.
+	if elapsed < 100*time.Millisecond {
+		t.Errorf("3 requests took %v, want them paced", elapsed)
+	}
+	stats := client.Throttle.Stats()
+	if stats.Delayed != 2 || stats.TotalDelay <= 0 || stats.InFlight != 0 || stats.Waiting != 0 {
+		t.Errorf("Stats = %+v, want 2 delayed requests", stats)
+	}
+
+	budget := client.RateBudget(CoreCategory)
+	if budget.Rate.Remaining != 20 || budget.Interval <= 0 || budget.Interval > reset.Sub(start)/20 {
+		t.Errorf("RateBudget(CoreCategory) = %+v", budget)
+	}
+	if budget := client.RateBudget(SearchCategory); budget != (RateBudget{}) {
+		t.Errorf("RateBudget(SearchCategory) = %+v, want zero", budget)
+	}
+}
+
+func TestThrottle_categories(t *testing.T) {
+	t.Parallel()
+	// The rate limit category is determined from the path, so the API is
+	// served at the root of the server.
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	client.Throttle = &Throttle{}
+
+	reset := time.Now().Add(time.Hour)
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		setRateHeaders(w, 1, reset)
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/search/repositories", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := t.Context()
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+
+	// The core budget would only allow the next core request in an hour,
+	// but search requests are paced separately.
+	start := time.Now()
+	if _, _, err := client.Search.Repositories(ctx, "q", nil); err != nil {
+		t.Fatalf("Search.Repositories returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Search.Repositories took %v, want it not delayed", elapsed)
+	}
+
+	// Requests that bypass the rate limit check are not throttled.
+	bypass := context.WithValue(ctx, BypassRateLimitCheck, true)
+	if _, _, err := client.Repositories.Get(bypass, "o", "r"); err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, _, err := client.Repositories.Get(timeout, "o", "r")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Repositories.Get returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if stats := client.Throttle.Stats(); stats.InFlight != 0 || stats.Waiting != 0 {
+		t.Errorf("Stats = %+v, want no request in flight or waiting", stats)
+	}
+}
+
+func TestThrottle_maxDelay(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.Throttle = &Throttle{MaxDelay: time.Millisecond}
+
+	reset := time.Now().Add(time.Hour)
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		setRateHeaders(w, 1, reset)
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := t.Context()
+	start := time.Now()
+	for range 3 {
+		if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+			t.Fatalf("Repositories.Get returned error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("3 requests took %v, want delays capped by MaxDelay", elapsed)
+	}
+}
+
+func TestThrottle_maxConcurrent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		maxConcurrent int
+		want          int32
+	}{
+		{0, 1},
+		{2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("MaxConcurrent=%v", tt.maxConcurrent), func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+			client.Throttle = &Throttle{MaxConcurrent: tt.maxConcurrent}
+
+			var inFlight, peak atomic.Int32
+			mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+				n := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				fmt.Fprint(w, `{}`)
+			})
+
+			ctx := t.Context()
+			var wg sync.WaitGroup
+			for range 6 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+						t.Errorf("Repositories.Get returned error: %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got := peak.Load(); got != tt.want {
+				t.Errorf("peak concurrent requests = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestThrottle_mutationInterval(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	client.Throttle = &Throttle{MutationInterval: 50 * time.Millisecond}
+
+	var mu sync.Mutex
+	var times []time.Time
+	mux.HandleFunc("/repos/o/r/issues", func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		times = append(times, time.Now())
+		mu.Unlock()
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/repos/o/r", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx := t.Context()
+	for range 3 {
+		if _, _, err := client.Issues.Create(ctx, "o", "r", &IssueRequest{}); err != nil {
+			t.Fatalf("Issues.Create returned error: %v", err)
+		}
+	}
+	for i := 1; i < len(times); i++ {
+		// Allow for the imprecision of timers.
+		if d := times[i].Sub(times[i-1]); d < 40*time.Millisecond {
+			t.Errorf("mutation %v was sent %v after the previous one, want at least 50ms", i, d)
+		}
+	}
+
+	// Reads are not delayed by the mutation interval.
+	start := time.Now()
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 40*time.Millisecond {
+		t.Errorf("Repositories.Get took %v, want it not delayed", elapsed)
+	}
+}
+
+func TestPaceInterval(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	tests := []struct {
+		rate Rate
+		want time.Duration
+	}{
+		{Rate{}, 0},
+		{Rate{Remaining: 10, Reset: Timestamp{now.Add(-time.Second)}}, 0},
+		{Rate{Remaining: 0, Reset: Timestamp{now.Add(time.Hour)}}, 0},
+		{Rate{Remaining: 10, Reset: Timestamp{now.Add(time.Second)}}, 100 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		if got := paceInterval(tt.rate, now); got != tt.want {
+			t.Errorf("paceInterval(%v) = %v, want %v", tt.rate, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+/*
+TokenPoolTransport is an http.RoundTripper that spreads requests across
+several credentials, such as personal access tokens or installations of a
+GitHub App, to combine their rate limits.
+
+The transport tracks the rate limit of each credential in each
+RateLimitCategory from the responses it receives, and authenticates each
+request with the credential that has the most remaining requests in the
+category of the endpoint. Credentials whose rate limit is not known yet are
+preferred, so that all of them get used.
+
+When the rate limit of every credential is exhausted, the request is sent
+with the credential whose rate limit resets first, and fails with a
+*RateLimitError. If WaitForReset is true, the transport waits for that reset
+instead.
+
+	pool := github.NewTokenPoolTransport(token1, token2, token3)
+	client := github.NewClient(pool.Client())
+
+Other credentials can be added to the pool as RoundTrippers that
+authenticate requests:
+
+	pool.Credentials = append(pool.Credentials, installationTransport)
+
+Since each response reports the rate limit of the credential it was made
+with, the rate limits tracked by the Client only describe the last
+credential used, and the Client refuses to send requests once that one is
+exhausted. Set Client.DisableRateLimitCheck to leave rate limit handling to
+the pool:
+
+	client.DisableRateLimitCheck = true
+*/
+type TokenPoolTransport struct {
+	// Credentials are the RoundTrippers used to send requests, each of them
+	// authenticating requests with a different credential.
+	// NewTokenPoolTransport adds one for each token. Credentials must not be
+	// modified after first use.
+	Credentials []http.RoundTripper
+
+	// Transport is the underlying HTTP transport used by the credentials
+	// created by NewTokenPoolTransport. It will default to
+	// http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	// WaitForReset makes the transport wait until a rate limit resets when
+	// the rate limits of all credentials are exhausted, instead of sending
+	// the request anyway.
+	WaitForReset bool
+
+	mu    sync.Mutex
+	rates [][Categories]Rate
+}
+
+// NewTokenPoolTransport returns a TokenPoolTransport that authenticates
+// requests with the given OAuth or personal access tokens.
+func NewTokenPoolTransport(tokens ...string) *TokenPoolTransport {
+	t := &TokenPoolTransport{}
+	for _, token := range tokens {
+		t.Credentials = append(t.Credentials, &poolToken{pool: t, token: token})
+	}
+	return t
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *TokenPoolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.Credentials) == 0 {
+		return nil, errors.New("github: TokenPoolTransport has no credentials")
+	}
+	category := GetRateLimitCategory(req.Method, req.URL.Path)
+
+	var i int
+	for {
+		var reset time.Time
+		i, reset = t.pick(category)
+		if reset.IsZero() || !t.WaitForReset {
+			break
+		}
+		if err := sleepUntilResetWithBuffer(req.Context(), reset); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.Credentials[i].RoundTrip(req)
+	if resp != nil {
+		if rate := parseRate(resp); !rate.Reset.IsZero() {
+			// GraphQL requests are always reported against the graphql
+			// resource, wherever they are sent, as in Client.bareDo.
+			if rate.Resource == "graphql" {
+				category = GraphqlCategory
+			}
+			t.mu.Lock()
+			t.rates[i][category] = rate
+			t.mu.Unlock()
+		}
+	}
+	return resp, err
+}
+
+// pick returns the index of the credential with the most remaining requests
+// in category, and reserves one of them. If the rate limits of all
+// credentials are exhausted, it returns the credential whose rate limit
+// resets first along with the time of that reset.
+func (t *TokenPoolTransport) pick(category RateLimitCategory) (int, time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.rates) < len(t.Credentials) {
+		t.rates = append(t.rates, make([][Categories]Rate, len(t.Credentials)-len(t.rates))...)
+	}
+
+	now := time.Now()
+	best, bestRemaining := 0, -1
+	first, firstReset := 0, time.Time{}
+	for i := range t.Credentials {
+		rate := t.rates[i][category]
+		remaining := rate.Remaining
+		if rate.Reset.IsZero() || !rate.Reset.After(now) {
+			// The rate limit is unknown or has been reset.
+			remaining = math.MaxInt
+		}
+		if remaining > bestRemaining {
+			best, bestRemaining = i, remaining
+		}
+		if remaining == 0 && (firstReset.IsZero() || rate.Reset.Before(firstReset)) {
+			first, firstReset = i, rate.Reset.Time
+		}
+	}
+
+	if bestRemaining == 0 {
+		return first, firstReset
+	}
+	if bestRemaining != math.MaxInt {
+		// Count the request right away, so that concurrent requests are
+		// spread across credentials.
+		t.rates[best][category].Remaining--
+	}
+	return best, time.Time{}
+}
+
+// Rates returns the rate limit of each credential in category, as tracked
+// from the most recent responses, in the order of Credentials. The rate of
+// a credential is the zero Rate until a response is received with it.
+func (t *TokenPoolTransport) Rates(category RateLimitCategory) []Rate {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rates := make([]Rate, len(t.Credentials))
+	for i := range rates {
+		if i < len(t.rates) {
+			rates[i] = t.rates[i][category]
+		}
+	}
+	return rates
+}
+
+// Client returns an *http.Client that makes requests which are authenticated
+// with the credentials of the pool.
+func (t *TokenPoolTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *TokenPoolTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// poolToken authenticates requests with a token, using the transport of its
+// pool.
+type poolToken struct {
+	pool  *TokenPoolTransport
+	token string
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (p *poolToken) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("Authorization", "Bearer "+p.token)
+	return p.pool.transport().RoundTrip(req2)
+}
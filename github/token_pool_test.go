@@ -0,0 +1,187 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// poolServer is a test server with a rate limit per token.
+type poolServer struct {
+	mu        sync.Mutex
+	remaining map[string]int
+	reset     time.Time
+	tokens    []string // Tokens of the received requests, in order.
+}
+
+func (s *poolServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.tokens = append(s.tokens, token)
+
+	resource := "core"
+	if strings.HasPrefix(r.URL.Path, "/search/") {
+		resource = "search"
+	}
+	key := token + "/" + resource
+	remaining, ok := s.remaining[key]
+	if !ok {
+		remaining = 5
+	}
+	w.Header().Set(headerRateLimit, "5")
+	w.Header().Set(headerRateReset, fmt.Sprint(s.reset.Unix()))
+	w.Header().Set(headerRateResource, resource)
+	if remaining == 0 {
+		w.Header().Set(headerRateRemaining, "0")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"API rate limit exceeded"}`)
+		return
+	}
+	s.remaining[key] = remaining - 1
+	w.Header().Set(headerRateRemaining, fmt.Sprint(remaining-1))
+	fmt.Fprint(w, `{}`)
+}
+
+// newPoolClient returns a Client using pool to send requests to a new
+// poolServer, with rate limits that reset at reset.
+func newPoolClient(t *testing.T, pool *TokenPoolTransport, reset time.Time) (*Client, *poolServer) {
+	t.Helper()
+	s := &poolServer{remaining: make(map[string]int), reset: reset}
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+
+	client := NewClient(pool.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	return client, s
+}
+
+func TestTokenPoolTransport(t *testing.T) {
+	t.Parallel()
+	pool := NewTokenPoolTransport("a", "b")
+	client, s := newPoolClient(t, pool, time.Now().Add(time.Hour))
+	s.remaining["a/core"] = 4
+
+	ctx := t.Context()
+	for range 5 {
+		if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+			t.Fatalf("Repositories.Get returned error: %v", err)
+		}
+	}
+	// Both tokens are tried first, then the one with the most remaining
+	// requests is used.
+	if want := []string{"a", "b", "b", "a", "b"}; !cmp.Equal(s.tokens, want) {
+		t.Errorf("requests used tokens %v, want %v", s.tokens, want)
+	}
+
+	var remaining []int
+	for _, r := range pool.Rates(CoreCategory) {
+		remaining = append(remaining, r.Remaining)
+	}
+	if want := []int{2, 2}; !cmp.Equal(remaining, want) {
+		t.Errorf("Rates(CoreCategory) remaining = %v, want %v", remaining, want)
+	}
+	if rates := pool.Rates(SearchCategory); rates[0] != (Rate{}) || rates[1] != (Rate{}) {
+		t.Errorf("Rates(SearchCategory) = %v, want unknown rates", rates)
+	}
+
+	// Search requests are tracked separately.
+	s.tokens = nil
+	if _, _, err := client.Search.Repositories(ctx, "q", nil); err != nil {
+		t.Fatalf("Search.Repositories returned error: %v", err)
+	}
+	if want := []string{"a"}; !cmp.Equal(s.tokens, want) {
+		t.Errorf("search request used tokens %v, want %v", s.tokens, want)
+	}
+}
+
+func TestTokenPoolTransport_exhausted(t *testing.T) {
+	t.Parallel()
+	pool := NewTokenPoolTransport("a", "b")
+	client, s := newPoolClient(t, pool, time.Now().Add(time.Hour))
+	s.remaining["a/core"] = 1
+	s.remaining["b/core"] = 1
+	// The client would refuse to send requests once it sees a rate limit
+	// of zero.
+	client.DisableRateLimitCheck = true
+
+	ctx := t.Context()
+	for range 2 {
+		if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+			t.Fatalf("Repositories.Get returned error: %v", err)
+		}
+	}
+	_, _, err := client.Repositories.Get(ctx, "o", "r")
+	var rateErr *RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Errorf("Repositories.Get returned error %v, want *RateLimitError", err)
+	}
+	if len(s.tokens) != 3 {
+		t.Errorf("server received %v requests, want 3", len(s.tokens))
+	}
+
+	pool.WaitForReset = true
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, _, err = client.Repositories.Get(ctx, "o", "r")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Repositories.Get returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if len(s.tokens) != 3 {
+		t.Errorf("server received %v requests, want no request while waiting", len(s.tokens))
+	}
+}
+
+func TestTokenPoolTransport_waitForReset(t *testing.T) {
+	t.Parallel()
+	pool := NewTokenPoolTransport("a")
+	pool.WaitForReset = true
+	client, s := newPoolClient(t, pool, time.Now())
+	client.DisableRateLimitCheck = true
+
+	// The rate limit is exhausted, but resets immediately.
+	pool.pick(CoreCategory)
+	pool.rates[0][CoreCategory] = Rate{Limit: 5, Reset: Timestamp{time.Now().Add(10 * time.Millisecond)}}
+
+	if _, _, err := client.Repositories.Get(t.Context(), "o", "r"); err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if want := []string{"a"}; !cmp.Equal(s.tokens, want) {
+		t.Errorf("requests used tokens %v, want %v", s.tokens, want)
+	}
+}
+
+func TestTokenPoolTransport_credentials(t *testing.T) {
+	t.Parallel()
+	pool := &TokenPoolTransport{}
+	if _, err := pool.RoundTrip(&http.Request{Method: "GET", URL: &url.URL{Path: "/"}}); err == nil {
+		t.Error("RoundTrip with no credentials returned nil error")
+	}
+
+	var got string
+	pool.Credentials = append(pool.Credentials, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.URL.Path
+		return &http.Response{StatusCode: http.StatusNoContent, Header: make(http.Header), Body: http.NoBody, Request: req}, nil
+	}))
+	client := NewClient(pool.Client())
+	if _, err := client.Repositories.Delete(t.Context(), "o", "r"); err != nil {
+		t.Fatalf("Repositories.Delete returned error: %v", err)
+	}
+	if got != "/repos/o/r" {
+		t.Errorf("credential received request for %v, want /repos/o/r", got)
+	}
+}
@@ -0,0 +1,348 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultWaitInterval    = 5 * time.Second
+	defaultWaitMaxInterval = time.Minute
+)
+
+// WaitOutcome is the outcome of waiting for a workflow run, check runs, a
+// deployment or a SARIF upload to complete.
+type WaitOutcome string
+
+const (
+	// WaitSucceeded means that the awaited resource completed successfully.
+	WaitSucceeded WaitOutcome = "success"
+
+	// WaitFailed means that the awaited resource completed unsuccessfully.
+	WaitFailed WaitOutcome = "failure"
+
+	// WaitTimedOut means that the awaited resource did not complete before
+	// WaitOptions.Timeout elapsed.
+	WaitTimedOut WaitOutcome = "timed_out"
+)
+
+// WaitOptions specifies how the wait helpers, such as
+// ActionsService.WaitForWorkflowRun, poll the API.
+//
+// The helpers poll every Interval while the status of the awaited resource
+// changes, and back off exponentially up to MaxInterval while it does not.
+// They also poll less often when the rate limit is running low, so as to
+// spread the remaining requests until it resets, and wait for rate limits to
+// reset when they are exceeded.
+type WaitOptions struct {
+	// Interval is the initial interval between polls. Defaults to 5s.
+	Interval time.Duration
+
+	// MaxInterval is the maximum interval between polls. Defaults to 1m.
+	MaxInterval time.Duration
+
+	// Timeout is the maximum time to wait for. When it elapses, the helpers
+	// return the WaitTimedOut outcome rather than an error. If zero, the
+	// helpers wait until ctx is done.
+	Timeout time.Duration
+}
+
+// WaitForWorkflowRunOptions specifies parameters to
+// ActionsService.WaitForWorkflowRun.
+type WaitForWorkflowRunOptions struct {
+	WaitOptions
+
+	// OnChange, if set, is called with the run whenever its status or
+	// conclusion changes, including for the first poll.
+	OnChange func(*WorkflowRun)
+}
+
+// WaitForChecksOptions specifies parameters to ChecksService.WaitForChecks.
+type WaitForChecksOptions struct {
+	WaitOptions
+
+	// Filter selects the check runs to wait for. Its ListOptions are
+	// ignored, since all the check runs are listed.
+	Filter ListCheckRunsOptions
+
+	// FailFast makes WaitForChecks return as soon as a check run completes
+	// unsuccessfully, rather than waiting for all of them.
+	FailFast bool
+
+	// OnChange, if set, is called with a check run whenever its status or
+	// conclusion changes, including when it is first seen.
+	OnChange func(*CheckRun)
+}
+
+// WaitForDeploymentOptions specifies parameters to
+// RepositoriesService.WaitForDeployment.
+type WaitForDeploymentOptions struct {
+	WaitOptions
+
+	// OnChange, if set, is called with the latest status of the deployment
+	// whenever it changes.
+	OnChange func(*DeploymentStatus)
+}
+
+// WaitForSARIFOptions specifies parameters to
+// CodeScanningService.WaitForSARIF.
+type WaitForSARIFOptions struct {
+	WaitOptions
+
+	// OnChange, if set, is called with the upload whenever its processing
+	// status changes, including for the first poll.
+	OnChange func(*SARIFUpload)
+}
+
+// isSuccessfulConclusion reports whether the conclusion of a completed
+// workflow run or check run is successful.
+func isSuccessfulConclusion(conclusion string) bool {
+	switch conclusion {
+	case "success", "neutral", "skipped":
+		return true
+	}
+	return false
+}
+
+// WaitForWorkflowRun polls a workflow run until it completes, and returns it
+// along with whether it succeeded. Runs whose conclusion is "success",
+// "neutral" or "skipped" are successful.
+//
+// GitHub API docs: https://docs.github.com/rest/actions/workflow-runs#get-a-workflow-run
+//
+//meta:operation GET /repos/{owner}/{repo}/actions/runs/{run_id}
+func (s *ActionsService) WaitForWorkflowRun(ctx context.Context, owner, repo string, runID int64, opts *WaitForWorkflowRunOptions) (*WorkflowRun, WaitOutcome, error) {
+	if opts == nil {
+		opts = &WaitForWorkflowRunOptions{}
+	}
+	var run *WorkflowRun
+	var status string
+	outcome, err := s.client.waitUntil(ctx, &opts.WaitOptions, func(ctx context.Context) (WaitOutcome, bool, *Response, error) {
+		r, resp, err := s.GetWorkflowRunByID(ctx, owner, repo, runID)
+		if err != nil {
+			return "", false, resp, err
+		}
+		run = r
+
+		changed := r.GetStatus()+"/"+r.GetConclusion() != status
+		status = r.GetStatus() + "/" + r.GetConclusion()
+		if changed && opts.OnChange != nil {
+			opts.OnChange(r)
+		}
+		if r.GetStatus() != "completed" {
+			return "", changed, resp, nil
+		}
+		if isSuccessfulConclusion(r.GetConclusion()) {
+			return WaitSucceeded, changed, resp, nil
+		}
+		return WaitFailed, changed, resp, nil
+	})
+	return run, outcome, err
+}
+
+// WaitForChecks polls the check runs of a ref, as selected by opts.Filter,
+// until they all complete, and returns them along with whether they all
+// succeeded. Check runs whose conclusion is "success", "neutral" or "skipped"
+// are successful. If no check run matches, WaitForChecks waits until one
+// does.
+//
+// GitHub API docs: https://docs.github.com/rest/checks/runs#list-check-runs-for-a-git-reference
+//
+//meta:operation GET /repos/{owner}/{repo}/commits/{ref}/check-runs
+func (s *ChecksService) WaitForChecks(ctx context.Context, owner, repo, ref string, opts *WaitForChecksOptions) ([]*CheckRun, WaitOutcome, error) {
+	if opts == nil {
+		opts = &WaitForChecksOptions{}
+	}
+	var runs []*CheckRun
+	statuses := make(map[int64]string)
+	outcome, err := s.client.waitUntil(ctx, &opts.WaitOptions, func(ctx context.Context) (WaitOutcome, bool, *Response, error) {
+		filter := opts.Filter
+		filter.ListOptions = ListOptions{PerPage: 100}
+		var all []*CheckRun
+		var resp *Response
+		for {
+			var results *ListCheckRunsResults
+			var err error
+			results, resp, err = s.ListCheckRunsForRef(ctx, owner, repo, ref, &filter)
+			if err != nil {
+				return "", false, resp, err
+			}
+			all = append(all, results.CheckRuns...)
+			if resp.NextPage == 0 {
+				break
+			}
+			filter.Page = resp.NextPage
+		}
+		runs = all
+
+		var changed, pending, failed bool
+		for _, r := range all {
+			status := r.GetStatus() + "/" + r.GetConclusion()
+			if statuses[r.GetID()] != status {
+				statuses[r.GetID()] = status
+				changed = true
+				if opts.OnChange != nil {
+					opts.OnChange(r)
+				}
+			}
+			switch {
+			case r.GetStatus() != "completed":
+				pending = true
+			case !isSuccessfulConclusion(r.GetConclusion()):
+				failed = true
+			}
+		}
+		switch {
+		case failed && (opts.FailFast || !pending):
+			return WaitFailed, changed, resp, nil
+		case pending || len(all) == 0:
+			return "", changed, resp, nil
+		default:
+			return WaitSucceeded, changed, resp, nil
+		}
+	})
+	return runs, outcome, err
+}
+
+// WaitForDeployment polls the statuses of a deployment until its latest
+// status is final, and returns that status along with whether it succeeded.
+// The "success" state is successful, while the "failure", "error" and
+// "inactive" states are not.
+//
+// GitHub API docs: https://docs.github.com/rest/deployments/statuses#list-deployment-statuses
+//
+//meta:operation GET /repos/{owner}/{repo}/deployments/{deployment_id}/statuses
+func (s *RepositoriesService) WaitForDeployment(ctx context.Context, owner, repo string, deploymentID int64, opts *WaitForDeploymentOptions) (*DeploymentStatus, WaitOutcome, error) {
+	if opts == nil {
+		opts = &WaitForDeploymentOptions{}
+	}
+	var latest *DeploymentStatus
+	outcome, err := s.client.waitUntil(ctx, &opts.WaitOptions, func(ctx context.Context) (WaitOutcome, bool, *Response, error) {
+		// Deployment statuses are listed from the most recent.
+		statuses, resp, err := s.ListDeploymentStatuses(ctx, owner, repo, deploymentID, &ListOptions{PerPage: 1})
+		if err != nil || len(statuses) == 0 {
+			return "", false, resp, err
+		}
+
+		changed := latest == nil || latest.GetID() != statuses[0].GetID()
+		latest = statuses[0]
+		if changed && opts.OnChange != nil {
+			opts.OnChange(latest)
+		}
+		switch latest.GetState() {
+		case "success":
+			return WaitSucceeded, changed, resp, nil
+		case "failure", "error", "inactive":
+			return WaitFailed, changed, resp, nil
+		default:
+			return "", changed, resp, nil
+		}
+	})
+	return latest, outcome, err
+}
+
+// WaitForSARIF polls a SARIF upload, as returned by UploadSarif, until it is
+// processed, and returns it along with whether it succeeded. Uploads whose
+// processing status is "complete" are successful, while those whose status is
+// "failed" are not, and report why in their Errors.
+//
+// Since an upload may not be found until GitHub starts processing it, it is
+// considered pending while GetSARIF responds with 404 Not Found.
+//
+// GitHub API docs: https://docs.github.com/rest/code-scanning/code-scanning#get-information-about-a-sarif-upload
+//
+//meta:operation GET /repos/{owner}/{repo}/code-scanning/sarifs/{sarif_id}
+func (s *CodeScanningService) WaitForSARIF(ctx context.Context, owner, repo, sarifID string, opts *WaitForSARIFOptions) (*SARIFUpload, WaitOutcome, error) {
+	if opts == nil {
+		opts = &WaitForSARIFOptions{}
+	}
+	var upload *SARIFUpload
+	outcome, err := s.client.waitUntil(ctx, &opts.WaitOptions, func(ctx context.Context) (WaitOutcome, bool, *Response, error) {
+		u, resp, err := s.GetSARIF(ctx, owner, repo, sarifID)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", false, resp, nil
+		}
+		if err != nil {
+			return "", false, resp, err
+		}
+
+		changed := upload == nil || upload.GetProcessingStatus() != u.GetProcessingStatus()
+		upload = u
+		if changed && opts.OnChange != nil {
+			opts.OnChange(u)
+		}
+		switch u.GetProcessingStatus() {
+		case "complete":
+			return WaitSucceeded, changed, resp, nil
+		case "failed":
+			return WaitFailed, changed, resp, nil
+		default:
+			return "", changed, resp, nil
+		}
+	})
+	return upload, outcome, err
+}
+
+// waitUntil calls poll until it returns an outcome, backing off between
+// calls as described by WaitOptions. poll also reports whether the awaited
+// resource changed, which resets the backoff.
+func (c *Client) waitUntil(ctx context.Context, opts *WaitOptions, poll func(context.Context) (WaitOutcome, bool, *Response, error)) (WaitOutcome, error) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+	maxInterval := max(opts.MaxInterval, interval)
+	if opts.MaxInterval <= 0 {
+		maxInterval = max(defaultWaitMaxInterval, interval)
+	}
+	pollCtx := ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		pollCtx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	timedOut := func(err error) (WaitOutcome, error) {
+		if ctx.Err() == nil && pollCtx.Err() != nil {
+			return WaitTimedOut, nil
+		}
+		return "", err
+	}
+
+	delay := interval
+	for {
+		outcome, changed, resp, err := poll(pollCtx)
+		next := delay
+		var rateErr *RateLimitError
+		var abuseErr *AbuseRateLimitError
+		switch {
+		case errors.As(err, &rateErr):
+			next = time.Until(rateErr.Rate.Reset.Time) + time.Second
+		case errors.As(err, &abuseErr) && abuseErr.RetryAfter != nil:
+			next = *abuseErr.RetryAfter
+		case err != nil:
+			return timedOut(err)
+		case outcome != "":
+			return outcome, nil
+		case changed:
+			delay = interval
+			next = delay
+		default:
+			delay = min(2*delay, maxInterval)
+			next = delay
+		}
+		if resp != nil {
+			next = max(next, paceInterval(resp.Rate, time.Now()))
+		}
+
+		if err := sleepWithContext(pollCtx, next); err != nil {
+			return timedOut(err)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fastWait polls without delay, so that tests run quickly.
+var fastWait = WaitOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+func TestActionsService_WaitForWorkflowRun(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	responses := []string{
+		`{"id":1,"status":"queued"}`,
+		`{"id":1,"status":"in_progress"}`,
+		`{"id":1,"status":"in_progress"}`,
+		`{"id":1,"status":"completed","conclusion":"failure"}`,
+	}
+	var calls atomic.Int32
+	mux.HandleFunc("/repos/o/r/actions/runs/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, responses[calls.Add(1)-1])
+	})
+
+	var changes []string
+	opts := &WaitForWorkflowRunOptions{
+		WaitOptions: fastWait,
+		OnChange:    func(r *WorkflowRun) { changes = append(changes, r.GetStatus()) },
+	}
+	run, outcome, err := client.Actions.WaitForWorkflowRun(t.Context(), "o", "r", 1, opts)
+	if err != nil {
+		t.Fatalf("Actions.WaitForWorkflowRun returned error: %v", err)
+	}
+	if outcome != WaitFailed || run.GetConclusion() != "failure" {
+		t.Errorf("Actions.WaitForWorkflowRun returned %v, %v, want failed run", run, outcome)
+	}
+	if want := []string{"queued", "in_progress", "completed"}; !cmp.Equal(changes, want) {
+		t.Errorf("OnChange was called with %v, want %v", changes, want)
+	}
+}
+
+func TestActionsService_WaitForWorkflowRun_timeout(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	mux.HandleFunc("/repos/o/r/actions/runs/1", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"id":1,"status":"in_progress"}`)
+	})
+
+	ctx := t.Context()
+	opts := &WaitForWorkflowRunOptions{WaitOptions: fastWait}
+	opts.Timeout = 20 * time.Millisecond
+	run, outcome, err := client.Actions.WaitForWorkflowRun(ctx, "o", "r", 1, opts)
+	if err != nil {
+		t.Fatalf("Actions.WaitForWorkflowRun returned error: %v", err)
+	}
+	if outcome != WaitTimedOut || run.GetStatus() != "in_progress" {
+		t.Errorf("Actions.WaitForWorkflowRun returned %v, %v, want timed out run", run, outcome)
+	}
+
+	// Canceling ctx is an error rather than a timeout.
+	cancelCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, _, err = client.Actions.WaitForWorkflowRun(cancelCtx, "o", "r", 1, &WaitForWorkflowRunOptions{WaitOptions: fastWait})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Actions.WaitForWorkflowRun returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestActionsService_WaitForWorkflowRun_error(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+	var calls atomic.Int32
+	mux.HandleFunc("/repos/o/r/actions/runs/1", func(w http.ResponseWriter, _ *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			// Secondary rate limits are waited for.
+			w.Header().Set(headerRetryAfter, "0")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You have exceeded a secondary rate limit","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`)
+		case 2:
+			fmt.Fprint(w, `{"id":1,"status":"completed","conclusion":"skipped"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := t.Context()
+	_, outcome, err := client.Actions.WaitForWorkflowRun(ctx, "o", "r", 1, &WaitForWorkflowRunOptions{WaitOptions: fastWait})
+	if err != nil || outcome != WaitSucceeded {
+		t.Errorf("Actions.WaitForWorkflowRun returned %v, %v, want success", outcome, err)
+	}
+
+	// Other errors are returned.
+	_, _, err = client.Actions.WaitForWorkflowRun(ctx, "o", "r", 1, &WaitForWorkflowRunOptions{WaitOptions: fastWait})
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Actions.WaitForWorkflowRun returned error %v, want *ErrorResponse", err)
+	}
+}
+
+func TestChecksService_WaitForChecks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		failFast bool
+		polls    []string
+		want     WaitOutcome
+		changes  int
+	}{
+		{
+			name: "success",
+			polls: []string{
+				`[]`,
+				`[{"id":1,"status":"in_progress"},{"id":2,"status":"queued"}]`,
+				`[{"id":1,"status":"completed","conclusion":"success"},{"id":2,"status":"completed","conclusion":"neutral"}]`,
+			},
+			want:    WaitSucceeded,
+			changes: 4,
+		},
+		{
+			name: "failure",
+			polls: []string{
+				`[{"id":1,"status":"completed","conclusion":"failure"},{"id":2,"status":"queued"}]`,
+				`[{"id":1,"status":"completed","conclusion":"failure"},{"id":2,"status":"completed","conclusion":"success"}]`,
+			},
+			want:    WaitFailed,
+			changes: 3,
+		},
+		{
+			name:     "fail fast",
+			failFast: true,
+			polls: []string{
+				`[{"id":1,"status":"completed","conclusion":"failure"},{"id":2,"status":"queued"}]`,
+			},
+			want:    WaitFailed,
+			changes: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, mux, _ := setup(t)
+			var calls atomic.Int32
+			mux.HandleFunc("/repos/o/r/commits/main/check-runs", func(w http.ResponseWriter, r *http.Request) {
+				testMethod(t, r, "GET")
+				testFormValues(t, r, values{"check_name": "ci", "per_page": "100"})
+				fmt.Fprintf(w, `{"check_runs":%v}`, tt.polls[calls.Add(1)-1])
+			})
+
+			var changes int
+			opts := &WaitForChecksOptions{
+				WaitOptions: fastWait,
+				Filter:      ListCheckRunsOptions{CheckName: Ptr("ci")},
+				FailFast:    tt.failFast,
+				OnChange:    func(*CheckRun) { changes++ },
+			}
+			runs, outcome, err := client.Checks.WaitForChecks(t.Context(), "o", "r", "main", opts)
+			if err != nil {
+				t.Fatalf("Checks.WaitForChecks returned error: %v", err)
+			}
+			if outcome != tt.want || len(runs) != 2 {
+				t.Errorf("Checks.WaitForChecks returned %v runs, %v, want 2 runs, %v", len(runs), outcome, tt.want)
+			}
+			if changes != tt.changes {
+				t.Errorf("OnChange was called %v times, want %v", changes, tt.changes)
+			}
+			if got := int(calls.Load()); got != len(tt.polls) {
+				t.Errorf("Checks.WaitForChecks polled %v times, want %v", got, len(tt.polls))
+			}
+		})
+	}
+}
+
+func TestRepositoriesService_WaitForDeployment(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	polls := []string{
+		`[]`,
+		`[{"id":1,"state":"queued"}]`,
+		`[{"id":2,"state":"in_progress"}]`,
+		`[{"id":3,"state":"success"}]`,
+	}
+	var calls atomic.Int32
+	mux.HandleFunc("/repos/o/r/deployments/1/statuses", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, polls[calls.Add(1)-1])
+	})
+
+	var changes []string
+	opts := &WaitForDeploymentOptions{
+		WaitOptions: fastWait,
+		OnChange:    func(s *DeploymentStatus) { changes = append(changes, s.GetState()) },
+	}
+	status, outcome, err := client.Repositories.WaitForDeployment(t.Context(), "o", "r", 1, opts)
+	if err != nil {
+		t.Fatalf("Repositories.WaitForDeployment returned error: %v", err)
+	}
+	if outcome != WaitSucceeded || status.GetID() != 3 {
+		t.Errorf("Repositories.WaitForDeployment returned %v, %v, want status 3, success", status, outcome)
+	}
+	if want := []string{"queued", "in_progress", "success"}; !cmp.Equal(changes, want) {
+		t.Errorf("OnChange was called with %v, want %v", changes, want)
+	}
+}
+
+func TestCodeScanningService_WaitForSARIF(t *testing.T) {
+	t.Parallel()
+	client, mux, _ := setup(t)
+
+	polls := []string{
+		``,
+		`{"processing_status":"pending"}`,
+		`{"processing_status":"pending"}`,
+		`{"processing_status":"failed","errors":["invalid location"]}`,
+	}
+	var calls atomic.Int32
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/abc", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		poll := polls[calls.Add(1)-1]
+		if poll == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, poll)
+	})
+
+	var changes []string
+	opts := &WaitForSARIFOptions{
+		WaitOptions: fastWait,
+		OnChange:    func(u *SARIFUpload) { changes = append(changes, u.GetProcessingStatus()) },
+	}
+	upload, outcome, err := client.CodeScanning.WaitForSARIF(t.Context(), "o", "r", "abc", opts)
+	if err != nil {
+		t.Fatalf("CodeScanning.WaitForSARIF returned error: %v", err)
+	}
+	want := &SARIFUpload{ProcessingStatus: Ptr("failed"), Errors: []string{"invalid location"}}
+	if outcome != WaitFailed || !cmp.Equal(upload, want) {
+		t.Errorf("CodeScanning.WaitForSARIF returned %v, %v, want %v, failure", upload, outcome, want)
+	}
+	if want := []string{"pending", "failed"}; !cmp.Equal(changes, want) {
+		t.Errorf("OnChange was called with %v, want %v", changes, want)
+	}
+
+	// Other errors are returned.
+	mux.HandleFunc("/repos/o/r/code-scanning/sarifs/def", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	if _, _, err := client.CodeScanning.WaitForSARIF(t.Context(), "o", "r", "def", opts); err == nil {
+		t.Error("CodeScanning.WaitForSARIF returned nil error for 403 Forbidden")
+	}
+}
+
+func TestClient_waitUntil_backoff(t *testing.T) {
+	t.Parallel()
+	client, _, _ := setup(t)
+
+	var times []time.Time
+	opts := &WaitOptions{Interval: 10 * time.Millisecond, MaxInterval: 40 * time.Millisecond}
+	_, err := client.waitUntil(t.Context(), opts, func(context.Context) (WaitOutcome, bool, *Response, error) {
+		times = append(times, time.Now())
+		if len(times) == 5 {
+			return WaitSucceeded, false, nil, nil
+		}
+		return "", len(times) == 1, nil, nil
+	})
+	if err != nil {
+		t.Fatalf("waitUntil returned error: %v", err)
+	}
+	// The interval doubles while nothing changes, up to MaxInterval.
+	for i, want := range []time.Duration{10, 20, 40, 40} {
+		want *= time.Millisecond
+		if d := times[i+1].Sub(times[i]); d < want || d > want+50*time.Millisecond {
+			t.Errorf("poll %v happened %v after the previous one, want %v", i+1, d, want)
+		}
+	}
+}
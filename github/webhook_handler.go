@@ -0,0 +1,282 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+const (
+	// maxWebhookPayloadSize is the maximum size of a webhook payload
+	// delivered by GitHub.
+	maxWebhookPayloadSize = 25 << 20
+
+	// defaultMaxDeliveries is the default number of delivery IDs remembered
+	// by a WebhookHandler to detect redeliveries.
+	defaultMaxDeliveries = 1000
+)
+
+// WebhookHandler is an http.Handler that receives GitHub webhook deliveries,
+// validates their signatures, and dispatches the parsed events to registered
+// callbacks.
+//
+// Callbacks are registered with the typed On methods, such as OnPullRequest,
+// or with OnAny. All callbacks matching an event are called in the order
+// they were registered, with the context of the incoming request.
+//
+// The handler responds with:
+//   - 405 Method Not Allowed if the request is not a POST.
+//   - 413 Request Entity Too Large if the payload exceeds 25 MB.
+//   - 400 Bad Request if the event type header is missing or the payload
+//     cannot be read or parsed.
+//   - 401 Unauthorized if the signature is missing or invalid.
+//   - 500 Internal Server Error if a callback returns an error.
+//   - 200 OK otherwise, including for event types with no callbacks and for
+//     deliveries that were already handled.
+//
+// Deliveries are deduplicated by their delivery ID, so a redelivered event
+// is only handled once. If a callback fails, the delivery ID is forgotten so
+// that a redelivery is handled again.
+//
+// GitHub API docs: https://docs.github.com/webhooks/using-webhooks/best-practices-for-using-webhooks
+type WebhookHandler struct {
+	// ErrorHandler, if non-nil, is called with the error of every delivery
+	// that is rejected or whose callbacks fail. It can be used for logging.
+	ErrorHandler func(r *http.Request, err error)
+
+	// MaxDeliveries is the number of recent delivery IDs remembered to detect
+	// redeliveries. If zero, 1000 delivery IDs are remembered. If negative,
+	// deliveries are not deduplicated.
+	// It must not be modified after the handler starts serving requests.
+	MaxDeliveries int
+
+	secrets [][]byte
+
+	mu           sync.RWMutex
+	callbacks    map[string][]webhookCallback
+	anyCallbacks []func(ctx context.Context, eventType string, event any) error
+
+	deliveriesMu sync.Mutex
+	deliveries   map[string]*list.Element
+	deliveryList *list.List // Delivery IDs, oldest first.
+}
+
+// webhookCallback is a callback registered for a single event type.
+type webhookCallback struct {
+	actions []string
+	fn      func(ctx context.Context, event any) error
+}
+
+// NewWebhookHandler returns a new WebhookHandler that validates payload
+// signatures with the given secret tokens.
+//
+// A payload is accepted if its signature matches any of the secret tokens,
+// which allows the webhook secret to be rotated without downtime: register
+// both the old and the new secret until the webhook configuration has been
+// updated. If no secret tokens are given, signatures are not validated.
+// This is intended for local development purposes only.
+func NewWebhookHandler(secretTokens ...[]byte) *WebhookHandler {
+	return &WebhookHandler{
+		secrets:      secretTokens,
+		callbacks:    make(map[string][]webhookCallback),
+		deliveries:   make(map[string]*list.Element),
+		deliveryList: list.New(),
+	}
+}
+
+// OnAny registers fn to be called for every webhook event of a type known to
+// ParseWebHook. eventType is the webhook event name, such as "pull_request",
+// and event is a pointer to the corresponding event struct.
+func (h *WebhookHandler) OnAny(fn func(ctx context.Context, eventType string, event any) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.anyCallbacks = append(h.anyCallbacks, fn)
+}
+
+// on registers fn to be called for events of type eventType. If actions is
+// not empty, fn is only called for events with one of those actions.
+func (h *WebhookHandler) on(eventType string, actions []string, fn func(ctx context.Context, event any) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.callbacks[eventType] = append(h.callbacks[eventType], webhookCallback{actions: actions, fn: fn})
+}
+
+// ServeHTTP implements http.Handler.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		h.fail(w, r, http.StatusMethodNotAllowed, fmt.Errorf("unsupported method %v", r.Method))
+		return
+	}
+
+	eventType := WebHookType(r)
+	if eventType == "" {
+		h.fail(w, r, http.StatusBadRequest, fmt.Errorf("missing %v header", EventTypeHeader))
+		return
+	}
+
+	payload, status, err := h.validatePayload(w, r)
+	if err != nil {
+		h.fail(w, r, status, err)
+		return
+	}
+
+	if _, ok := messageToTypeName[eventType]; !ok || !h.hasCallbacks(eventType) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	event, err := ParseWebHook(eventType, payload)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	deliveryID := DeliveryID(r)
+	if !h.markDelivery(deliveryID) {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), eventType, event); err != nil {
+		h.forgetDelivery(deliveryID)
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// validatePayload reads the body of r and validates its signature against
+// the secret tokens of h. It returns the JSON payload, or an HTTP status code
+// and an error if the payload is rejected.
+func (h *WebhookHandler) validatePayload(w http.ResponseWriter, r *http.Request) ([]byte, int, error) {
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, http.StatusRequestEntityTooLarge, err
+		}
+		return nil, http.StatusBadRequest, err
+	}
+
+	payload, err := ValidatePayloadFromBody(contentType, bytes.NewReader(body), "", nil)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	if len(h.secrets) == 0 {
+		return payload, 0, nil
+	}
+
+	signature := r.Header.Get(SHA256SignatureHeader)
+	if signature == "" {
+		signature = r.Header.Get(SHA1SignatureHeader)
+	}
+	if signature == "" {
+		return nil, http.StatusUnauthorized, errors.New("missing signature")
+	}
+	for _, secret := range h.secrets {
+		if err = ValidateSignature(signature, body, secret); err == nil {
+			return payload, 0, nil
+		}
+	}
+	return nil, http.StatusUnauthorized, err
+}
+
+// hasCallbacks reports whether any callbacks may be called for eventType.
+func (h *WebhookHandler) hasCallbacks(eventType string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.anyCallbacks) > 0 || len(h.callbacks[eventType]) > 0
+}
+
+// dispatch calls the callbacks registered for eventType with event. All
+// matching callbacks are called, and their errors are joined.
+func (h *WebhookHandler) dispatch(ctx context.Context, eventType string, event any) error {
+	h.mu.RLock()
+	callbacks := h.callbacks[eventType]
+	anyCallbacks := h.anyCallbacks
+	h.mu.RUnlock()
+
+	var action string
+	if e, ok := event.(interface{ GetAction() string }); ok {
+		action = e.GetAction()
+	}
+
+	var errs []error
+	for _, cb := range callbacks {
+		if len(cb.actions) > 0 && !slices.Contains(cb.actions, action) {
+			continue
+		}
+		if err := cb.fn(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, fn := range anyCallbacks {
+		if err := fn(ctx, eventType, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// markDelivery records deliveryID as handled. It reports false if the
+// delivery was already recorded.
+func (h *WebhookHandler) markDelivery(deliveryID string) bool {
+	if deliveryID == "" || h.MaxDeliveries < 0 {
+		return true
+	}
+	limit := h.MaxDeliveries
+	if limit == 0 {
+		limit = defaultMaxDeliveries
+	}
+
+	h.deliveriesMu.Lock()
+	defer h.deliveriesMu.Unlock()
+	if _, ok := h.deliveries[deliveryID]; ok {
+		return false
+	}
+	h.deliveries[deliveryID] = h.deliveryList.PushBack(deliveryID)
+	for h.deliveryList.Len() > limit {
+		oldest := h.deliveryList.Front()
+		h.deliveryList.Remove(oldest)
+		delete(h.deliveries, oldest.Value.(string))
+	}
+	return true
+}
+
+// forgetDelivery removes deliveryID from the recorded deliveries, so that a
+// redelivery is handled again.
+func (h *WebhookHandler) forgetDelivery(deliveryID string) {
+	h.deliveriesMu.Lock()
+	defer h.deliveriesMu.Unlock()
+	if e, ok := h.deliveries[deliveryID]; ok {
+		h.deliveryList.Remove(e)
+		delete(h.deliveries, deliveryID)
+	}
+}
+
+// fail responds to r with status, and reports err to h.ErrorHandler.
+func (h *WebhookHandler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.ErrorHandler != nil {
+		h.ErrorHandler(r, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
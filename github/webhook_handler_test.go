@@ -0,0 +1,246 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package github
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// newWebhookRequest returns a webhook delivery request for payload, signed
+// with secret if it is non-empty.
+func newWebhookRequest(eventType, deliveryID, payload string, secret []byte) *http.Request {
+	r := httptest.NewRequest("POST", "/webhook", strings.NewReader(payload))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set(EventTypeHeader, eventType)
+	r.Header.Set(DeliveryIDHeader, deliveryID)
+	if len(secret) > 0 {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(payload))
+		r.Header.Set(SHA256SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return r
+}
+
+func serveWebhook(h http.Handler, r *http.Request) int {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestWebhookHandler_dispatch(t *testing.T) {
+	t.Parallel()
+	secret := []byte("s")
+	h := NewWebhookHandler(secret)
+
+	var got []string
+	h.OnPullRequest(func(_ context.Context, event *PullRequestEvent) error {
+		got = append(got, "pr:"+event.GetAction())
+		return nil
+	})
+	h.OnPullRequest(func(_ context.Context, event *PullRequestEvent) error {
+		got = append(got, "pr-opened:"+event.GetAction())
+		return nil
+	}, "opened", "reopened")
+	h.OnPing(func(_ context.Context, event *PingEvent) error {
+		got = append(got, "ping:"+event.GetZen())
+		return nil
+	})
+	h.OnAny(func(_ context.Context, eventType string, _ any) error {
+		got = append(got, "any:"+eventType)
+		return nil
+	})
+
+	deliveries := []struct {
+		eventType, payload string
+	}{
+		{"pull_request", `{"action":"opened"}`},
+		{"pull_request", `{"action":"closed"}`},
+		{"ping", `{"zen":"z"}`},
+		{"issues", `{"action":"opened"}`},
+	}
+	for i, d := range deliveries {
+		r := newWebhookRequest(d.eventType, string(rune('a'+i)), d.payload, secret)
+		if code := serveWebhook(h, r); code != http.StatusOK {
+			t.Errorf("delivery %v returned status %v, want %v", i, code, http.StatusOK)
+		}
+	}
+
+	want := []string{
+		"pr:opened", "pr-opened:opened", "any:pull_request",
+		"pr:closed", "any:pull_request",
+		"ping:z", "any:ping",
+		"any:issues",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("callbacks called %v, want %v", got, want)
+	}
+}
+
+func TestWebhookHandler_formPayload(t *testing.T) {
+	t.Parallel()
+	h := NewWebhookHandler()
+
+	var zen string
+	h.OnPing(func(_ context.Context, event *PingEvent) error {
+		zen = event.GetZen()
+		return nil
+	})
+
+	form := url.Values{"payload": {`{"zen":"z"}`}}.Encode()
+	r := newWebhookRequest("ping", "1", form, nil)
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if code := serveWebhook(h, r); code != http.StatusOK {
+		t.Errorf("ServeHTTP returned status %v, want %v", code, http.StatusOK)
+	}
+	if want := "z"; zen != want {
+		t.Errorf("OnPing callback got zen %q, want %q", zen, want)
+	}
+}
+
+func TestWebhookHandler_secretRotation(t *testing.T) {
+	t.Parallel()
+	h := NewWebhookHandler([]byte("old"), []byte("new"))
+
+	calls := 0
+	h.OnPing(func(context.Context, *PingEvent) error {
+		calls++
+		return nil
+	})
+
+	tests := []struct {
+		secret []byte
+		want   int
+	}{
+		{[]byte("old"), http.StatusOK},
+		{[]byte("new"), http.StatusOK},
+		{[]byte("other"), http.StatusUnauthorized},
+		{nil, http.StatusUnauthorized},
+	}
+	for i, tt := range tests {
+		r := newWebhookRequest("ping", string(rune('a'+i)), `{}`, tt.secret)
+		if code := serveWebhook(h, r); code != tt.want {
+			t.Errorf("ServeHTTP with secret %q returned status %v, want %v", tt.secret, code, tt.want)
+		}
+	}
+	if want := 2; calls != want {
+		t.Errorf("OnPing callback called %v times, want %v", calls, want)
+	}
+}
+
+func TestWebhookHandler_deduplication(t *testing.T) {
+	t.Parallel()
+	h := NewWebhookHandler()
+	h.MaxDeliveries = 2
+
+	var got []string
+	fail := true
+	h.OnPing(func(_ context.Context, event *PingEvent) error {
+		got = append(got, event.GetZen())
+		if fail {
+			fail = false
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	deliveries := []struct {
+		deliveryID string
+		want       int
+	}{
+		{"1", http.StatusInternalServerError},
+		{"1", http.StatusOK}, // Redelivery after a failure is handled.
+		{"1", http.StatusOK}, // Duplicate is ignored.
+		{"2", http.StatusOK},
+		{"3", http.StatusOK},
+		{"1", http.StatusOK}, // Evicted, so handled again.
+		{"", http.StatusOK},
+		{"", http.StatusOK},
+	}
+	for i, d := range deliveries {
+		r := newWebhookRequest("ping", d.deliveryID, `{"zen":"`+d.deliveryID+`"}`, nil)
+		if code := serveWebhook(h, r); code != d.want {
+			t.Errorf("delivery %v returned status %v, want %v", i, code, d.want)
+		}
+	}
+
+	want := []string{"1", "1", "2", "3", "1", "", ""}
+	if !cmp.Equal(got, want) {
+		t.Errorf("OnPing callback called with %v, want %v", got, want)
+	}
+}
+
+func TestWebhookHandler_errors(t *testing.T) {
+	t.Parallel()
+	h := NewWebhookHandler()
+	var errs []error
+	h.ErrorHandler = func(_ *http.Request, err error) {
+		errs = append(errs, err)
+	}
+	h.OnPing(func(context.Context, *PingEvent) error { return nil })
+
+	tests := []struct {
+		name string
+		req  *http.Request
+		want int
+	}{
+		{
+			name: "method",
+			req:  httptest.NewRequest("GET", "/webhook", nil),
+			want: http.StatusMethodNotAllowed,
+		},
+		{
+			name: "missing event type",
+			req:  newWebhookRequest("", "1", `{}`, nil),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "content type",
+			req: func() *http.Request {
+				r := newWebhookRequest("ping", "1", `{}`, nil)
+				r.Header.Set("Content-Type", "text/plain")
+				return r
+			}(),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "invalid payload",
+			req:  newWebhookRequest("ping", "1", `{`, nil),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "too large",
+			req:  newWebhookRequest("ping", "1", strings.Repeat(" ", maxWebhookPayloadSize+1), nil),
+			want: http.StatusRequestEntityTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		if code := serveWebhook(h, tt.req); code != tt.want {
+			t.Errorf("%v: ServeHTTP returned status %v, want %v", tt.name, code, tt.want)
+		}
+	}
+	if len(errs) != len(tests) {
+		t.Errorf("ErrorHandler called %v times, want %v", len(errs), len(tests))
+	}
+
+	// Unknown and unhandled event types are accepted without parsing.
+	if code := serveWebhook(h, newWebhookRequest("unknown", "1", `{`, nil)); code != http.StatusOK {
+		t.Errorf("ServeHTTP for unknown event returned status %v, want %v", code, http.StatusOK)
+	}
+	if code := serveWebhook(h, newWebhookRequest("issues", "1", `{`, nil)); code != http.StatusOK {
+		t.Errorf("ServeHTTP for unhandled event returned status %v, want %v", code, http.StatusOK)
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v75/github"
+)
+
+// AddCheckRun adds a check run to the repository owner/repo and returns it.
+// The Name and HeadSHA of run are required. Its Status defaults to "queued",
+// or to "completed" if it has a Conclusion.
+//
+// AddCheckRun panics if the repository does not exist.
+func (s *Server) AddCheckRun(owner, repo string, run *github.CheckRun) *github.CheckRun {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, err := s.addCheckRun(s.mustRepository("AddCheckRun", owner, repo), run)
+	if err != nil {
+		panic(fmt.Sprintf("githubtest: AddCheckRun: %v", err))
+	}
+	return clone(stored)
+}
+
+// addCheckRun adds a copy of run to repo.
+func (s *Server) addCheckRun(repo *repository, run *github.CheckRun) (*github.CheckRun, error) {
+	if run.GetName() == "" {
+		return nil, validationFailed("CheckRun", "name", "missing_field")
+	}
+	if run.GetHeadSHA() == "" {
+		return nil, validationFailed("CheckRun", "head_sha", "missing_field")
+	}
+	if err := validateCheckRunStatus(run.Status, run.Conclusion); err != nil {
+		return nil, err
+	}
+
+	run = clone(run)
+	run.ID = github.Ptr(s.newID())
+	run.URL = github.Ptr(repo.url(fmt.Sprintf("/check-runs/%v", run.GetID())))
+	run.HTMLURL = github.Ptr(fmt.Sprintf("%v/runs/%v", repo.repo.GetHTMLURL(), run.GetID()))
+	if run.Status == nil {
+		run.Status = github.Ptr("queued")
+		if run.Conclusion != nil {
+			run.Status = github.Ptr("completed")
+		}
+	}
+	if run.StartedAt == nil {
+		run.StartedAt = timestamp()
+	}
+	if run.GetStatus() == "completed" && run.CompletedAt == nil {
+		run.CompletedAt = timestamp()
+	}
+	repo.checkRuns = append(repo.checkRuns, run)
+	return run, nil
+}
+
+// validateCheckRunStatus validates the status and conclusion of a check run.
+func validateCheckRunStatus(status, conclusion *string) error {
+	if status != nil && !slices.Contains([]string{"queued", "in_progress", "completed", "waiting", "requested", "pending"}, *status) {
+		return validationFailed("CheckRun", "status", "invalid")
+	}
+	if conclusion != nil && !slices.Contains([]string{"action_required", "cancelled", "failure", "neutral", "success", "skipped", "stale", "timed_out"}, *conclusion) {
+		return validationFailed("CheckRun", "conclusion", "invalid")
+	}
+	if status != nil && *status == "completed" && conclusion == nil {
+		return validationFailed("CheckRun", "conclusion", "missing_field")
+	}
+	return nil
+}
+
+// pathCheckRun returns the check run of repo identified by the "id" path
+// wildcard of r.
+func (repo *repository) pathCheckRun(r *http.Request) (*github.CheckRun, error) {
+	id, err := pathInt(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(repo.checkRuns, func(run *github.CheckRun) bool { return run.GetID() == id })
+	if idx < 0 {
+		return nil, errNotFound
+	}
+	return repo.checkRuns[idx], nil
+}
+
+func (s *Server) createCheckRun(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	req := new(github.CreateCheckRunOptions)
+	if err := decode(r, req); err != nil {
+		return err
+	}
+	run, err := s.addCheckRun(repo, &github.CheckRun{
+		Name:        github.Ptr(req.Name),
+		HeadSHA:     github.Ptr(req.HeadSHA),
+		DetailsURL:  req.DetailsURL,
+		ExternalID:  req.ExternalID,
+		Status:      req.Status,
+		Conclusion:  req.Conclusion,
+		StartedAt:   req.StartedAt,
+		CompletedAt: req.CompletedAt,
+		Output:      req.Output,
+	})
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusCreated, run)
+}
+
+func (s *Server) getCheckRun(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	run, err := repo.pathCheckRun(r)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, run)
+}
+
+func (s *Server) updateCheckRun(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	run, err := repo.pathCheckRun(r)
+	if err != nil {
+		return err
+	}
+	req := new(github.UpdateCheckRunOptions)
+	if err := decode(r, req); err != nil {
+		return err
+	}
+	if req.Conclusion != nil && req.Status == nil {
+		req.Status = github.Ptr("completed")
+	}
+	if err := validateCheckRunStatus(req.Status, req.Conclusion); err != nil {
+		return err
+	}
+
+	if req.Name != "" {
+		run.Name = github.Ptr(req.Name)
+	}
+	if req.DetailsURL != nil {
+		run.DetailsURL = req.DetailsURL
+	}
+	if req.ExternalID != nil {
+		run.ExternalID = req.ExternalID
+	}
+	if req.Status != nil {
+		run.Status = req.Status
+	}
+	if req.Conclusion != nil {
+		run.Conclusion = req.Conclusion
+	}
+	if req.Output != nil {
+		run.Output = req.Output
+	}
+	if req.CompletedAt != nil {
+		run.CompletedAt = req.CompletedAt
+	} else if run.GetStatus() == "completed" && run.CompletedAt == nil {
+		run.CompletedAt = timestamp()
+	}
+	return writeJSON(w, http.StatusOK, run)
+}
+
+func (s *Server) listCheckRunsForRef(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	ref, ok := strings.CutSuffix(r.PathValue("rest"), "/check-runs")
+	if !ok {
+		return errNotFound
+	}
+	sha, ok := repo.resolve(ref)
+	if !ok {
+		return unprocessable("No commit found for SHA: " + ref)
+	}
+
+	q := r.URL.Query()
+	name, status := q.Get("check_name"), q.Get("status")
+	// By default, only the most recent check run of each name is returned.
+	latest := q.Get("filter") != "all"
+
+	var runs []*github.CheckRun
+	for _, run := range slices.Backward(repo.checkRuns) {
+		if run.GetHeadSHA() != sha {
+			continue
+		}
+		if name != "" && run.GetName() != name {
+			continue
+		}
+		if status != "" && run.GetStatus() != status {
+			continue
+		}
+		if latest && slices.ContainsFunc(runs, func(o *github.CheckRun) bool { return o.GetName() == run.GetName() }) {
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	total := len(runs)
+	return writeJSON(w, http.StatusOK, &github.ListCheckRunsResults{
+		Total:     github.Ptr(total),
+		CheckRuns: paginate(w, r, s.URL, runs),
+	})
+}
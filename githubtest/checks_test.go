@@ -0,0 +1,61 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v75/github"
+)
+
+func TestServer_checkRuns(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	sha := s.SetFile("o", "r", "", "a.txt", []byte("a"))
+	s.AddCheckRun("o", "r", &github.CheckRun{Name: github.Ptr("build"), HeadSHA: github.Ptr(sha), Conclusion: github.Ptr("failure")})
+
+	run, _, err := client.Checks.CreateCheckRun(ctx, "o", "r", github.CreateCheckRunOptions{Name: "build", HeadSHA: sha})
+	if err != nil {
+		t.Fatalf("Checks.CreateCheckRun returned error: %v", err)
+	}
+	if run.GetStatus() != "queued" {
+		t.Errorf("Checks.CreateCheckRun returned status %v, want queued", run.GetStatus())
+	}
+	_, _, err = client.Checks.CreateCheckRun(ctx, "o", "r", github.CreateCheckRunOptions{Name: "build", HeadSHA: sha, Status: github.Ptr("completed")})
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+
+	run, _, err = client.Checks.UpdateCheckRun(ctx, "o", "r", run.GetID(), github.UpdateCheckRunOptions{Name: "build", Conclusion: github.Ptr("success")})
+	if err != nil {
+		t.Fatalf("Checks.UpdateCheckRun returned error: %v", err)
+	}
+	if run.GetStatus() != "completed" || run.GetConclusion() != "success" || run.CompletedAt == nil {
+		t.Errorf("Checks.UpdateCheckRun returned %+v", run)
+	}
+
+	results, _, err := client.Checks.ListCheckRunsForRef(ctx, "o", "r", "main", nil)
+	if err != nil {
+		t.Fatalf("Checks.ListCheckRunsForRef returned error: %v", err)
+	}
+	if results.GetTotal() != 1 || results.CheckRuns[0].GetID() != run.GetID() {
+		t.Errorf("Checks.ListCheckRunsForRef returned %+v, want the latest check run", results)
+	}
+
+	results, _, err = client.Checks.ListCheckRunsForRef(ctx, "o", "r", sha, &github.ListCheckRunsOptions{Filter: github.Ptr("all")})
+	if err != nil {
+		t.Fatalf("Checks.ListCheckRunsForRef returned error: %v", err)
+	}
+	if results.GetTotal() != 2 {
+		t.Errorf("Checks.ListCheckRunsForRef with filter all returned %v check runs, want 2", results.GetTotal())
+	}
+
+	_, _, err = client.Checks.ListCheckRunsForRef(ctx, "o", "r", "missing", nil)
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+	_, _, err = client.Checks.GetCheckRun(ctx, "o", "r", 0)
+	wantStatus(t, err, http.StatusNotFound)
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"cmp"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v75/github"
+)
+
+// SetFile commits content to path on branch of the repository owner/repo,
+// creating or replacing the file, and returns the SHA of the new commit. If
+// branch is empty, the default branch is used.
+//
+// SetFile panics if the repository or the branch does not exist.
+func (s *Server) SetFile(owner, repo, branch, filePath string, content []byte) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := s.mustRepository("SetFile", owner, repo)
+	ref := "refs/heads/" + cmp.Or(branch, stored.repo.GetDefaultBranch())
+	if _, ok := stored.refs[ref]; !ok {
+		panic(fmt.Sprintf("githubtest: SetFile: %v does not exist", ref))
+	}
+	return s.commitFile(stored, ref, strings.Trim(filePath, "/"), content)
+}
+
+// commitFile creates a commit on the branch ref of repo that sets the file
+// at filePath to content, or deletes it if content is nil. It returns the
+// SHA of the new commit.
+func (s *Server) commitFile(repo *repository, ref, filePath string, content []byte) string {
+	tree := maps.Clone(repo.trees[repo.refs[ref]])
+	if content == nil {
+		delete(tree, filePath)
+	} else {
+		tree[filePath] = slices.Clone(content)
+	}
+	sha := s.newCommitSHA()
+	repo.trees[sha] = tree
+	repo.refs[ref] = sha
+	repo.repo.PushedAt = timestamp()
+	return sha
+}
+
+// blobSHA returns the SHA of the Git blob object holding content.
+func blobSHA(content []byte) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "blob %v\x00", len(content))
+	h.Write(content)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// encodeContent encodes content in base64, in lines of 60 characters as
+// GitHub does.
+func encodeContent(content []byte) string {
+	enc := base64.StdEncoding.EncodeToString(content)
+	var b strings.Builder
+	for len(enc) > 60 {
+		b.WriteString(enc[:60] + "\n")
+		enc = enc[60:]
+	}
+	b.WriteString(enc + "\n")
+	return b.String()
+}
+
+// fileContent returns the metadata of the file or directory at filePath of
+// repo at ref. If withContent is true, the content of a file is included.
+func (repo *repository) fileContent(typ, filePath, ref, sha string, content []byte, withContent bool) *github.RepositoryContent {
+	kind := "blob"
+	if typ == "dir" {
+		kind = "tree"
+	}
+	c := &github.RepositoryContent{
+		Type:    github.Ptr(typ),
+		Name:    github.Ptr(path.Base(filePath)),
+		Path:    github.Ptr(filePath),
+		SHA:     github.Ptr(sha),
+		URL:     github.Ptr(repo.url("/contents/" + filePath + "?ref=" + url.QueryEscape(ref))),
+		HTMLURL: github.Ptr(fmt.Sprintf("%v/%v/%v/%v", repo.repo.GetHTMLURL(), kind, ref, filePath)),
+		Size:    github.Ptr(0),
+	}
+	if typ == "file" {
+		c.Size = github.Ptr(len(content))
+		c.GitURL = github.Ptr(repo.url("/git/blobs/" + sha))
+		c.DownloadURL = github.Ptr(fmt.Sprintf("%v/raw/%v/%v", repo.repo.GetHTMLURL(), ref, filePath))
+		if withContent {
+			c.Encoding = github.Ptr("base64")
+			c.Content = github.Ptr(encodeContent(content))
+		}
+	} else {
+		c.GitURL = github.Ptr(repo.url("/git/trees/" + sha))
+	}
+	return c
+}
+
+func (s *Server) getContents(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	ref := cmp.Or(r.URL.Query().Get("ref"), repo.repo.GetDefaultBranch())
+	commit, ok := repo.resolve(ref)
+	if !ok {
+		return &apiError{status: http.StatusNotFound, message: fmt.Sprintf("No commit found for the ref %v", ref)}
+	}
+	tree := repo.trees[commit]
+	filePath := strings.Trim(r.PathValue("path"), "/")
+
+	if content, ok := tree[filePath]; ok {
+		return writeJSON(w, http.StatusOK, repo.fileContent("file", filePath, ref, blobSHA(content), content, true))
+	}
+
+	// List the entries of the directory, if it exists.
+	prefix := ""
+	if filePath != "" {
+		prefix = filePath + "/"
+	}
+	entries := make(map[string]*github.RepositoryContent)
+	for p, content := range tree {
+		rest, ok := strings.CutPrefix(p, prefix)
+		if !ok {
+			continue
+		}
+		if name, _, isDir := strings.Cut(rest, "/"); isDir {
+			if _, ok := entries[name]; !ok {
+				sum := sha1.Sum([]byte("tree " + commit + " " + prefix + name))
+				entries[name] = repo.fileContent("dir", prefix+name, ref, hex.EncodeToString(sum[:]), nil, false)
+			}
+		} else {
+			entries[name] = repo.fileContent("file", p, ref, blobSHA(content), content, false)
+		}
+	}
+	if len(entries) == 0 && filePath != "" {
+		return errNotFound
+	}
+	list := make([]*github.RepositoryContent, 0, len(entries))
+	for _, name := range slices.Sorted(maps.Keys(entries)) {
+		list = append(list, entries[name])
+	}
+	return writeJSON(w, http.StatusOK, list)
+}
+
+// contentFileRequest decodes the body of a request to create, update or
+// delete the file at the "path" wildcard of r, and validates it against the
+// current file. It returns the file path, the branch ref and the request.
+func (s *Server) contentFileRequest(r *http.Request, repo *repository) (string, string, *github.RepositoryContentFileOptions, error) {
+	req := new(github.RepositoryContentFileOptions)
+	if err := decode(r, req); err != nil {
+		return "", "", nil, err
+	}
+	filePath := strings.Trim(r.PathValue("path"), "/")
+	if filePath == "" {
+		return "", "", nil, errNotFound
+	}
+	if req.GetMessage() == "" {
+		return "", "", nil, validationFailed("Commit", "message", "missing_field")
+	}
+	ref := "refs/heads/" + cmp.Or(req.GetBranch(), repo.repo.GetDefaultBranch())
+	commit, ok := repo.refs[ref]
+	if !ok {
+		return "", "", nil, &apiError{status: http.StatusNotFound, message: "Branch " + req.GetBranch() + " not found"}
+	}
+
+	if content, ok := repo.trees[commit][filePath]; ok {
+		if req.SHA == nil {
+			return "", "", nil, unprocessable(`Invalid request. "sha" wasn't supplied.`)
+		}
+		if sha := blobSHA(content); req.GetSHA() != sha {
+			return "", "", nil, &apiError{
+				status:  http.StatusConflict,
+				message: fmt.Sprintf("%v does not match %v", filePath, req.GetSHA()),
+			}
+		}
+	} else if r.Method == "DELETE" {
+		return "", "", nil, errNotFound
+	}
+	return filePath, ref, req, nil
+}
+
+// contentCommit returns the commit sha, made by the authenticated user for req.
+func (s *Server) contentCommit(repo *repository, sha string, req *github.RepositoryContentFileOptions) github.Commit {
+	user := s.user(s.Login)
+	return github.Commit{
+		SHA:     github.Ptr(sha),
+		Message: req.Message,
+		Author: &github.CommitAuthor{
+			Date:  timestamp(),
+			Name:  user.Login,
+			Email: github.Ptr(user.GetLogin() + "@users.noreply.github.com"),
+		},
+		URL:     github.Ptr(repo.url("/git/commits/" + sha)),
+		HTMLURL: github.Ptr(repo.repo.GetHTMLURL() + "/commit/" + sha),
+	}
+}
+
+func (s *Server) putContents(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	filePath, ref, req, err := s.contentFileRequest(r, repo)
+	if err != nil {
+		return err
+	}
+
+	status := http.StatusCreated
+	if _, ok := repo.trees[repo.refs[ref]][filePath]; ok {
+		status = http.StatusOK
+	}
+	content := req.Content
+	if content == nil {
+		content = []byte{}
+	}
+	sha := s.commitFile(repo, ref, filePath, content)
+	branch := strings.TrimPrefix(ref, "refs/heads/")
+	return writeJSON(w, status, &github.RepositoryContentResponse{
+		Content: repo.fileContent("file", filePath, branch, blobSHA(content), content, false),
+		Commit:  s.contentCommit(repo, sha, req),
+	})
+}
+
+func (s *Server) deleteContents(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	filePath, ref, req, err := s.contentFileRequest(r, repo)
+	if err != nil {
+		return err
+	}
+	sha := s.commitFile(repo, ref, filePath, nil)
+	return writeJSON(w, http.StatusOK, &github.RepositoryContentResponse{
+		Commit: s.contentCommit(repo, sha, req),
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v75/github"
+)
+
+func TestServer_contents(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	s.SetFile("o", "r", "", "docs/guide.md", []byte("guide"))
+	s.SetFile("o", "r", "", "README.md", []byte("readme"))
+
+	file, _, _, err := client.Repositories.GetContents(ctx, "o", "r", "README.md", nil)
+	if err != nil {
+		t.Fatalf("Repositories.GetContents returned error: %v", err)
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		t.Fatalf("GetContent returned error: %v", err)
+	}
+	if content != "readme" || file.GetSHA() != blobSHA([]byte("readme")) {
+		t.Errorf("Repositories.GetContents returned content %q and SHA %v", content, file.GetSHA())
+	}
+
+	_, dir, _, err := client.Repositories.GetContents(ctx, "o", "r", "", nil)
+	if err != nil {
+		t.Fatalf("Repositories.GetContents returned error: %v", err)
+	}
+	var entries []string
+	for _, e := range dir {
+		entries = append(entries, e.GetType()+":"+e.GetPath())
+	}
+	if want := []string{"file:README.md", "dir:docs"}; !cmp.Equal(entries, want) {
+		t.Errorf("Repositories.GetContents returned %v, want %v", entries, want)
+	}
+
+	_, _, resp, err := client.Repositories.GetContents(ctx, "o", "r", "missing", nil)
+	wantStatus(t, err, http.StatusNotFound)
+	if resp == nil {
+		t.Error("Repositories.GetContents returned nil Response")
+	}
+
+	created, resp, err := client.Repositories.CreateFile(ctx, "o", "r", "new.txt", &github.RepositoryContentFileOptions{
+		Message: github.Ptr("add"),
+		Content: []byte("new"),
+		Branch:  github.Ptr("main"),
+	})
+	if err != nil {
+		t.Fatalf("Repositories.CreateFile returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated || created.GetSHA() == "" || created.GetContent().GetSHA() != blobSHA([]byte("new")) {
+		t.Errorf("Repositories.CreateFile returned %v %+v", resp.StatusCode, created)
+	}
+
+	update := &github.RepositoryContentFileOptions{Message: github.Ptr("update"), Content: []byte("newer")}
+	_, _, err = client.Repositories.UpdateFile(ctx, "o", "r", "new.txt", update)
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+	update.SHA = github.Ptr("0000")
+	_, _, err = client.Repositories.UpdateFile(ctx, "o", "r", "new.txt", update)
+	wantStatus(t, err, http.StatusConflict)
+	update.SHA = created.GetContent().SHA
+	if _, _, err := client.Repositories.UpdateFile(ctx, "o", "r", "new.txt", update); err != nil {
+		t.Fatalf("Repositories.UpdateFile returned error: %v", err)
+	}
+
+	// Older commits keep their files.
+	file, _, _, err = client.Repositories.GetContents(ctx, "o", "r", "new.txt", &github.RepositoryContentGetOptions{Ref: created.GetSHA()})
+	if err != nil {
+		t.Fatalf("Repositories.GetContents returned error: %v", err)
+	}
+	if content, _ := file.GetContent(); content != "new" {
+		t.Errorf("Repositories.GetContents at %v returned %q, want %q", created.GetSHA(), content, "new")
+	}
+
+	_, _, err = client.Repositories.DeleteFile(ctx, "o", "r", "new.txt", &github.RepositoryContentFileOptions{
+		Message: github.Ptr("delete"),
+		SHA:     github.Ptr(blobSHA([]byte("newer"))),
+	})
+	if err != nil {
+		t.Fatalf("Repositories.DeleteFile returned error: %v", err)
+	}
+	_, _, _, err = client.Repositories.GetContents(ctx, "o", "r", "new.txt", nil)
+	wantStatus(t, err, http.StatusNotFound)
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v75/github"
+)
+
+// SetRef creates or updates the Git reference ref of the repository
+// owner/repo to point to the commit sha, and returns it. ref must be fully
+// qualified, such as "refs/heads/feature". If sha is empty, the reference
+// points to the head of the default branch.
+//
+// SetRef panics if the repository or the commit does not exist.
+func (s *Server) SetRef(owner, repo, ref, sha string) *github.Reference {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := s.mustRepository("SetRef", owner, repo)
+	if sha == "" {
+		sha = stored.refs["refs/heads/"+stored.repo.GetDefaultBranch()]
+	}
+	if _, ok := stored.trees[sha]; !ok {
+		panic(fmt.Sprintf("githubtest: SetRef: commit %v does not exist", sha))
+	}
+	stored.refs[ref] = sha
+	return stored.reference(ref)
+}
+
+// reference returns the Git reference ref of repo, which must exist.
+func (repo *repository) reference(ref string) *github.Reference {
+	return &github.Reference{
+		Ref: github.Ptr(ref),
+		URL: github.Ptr(repo.url("/git/" + ref)),
+		Object: &github.GitObject{
+			Type: github.Ptr("commit"),
+			SHA:  github.Ptr(repo.refs[ref]),
+			URL:  github.Ptr(repo.url("/git/commits/" + repo.refs[ref])),
+		},
+	}
+}
+
+// resolve returns the commit SHA that ref refers to. ref can be a commit SHA,
+// a branch or tag name, or a partially or fully qualified reference, such as
+// "heads/main" or "refs/tags/v1.0".
+func (repo *repository) resolve(ref string) (string, bool) {
+	if _, ok := repo.trees[ref]; ok {
+		return ref, true
+	}
+	ref = strings.TrimPrefix(ref, "refs/")
+	for _, candidate := range []string{"refs/" + ref, "refs/heads/" + ref, "refs/tags/" + ref} {
+		if sha, ok := repo.refs[candidate]; ok {
+			return sha, true
+		}
+	}
+	return "", false
+}
+
+func (s *Server) getRef(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	ref := "refs/" + r.PathValue("ref")
+	if _, ok := repo.refs[ref]; !ok {
+		return errNotFound
+	}
+	return writeJSON(w, http.StatusOK, repo.reference(ref))
+}
+
+func (s *Server) listMatchingRefs(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	prefix := "refs/" + r.PathValue("ref")
+	var names []string
+	for ref := range repo.refs {
+		if strings.HasPrefix(ref, prefix) {
+			names = append(names, ref)
+		}
+	}
+	slices.Sort(names)
+
+	refs := make([]*github.Reference, len(names))
+	for i, ref := range names {
+		refs[i] = repo.reference(ref)
+	}
+	return writeJSON(w, http.StatusOK, paginate(w, r, s.URL, refs))
+}
+
+func (s *Server) createRef(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	req := new(github.CreateRef)
+	if err := decode(r, req); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(req.Ref, "refs/") || strings.Count(req.Ref, "/") < 2 {
+		return unprocessable("Reference name must start with 'refs/' and have at least two slashes.")
+	}
+	if _, ok := repo.trees[req.SHA]; !ok {
+		return unprocessable("Object does not exist")
+	}
+	if _, ok := repo.refs[req.Ref]; ok {
+		return unprocessable("Reference already exists")
+	}
+	repo.refs[req.Ref] = req.SHA
+	return writeJSON(w, http.StatusCreated, repo.reference(req.Ref))
+}
+
+func (s *Server) updateRef(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	ref := "refs/" + r.PathValue("ref")
+	if _, ok := repo.refs[ref]; !ok {
+		return unprocessable("Reference does not exist")
+	}
+	req := new(github.UpdateRef)
+	if err := decode(r, req); err != nil {
+		return err
+	}
+	if _, ok := repo.trees[req.SHA]; !ok {
+		return unprocessable("Object does not exist")
+	}
+	repo.refs[ref] = req.SHA
+	return writeJSON(w, http.StatusOK, repo.reference(ref))
+}
+
+func (s *Server) deleteRef(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	ref := "refs/" + r.PathValue("ref")
+	if _, ok := repo.refs[ref]; !ok {
+		return unprocessable("Reference does not exist")
+	}
+	delete(repo.refs, ref)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v75/github"
+)
+
+func TestServer_refs(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	main := s.SetRef("o", "r", "refs/heads/main", "")
+	sha := s.SetFile("o", "r", "", "a.txt", []byte("a"))
+
+	ref, _, err := client.Git.CreateRef(ctx, "o", "r", github.CreateRef{Ref: "refs/tags/v1", SHA: sha})
+	if err != nil {
+		t.Fatalf("Git.CreateRef returned error: %v", err)
+	}
+	if ref.GetObject().GetSHA() != sha {
+		t.Errorf("Git.CreateRef returned SHA %v, want %v", ref.GetObject().GetSHA(), sha)
+	}
+	_, _, err = client.Git.CreateRef(ctx, "o", "r", github.CreateRef{Ref: "refs/tags/v1", SHA: sha})
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+	_, _, err = client.Git.CreateRef(ctx, "o", "r", github.CreateRef{Ref: "refs/tags/v2", SHA: "missing"})
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+
+	ref, _, err = client.Git.UpdateRef(ctx, "o", "r", "tags/v1", github.UpdateRef{SHA: main.GetObject().GetSHA()})
+	if err != nil {
+		t.Fatalf("Git.UpdateRef returned error: %v", err)
+	}
+	if ref.GetObject().GetSHA() != main.GetObject().GetSHA() {
+		t.Errorf("Git.UpdateRef returned SHA %v, want %v", ref.GetObject().GetSHA(), main.GetObject().GetSHA())
+	}
+
+	s.SetRef("o", "r", "refs/heads/feature/x", sha)
+	refs, _, err := client.Git.ListMatchingRefs(ctx, "o", "r", &github.ReferenceListOptions{Ref: "heads"})
+	if err != nil {
+		t.Fatalf("Git.ListMatchingRefs returned error: %v", err)
+	}
+	var names []string
+	for _, r := range refs {
+		names = append(names, r.GetRef())
+	}
+	if want := []string{"refs/heads/feature/x", "refs/heads/main"}; !cmp.Equal(names, want) {
+		t.Errorf("Git.ListMatchingRefs returned %v, want %v", names, want)
+	}
+
+	if _, err := client.Git.DeleteRef(ctx, "o", "r", "heads/feature/x"); err != nil {
+		t.Fatalf("Git.DeleteRef returned error: %v", err)
+	}
+	_, _, err = client.Git.GetRef(ctx, "o", "r", "heads/feature/x")
+	wantStatus(t, err, http.StatusNotFound)
+	_, err = client.Git.DeleteRef(ctx, "o", "r", "heads/feature/x")
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+}
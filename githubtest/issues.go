@@ -0,0 +1,395 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v75/github"
+)
+
+// issue is the state of an issue or pull request. As on GitHub, pull requests
+// are also issues, and share their numbers.
+type issue struct {
+	issue *github.Issue
+	pull  *github.PullRequest // Non-nil for pull requests.
+}
+
+// issueComment is an issue comment, along with the number of its issue.
+type issueComment struct {
+	number  int
+	comment *github.IssueComment
+}
+
+// AddIssue adds an issue to the repository owner/repo and returns it. Only
+// the Title of issue is required. The names of its Labels are used to find
+// the labels of the repository, which are created if needed. Its User
+// defaults to the authenticated user and its State to "open".
+//
+// AddIssue panics if the repository does not exist.
+func (s *Server) AddIssue(owner, repo string, issue *github.Issue) *github.Issue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := s.addIssue(s.mustRepository("AddIssue", owner, repo), issue)
+	return clone(stored.issue)
+}
+
+// addIssue adds a copy of i to repo.
+func (s *Server) addIssue(repo *repository, i *github.Issue) *issue {
+	i = clone(i)
+	repo.nextNumber++
+	i.ID = github.Ptr(s.newID())
+	i.Number = github.Ptr(repo.nextNumber)
+	i.URL = github.Ptr(repo.url(fmt.Sprintf("/issues/%v", i.GetNumber())))
+	i.HTMLURL = github.Ptr(fmt.Sprintf("%v/issues/%v", repo.repo.GetHTMLURL(), i.GetNumber()))
+	i.CommentsURL = github.Ptr(i.GetURL() + "/comments")
+	i.RepositoryURL = repo.repo.URL
+	if i.User == nil {
+		i.User = s.user(s.Login)
+	} else {
+		i.User = s.user(i.User.GetLogin())
+	}
+	if i.State == nil {
+		i.State = github.Ptr("open")
+	}
+	i.Labels = s.issueLabels(repo, labelNames(i.Labels))
+	i.Comments = github.Ptr(0)
+	i.CreatedAt = timestamp()
+	i.UpdatedAt = i.CreatedAt
+	if i.GetState() == "closed" {
+		i.ClosedAt = i.CreatedAt
+	}
+
+	stored := &issue{issue: i}
+	repo.issues = append(repo.issues, stored)
+	return stored
+}
+
+// findIssue returns the issue of repo with the given number.
+func (repo *repository) findIssue(number int) (*issue, error) {
+	if number < 1 || number > len(repo.issues) {
+		return nil, errNotFound
+	}
+	i := repo.issues[number-1]
+	if i == nil {
+		return nil, errNotFound
+	}
+	return i, nil
+}
+
+// pathIssue returns the issue identified by the path wildcard name of r.
+func (repo *repository) pathIssue(r *http.Request, name string) (*issue, error) {
+	number, err := pathInt(r, name)
+	if err != nil {
+		return nil, err
+	}
+	return repo.findIssue(int(number))
+}
+
+func (s *Server) listIssues(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+
+	q := r.URL.Query()
+	state := cmp.Or(q.Get("state"), "open")
+	var labels []string
+	if v := q.Get("labels"); v != "" {
+		labels = strings.Split(v, ",")
+	}
+	creator := q.Get("creator")
+
+	var issues []*github.Issue
+	for _, i := range repo.issues {
+		if state != "all" && i.issue.GetState() != state {
+			continue
+		}
+		if creator != "" && !strings.EqualFold(i.issue.GetUser().GetLogin(), creator) {
+			continue
+		}
+		if !hasLabels(i.issue.Labels, labels) {
+			continue
+		}
+		issues = append(issues, i.issue)
+	}
+	sortByCreated(issues, q.Get("direction"), (*github.Issue).GetNumber)
+	return writeJSON(w, http.StatusOK, paginate(w, r, s.URL, issues))
+}
+
+// sortByCreated sorts items, which are in creation order, in the requested
+// direction. The default direction is descending, that is newest first.
+func sortByCreated[T any](items []T, direction string, number func(T) int) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		if direction == "asc" {
+			return cmp.Compare(number(a), number(b))
+		}
+		return cmp.Compare(number(b), number(a))
+	})
+}
+
+func (s *Server) createIssue(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	req := new(github.IssueRequest)
+	if err := decode(r, req); err != nil {
+		return err
+	}
+	if req.GetTitle() == "" {
+		return validationFailed("Issue", "title", "missing_field")
+	}
+
+	i := &github.Issue{Title: req.Title, Body: req.Body}
+	if req.Labels != nil {
+		for _, name := range *req.Labels {
+			i.Labels = append(i.Labels, &github.Label{Name: github.Ptr(name)})
+		}
+	}
+	i.Assignees = s.assignees(req)
+	stored := s.addIssue(repo, i)
+	return writeJSON(w, http.StatusCreated, stored.issue)
+}
+
+// assignees returns the users assigned by req, if any.
+func (s *Server) assignees(req *github.IssueRequest) []*github.User {
+	var logins []string
+	if req.Assignee != nil {
+		logins = append(logins, req.GetAssignee())
+	}
+	if req.Assignees != nil {
+		logins = append(logins, *req.Assignees...)
+	}
+	var users []*github.User
+	for _, login := range logins {
+		users = append(users, s.user(login))
+	}
+	return users
+}
+
+func (s *Server) getIssue(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	if r.PathValue("number") == "comments" {
+		return s.listIssueComments(w, r, repo, 0)
+	}
+	i, err := repo.pathIssue(r, "number")
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, i.issue)
+}
+
+func (s *Server) editIssue(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	i, err := repo.pathIssue(r, "number")
+	if err != nil {
+		return err
+	}
+	req := new(github.IssueRequest)
+	if err := decode(r, req); err != nil {
+		return err
+	}
+	if req.Title != nil && req.GetTitle() == "" {
+		return validationFailed("Issue", "title", "missing_field")
+	}
+	if req.State != nil && req.GetState() != "open" && req.GetState() != "closed" {
+		return validationFailed("Issue", "state", "invalid")
+	}
+
+	s.updateIssue(i, req.Title, req.Body, req.State)
+	if req.StateReason != nil {
+		i.issue.StateReason = req.StateReason
+	}
+	if req.Labels != nil {
+		i.issue.Labels = s.issueLabels(repo, *req.Labels)
+	}
+	if req.Assignee != nil || req.Assignees != nil {
+		i.issue.Assignees = s.assignees(req)
+	}
+	return writeJSON(w, http.StatusOK, i.issue)
+}
+
+// updateIssue updates the fields of i that are not nil.
+func (s *Server) updateIssue(i *issue, title, body, state *string) {
+	if title != nil {
+		i.issue.Title = title
+	}
+	if body != nil {
+		i.issue.Body = body
+	}
+	if state != nil && *state != i.issue.GetState() {
+		i.issue.State = github.Ptr(*state)
+		i.issue.ClosedAt = nil
+		if *state == "closed" {
+			i.issue.ClosedAt = timestamp()
+		}
+	}
+	i.issue.UpdatedAt = timestamp()
+}
+
+// issueSubresource handles the comments and labels of an issue, and single
+// issue comments.
+func (s *Server) issueSubresource(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+
+	if r.PathValue("a") == "comments" {
+		id, err := pathInt(r, "b")
+		if err != nil {
+			return err
+		}
+		switch r.Method {
+		case "GET":
+			return s.getIssueComment(w, repo, id)
+		case "PATCH":
+			return s.editIssueComment(w, r, repo, id)
+		case "DELETE":
+			return s.deleteIssueComment(w, repo, id)
+		}
+		return errNotFound
+	}
+
+	i, err := repo.pathIssue(r, "a")
+	if err != nil {
+		return err
+	}
+	switch r.PathValue("b") + " " + r.Method {
+	case "comments GET":
+		return s.listIssueComments(w, r, repo, i.issue.GetNumber())
+	case "comments POST":
+		return s.createIssueComment(w, r, repo, i)
+	case "labels GET":
+		return writeJSON(w, http.StatusOK, paginate(w, r, s.URL, i.issue.Labels))
+	case "labels POST", "labels PUT":
+		return s.setIssueLabels(w, r, repo, i)
+	case "labels DELETE":
+		i.issue.Labels = nil
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+	return errNotFound
+}
+
+// AddIssueComment adds a comment to the issue or pull request number of the
+// repository owner/repo and returns it. Only the Body of comment is required.
+// Its User defaults to the authenticated user.
+//
+// AddIssueComment panics if the issue does not exist.
+func (s *Server) AddIssueComment(owner, repo string, number int, comment *github.IssueComment) *github.IssueComment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := s.mustRepository("AddIssueComment", owner, repo)
+	i, err := stored.findIssue(number)
+	if err != nil {
+		panic(fmt.Sprintf("githubtest: AddIssueComment: issue %v/%v#%v does not exist", owner, repo, number))
+	}
+	return clone(s.addIssueComment(stored, i, comment))
+}
+
+// addIssueComment adds a copy of c to issue i of repo.
+func (s *Server) addIssueComment(repo *repository, i *issue, c *github.IssueComment) *github.IssueComment {
+	c = clone(c)
+	c.ID = github.Ptr(s.newID())
+	c.URL = github.Ptr(repo.url(fmt.Sprintf("/issues/comments/%v", c.GetID())))
+	c.HTMLURL = github.Ptr(fmt.Sprintf("%v#issuecomment-%v", i.issue.GetHTMLURL(), c.GetID()))
+	c.IssueURL = i.issue.URL
+	if c.User == nil {
+		c.User = s.user(s.Login)
+	} else {
+		c.User = s.user(c.User.GetLogin())
+	}
+	c.CreatedAt = timestamp()
+	c.UpdatedAt = c.CreatedAt
+
+	repo.comments = append(repo.comments, &issueComment{number: i.issue.GetNumber(), comment: c})
+	i.issue.Comments = github.Ptr(i.issue.GetComments() + 1)
+	return c
+}
+
+// findIssueComment returns the index of the comment of repo with the given ID.
+func (repo *repository) findIssueComment(id int64) (int, error) {
+	idx := slices.IndexFunc(repo.comments, func(c *issueComment) bool { return c.comment.GetID() == id })
+	if idx < 0 {
+		return 0, errNotFound
+	}
+	return idx, nil
+}
+
+// listIssueComments lists the comments of the issue number of repo, or all
+// comments of repo if number is zero.
+func (s *Server) listIssueComments(w http.ResponseWriter, r *http.Request, repo *repository, number int) error {
+	var comments []*github.IssueComment
+	for _, c := range repo.comments {
+		if number == 0 || c.number == number {
+			comments = append(comments, c.comment)
+		}
+	}
+	return writeJSON(w, http.StatusOK, paginate(w, r, s.URL, comments))
+}
+
+func (s *Server) createIssueComment(w http.ResponseWriter, r *http.Request, repo *repository, i *issue) error {
+	body := new(github.IssueComment)
+	if err := decode(r, body); err != nil {
+		return err
+	}
+	if body.GetBody() == "" {
+		return validationFailed("IssueComment", "body", "missing_field")
+	}
+	c := s.addIssueComment(repo, i, &github.IssueComment{Body: body.Body})
+	return writeJSON(w, http.StatusCreated, c)
+}
+
+func (s *Server) getIssueComment(w http.ResponseWriter, repo *repository, id int64) error {
+	idx, err := repo.findIssueComment(id)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, repo.comments[idx].comment)
+}
+
+func (s *Server) editIssueComment(w http.ResponseWriter, r *http.Request, repo *repository, id int64) error {
+	idx, err := repo.findIssueComment(id)
+	if err != nil {
+		return err
+	}
+	body := new(github.IssueComment)
+	if err := decode(r, body); err != nil {
+		return err
+	}
+	if body.GetBody() == "" {
+		return validationFailed("IssueComment", "body", "missing_field")
+	}
+	c := repo.comments[idx].comment
+	c.Body = body.Body
+	c.UpdatedAt = timestamp()
+	return writeJSON(w, http.StatusOK, c)
+}
+
+func (s *Server) deleteIssueComment(w http.ResponseWriter, repo *repository, id int64) error {
+	idx, err := repo.findIssueComment(id)
+	if err != nil {
+		return err
+	}
+	if i, err := repo.findIssue(repo.comments[idx].number); err == nil {
+		i.issue.Comments = github.Ptr(i.issue.GetComments() - 1)
+	}
+	repo.comments = slices.Delete(repo.comments, idx, idx+1)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v75/github"
+)
+
+// issueNumbers returns the numbers of issues.
+func issueNumbers(issues []*github.Issue) []int {
+	var numbers []int
+	for _, i := range issues {
+		numbers = append(numbers, i.GetNumber())
+	}
+	return numbers
+}
+
+func TestServer_issues(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	seeded := s.AddIssue("o", "r", &github.Issue{Title: github.Ptr("seeded"), Labels: []*github.Label{{Name: github.Ptr("bug")}}})
+	if seeded.GetNumber() != 1 || seeded.GetState() != "open" || seeded.GetUser().GetLogin() != "octocat" {
+		t.Errorf("AddIssue returned %+v", seeded)
+	}
+
+	created, _, err := client.Issues.Create(ctx, "o", "r", &github.IssueRequest{
+		Title:     github.Ptr("created"),
+		Labels:    &[]string{"BUG", "new"},
+		Assignees: &[]string{"a"},
+	})
+	if err != nil {
+		t.Fatalf("Issues.Create returned error: %v", err)
+	}
+	if created.GetNumber() != 2 || len(created.Labels) != 2 || created.Labels[0].GetName() != "bug" || created.Assignees[0].GetLogin() != "a" {
+		t.Errorf("Issues.Create returned %+v", created)
+	}
+	_, _, err = client.Issues.Create(ctx, "o", "r", &github.IssueRequest{})
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+
+	edited, _, err := client.Issues.Edit(ctx, "o", "r", 1, &github.IssueRequest{State: github.Ptr("closed"), StateReason: github.Ptr("completed")})
+	if err != nil {
+		t.Fatalf("Issues.Edit returned error: %v", err)
+	}
+	if edited.GetState() != "closed" || edited.ClosedAt == nil || edited.GetTitle() != "seeded" {
+		t.Errorf("Issues.Edit returned %+v", edited)
+	}
+
+	tests := []struct {
+		opts *github.IssueListByRepoOptions
+		want []int
+	}{
+		{nil, []int{2}},
+		{&github.IssueListByRepoOptions{State: "all"}, []int{2, 1}},
+		{&github.IssueListByRepoOptions{State: "all", Direction: "asc"}, []int{1, 2}},
+		{&github.IssueListByRepoOptions{State: "all", Labels: []string{"bug", "new"}}, []int{2}},
+		{&github.IssueListByRepoOptions{State: "closed"}, []int{1}},
+	}
+	for _, tt := range tests {
+		issues, _, err := client.Issues.ListByRepo(ctx, "o", "r", tt.opts)
+		if err != nil {
+			t.Fatalf("Issues.ListByRepo returned error: %v", err)
+		}
+		if got := issueNumbers(issues); !cmp.Equal(got, tt.want) {
+			t.Errorf("Issues.ListByRepo(%+v) returned %v, want %v", tt.opts, got, tt.want)
+		}
+	}
+
+	_, _, err = client.Issues.Get(ctx, "o", "r", 3)
+	wantStatus(t, err, http.StatusNotFound)
+}
+
+func TestServer_issueComments(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	s.AddIssue("o", "r", &github.Issue{Title: github.Ptr("a")})
+	s.AddIssue("o", "r", &github.Issue{Title: github.Ptr("b")})
+	seeded := s.AddIssueComment("o", "r", 1, &github.IssueComment{Body: github.Ptr("first"), User: &github.User{Login: github.Ptr("u")}})
+
+	created, _, err := client.Issues.CreateComment(ctx, "o", "r", 2, &github.IssueComment{Body: github.Ptr("second")})
+	if err != nil {
+		t.Fatalf("Issues.CreateComment returned error: %v", err)
+	}
+	_, _, err = client.Issues.CreateComment(ctx, "o", "r", 3, &github.IssueComment{Body: github.Ptr("x")})
+	wantStatus(t, err, http.StatusNotFound)
+
+	comments, _, err := client.Issues.ListComments(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Issues.ListComments returned error: %v", err)
+	}
+	if len(comments) != 1 || comments[0].GetBody() != "first" || comments[0].GetUser().GetLogin() != "u" {
+		t.Errorf("Issues.ListComments returned %+v", comments)
+	}
+	all, _, err := client.Issues.ListComments(ctx, "o", "r", 0, nil)
+	if err != nil {
+		t.Fatalf("Issues.ListComments returned error: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("Issues.ListComments for the repository returned %v comments, want 2", len(all))
+	}
+
+	edited, _, err := client.Issues.EditComment(ctx, "o", "r", created.GetID(), &github.IssueComment{Body: github.Ptr("edited")})
+	if err != nil {
+		t.Fatalf("Issues.EditComment returned error: %v", err)
+	}
+	if edited.GetBody() != "edited" {
+		t.Errorf("Issues.EditComment returned body %q, want %q", edited.GetBody(), "edited")
+	}
+
+	issue, _, err := client.Issues.Get(ctx, "o", "r", 1)
+	if err != nil {
+		t.Fatalf("Issues.Get returned error: %v", err)
+	}
+	if issue.GetComments() != 1 {
+		t.Errorf("Issues.Get returned %v comments, want 1", issue.GetComments())
+	}
+
+	if _, err := client.Issues.DeleteComment(ctx, "o", "r", seeded.GetID()); err != nil {
+		t.Fatalf("Issues.DeleteComment returned error: %v", err)
+	}
+	_, _, err = client.Issues.GetComment(ctx, "o", "r", seeded.GetID())
+	wantStatus(t, err, http.StatusNotFound)
+	issue, _, _ = client.Issues.Get(ctx, "o", "r", 1)
+	if issue.GetComments() != 0 {
+		t.Errorf("Issues.Get after DeleteComment returned %v comments, want 0", issue.GetComments())
+	}
+}
+
+func TestServer_labels(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	s.AddLabel("o", "r", &github.Label{Name: github.Ptr("bug"), Color: github.Ptr("d73a4a")})
+	s.AddIssue("o", "r", &github.Issue{Title: github.Ptr("a"), Labels: []*github.Label{{Name: github.Ptr("bug")}}})
+
+	if _, _, err := client.Issues.CreateLabel(ctx, "o", "r", &github.Label{Name: github.Ptr("docs")}); err != nil {
+		t.Fatalf("Issues.CreateLabel returned error: %v", err)
+	}
+	_, _, err := client.Issues.CreateLabel(ctx, "o", "r", &github.Label{Name: github.Ptr("Docs")})
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+
+	labels, _, err := client.Issues.AddLabelsToIssue(ctx, "o", "r", 1, []string{"docs", "new"})
+	if err != nil {
+		t.Fatalf("Issues.AddLabelsToIssue returned error: %v", err)
+	}
+	if got, want := labelNames(labels), []string{"bug", "docs", "new"}; !cmp.Equal(got, want) {
+		t.Errorf("Issues.AddLabelsToIssue returned %v, want %v", got, want)
+	}
+
+	if _, _, err := client.Issues.EditLabel(ctx, "o", "r", "bug", &github.Label{Name: github.Ptr("defect")}); err != nil {
+		t.Fatalf("Issues.EditLabel returned error: %v", err)
+	}
+	if _, err := client.Issues.RemoveLabelForIssue(ctx, "o", "r", 1, "new"); err != nil {
+		t.Fatalf("Issues.RemoveLabelForIssue returned error: %v", err)
+	}
+	if _, err := client.Issues.DeleteLabel(ctx, "o", "r", "docs"); err != nil {
+		t.Fatalf("Issues.DeleteLabel returned error: %v", err)
+	}
+
+	labels, _, err = client.Issues.ListLabelsByIssue(ctx, "o", "r", 1, nil)
+	if err != nil {
+		t.Fatalf("Issues.ListLabelsByIssue returned error: %v", err)
+	}
+	if got, want := labelNames(labels), []string{"defect"}; !cmp.Equal(got, want) {
+		t.Errorf("Issues.ListLabelsByIssue returned %v, want %v", got, want)
+	}
+	if labels[0].GetColor() != "d73a4a" {
+		t.Errorf("label color = %v, want d73a4a", labels[0].GetColor())
+	}
+
+	labels, _, err = client.Issues.ReplaceLabelsForIssue(ctx, "o", "r", 1, []string{})
+	if err != nil {
+		t.Fatalf("Issues.ReplaceLabelsForIssue returned error: %v", err)
+	}
+	if labels == nil || len(labels) != 0 {
+		t.Errorf("Issues.ReplaceLabelsForIssue returned %v, want no labels", labels)
+	}
+}
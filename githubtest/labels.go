@@ -0,0 +1,248 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v75/github"
+)
+
+// defaultLabelColor is the color of labels created without one.
+const defaultLabelColor = "ededed"
+
+// AddLabel adds a label to the repository owner/repo and returns it. Only the
+// Name of label is required.
+//
+// AddLabel panics if the repository does not exist or already has a label
+// with the same name.
+func (s *Server) AddLabel(owner, repo string, label *github.Label) *github.Label {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, err := s.addLabel(s.mustRepository("AddLabel", owner, repo), label)
+	if err != nil {
+		panic(fmt.Sprintf("githubtest: AddLabel: %v", err))
+	}
+	return clone(stored)
+}
+
+// addLabel adds a copy of l to repo.
+func (s *Server) addLabel(repo *repository, l *github.Label) (*github.Label, error) {
+	if l.GetName() == "" {
+		return nil, validationFailed("Label", "name", "missing_field")
+	}
+	if repo.findLabel(l.GetName()) >= 0 {
+		return nil, validationFailed("Label", "name", "already_exists")
+	}
+	l = clone(l)
+	l.ID = github.Ptr(s.newID())
+	l.URL = github.Ptr(repo.url("/labels/" + url.PathEscape(l.GetName())))
+	if l.Color == nil {
+		l.Color = github.Ptr(defaultLabelColor)
+	}
+	if l.Default == nil {
+		l.Default = github.Ptr(false)
+	}
+	repo.labels = append(repo.labels, l)
+	return l, nil
+}
+
+// findLabel returns the index of the label of repo with the given name, or
+// -1 if there is none. Label names are case-insensitive.
+func (repo *repository) findLabel(name string) int {
+	return slices.IndexFunc(repo.labels, func(l *github.Label) bool {
+		return strings.EqualFold(l.GetName(), name)
+	})
+}
+
+// issueLabels returns the labels of repo with the given names, creating the
+// missing ones. The returned labels are shared with repo, so that edits to
+// a label are reflected in the issues that have it.
+func (s *Server) issueLabels(repo *repository, names []string) []*github.Label {
+	var labels []*github.Label
+	for _, name := range names {
+		if slices.ContainsFunc(labels, func(l *github.Label) bool { return strings.EqualFold(l.GetName(), name) }) {
+			continue
+		}
+		if idx := repo.findLabel(name); idx >= 0 {
+			labels = append(labels, repo.labels[idx])
+			continue
+		}
+		l, err := s.addLabel(repo, &github.Label{Name: github.Ptr(name)})
+		if err == nil {
+			labels = append(labels, l)
+		}
+	}
+	return labels
+}
+
+// labelNames returns the names of labels.
+func labelNames(labels []*github.Label) []string {
+	var names []string
+	for _, l := range labels {
+		names = append(names, l.GetName())
+	}
+	return names
+}
+
+// hasLabels reports whether labels include all of the given names.
+func hasLabels(labels []*github.Label, names []string) bool {
+	for _, name := range names {
+		if !slices.ContainsFunc(labels, func(l *github.Label) bool { return strings.EqualFold(l.GetName(), name) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) listLabels(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, paginate(w, r, s.URL, repo.labels))
+}
+
+func (s *Server) createLabel(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	body := new(github.Label)
+	if err := decode(r, body); err != nil {
+		return err
+	}
+	l, err := s.addLabel(repo, &github.Label{Name: body.Name, Color: body.Color, Description: body.Description})
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusCreated, l)
+}
+
+// pathLabel returns the label of repo identified by the "name" path wildcard
+// of r.
+func (repo *repository) pathLabel(r *http.Request) (int, error) {
+	idx := repo.findLabel(r.PathValue("name"))
+	if idx < 0 {
+		return 0, errNotFound
+	}
+	return idx, nil
+}
+
+func (s *Server) getLabel(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	idx, err := repo.pathLabel(r)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, repo.labels[idx])
+}
+
+func (s *Server) editLabel(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	idx, err := repo.pathLabel(r)
+	if err != nil {
+		return err
+	}
+	body := new(github.Label)
+	if err := decode(r, body); err != nil {
+		return err
+	}
+
+	l := repo.labels[idx]
+	if name := body.GetName(); name != "" && !strings.EqualFold(name, l.GetName()) {
+		if repo.findLabel(name) >= 0 {
+			return validationFailed("Label", "name", "already_exists")
+		}
+	}
+	if body.Name != nil {
+		l.Name = body.Name
+		l.URL = github.Ptr(repo.url("/labels/" + url.PathEscape(l.GetName())))
+	}
+	if body.Color != nil {
+		l.Color = body.Color
+	}
+	if body.Description != nil {
+		l.Description = body.Description
+	}
+	return writeJSON(w, http.StatusOK, l)
+}
+
+func (s *Server) deleteLabel(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	idx, err := repo.pathLabel(r)
+	if err != nil {
+		return err
+	}
+	l := repo.labels[idx]
+	repo.labels = slices.Delete(repo.labels, idx, idx+1)
+	for _, i := range repo.issues {
+		i.issue.Labels = slices.DeleteFunc(i.issue.Labels, func(il *github.Label) bool { return il == l })
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// setIssueLabels adds labels to issue i (POST), or replaces its labels (PUT).
+// The request body is either an array of label names or an object with a
+// "labels" array.
+func (s *Server) setIssueLabels(w http.ResponseWriter, r *http.Request, repo *repository, i *issue) error {
+	var raw json.RawMessage
+	if err := decode(r, &raw); err != nil {
+		return err
+	}
+	var names []string
+	if err := json.Unmarshal(raw, &names); err != nil {
+		var body struct {
+			Labels []string `json:"labels"`
+		}
+		if err := json.Unmarshal(raw, &body); err != nil {
+			return &apiError{status: http.StatusBadRequest, message: "Problems parsing JSON"}
+		}
+		names = body.Labels
+	}
+
+	if r.Method == "POST" {
+		names = append(labelNames(i.issue.Labels), names...)
+	}
+	i.issue.Labels = s.issueLabels(repo, names)
+	i.issue.UpdatedAt = timestamp()
+	return writeJSON(w, http.StatusOK, nonNil(i.issue.Labels))
+}
+
+func (s *Server) removeIssueLabel(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	i, err := repo.pathIssue(r, "number")
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(i.issue.Labels, func(l *github.Label) bool {
+		return strings.EqualFold(l.GetName(), r.PathValue("name"))
+	})
+	if idx < 0 {
+		return &apiError{status: http.StatusNotFound, message: "Label does not exist"}
+	}
+	i.issue.Labels = slices.Delete(i.issue.Labels, idx, idx+1)
+	i.issue.UpdatedAt = timestamp()
+	return writeJSON(w, http.StatusOK, nonNil(i.issue.Labels))
+}
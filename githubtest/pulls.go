@@ -0,0 +1,308 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v75/github"
+)
+
+// AddPullRequest adds a pull request to the repository owner/repo and returns
+// it. The Title of pr and the Ref of its Head are required. The Ref of its
+// Base defaults to the default branch of the repository. If the head branch
+// does not exist, it is created from the base branch. Its User defaults to
+// the authenticated user and its State to "open".
+//
+// AddPullRequest panics if the repository or the base branch does not exist.
+func (s *Server) AddPullRequest(owner, repo string, pr *github.PullRequest) *github.PullRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := s.mustRepository("AddPullRequest", owner, repo)
+
+	base := cmp.Or(pr.GetBase().GetRef(), stored.repo.GetDefaultBranch())
+	baseSHA, ok := stored.refs["refs/heads/"+base]
+	if !ok {
+		panic(fmt.Sprintf("githubtest: AddPullRequest: branch %v does not exist", base))
+	}
+	head := pr.GetHead().GetRef()
+	if _, ok := stored.refs["refs/heads/"+head]; !ok && head != "" {
+		stored.refs["refs/heads/"+head] = baseSHA
+	}
+
+	i, err := s.addPullRequest(stored, &github.Issue{
+		Title: pr.Title,
+		Body:  pr.Body,
+		State: pr.State,
+		User:  pr.User,
+	}, head, base, pr.GetDraft())
+	if err != nil {
+		panic(fmt.Sprintf("githubtest: AddPullRequest: %v", err))
+	}
+	return clone(i.pull)
+}
+
+// addPullRequest adds a pull request from the head branch to the base branch
+// of repo, described by the issue fields of i.
+func (s *Server) addPullRequest(repo *repository, i *github.Issue, head, base string, draft bool) (*issue, error) {
+	if i.GetTitle() == "" {
+		return nil, validationFailed("PullRequest", "title", "missing_field")
+	}
+	if _, ok := repo.refs["refs/heads/"+head]; !ok {
+		return nil, validationFailed("PullRequest", "head", "invalid")
+	}
+	if _, ok := repo.refs["refs/heads/"+base]; !ok {
+		return nil, validationFailed("PullRequest", "base", "invalid")
+	}
+
+	stored := s.addIssue(repo, i)
+	number := stored.issue.GetNumber()
+	stored.pull = &github.PullRequest{
+		ID:                  github.Ptr(s.newID()),
+		URL:                 github.Ptr(repo.url(fmt.Sprintf("/pulls/%v", number))),
+		HTMLURL:             github.Ptr(fmt.Sprintf("%v/pull/%v", repo.repo.GetHTMLURL(), number)),
+		IssueURL:            stored.issue.URL,
+		Head:                &github.PullRequestBranch{Ref: github.Ptr(head), SHA: github.Ptr(repo.refs["refs/heads/"+head])},
+		Base:                &github.PullRequestBranch{Ref: github.Ptr(base), SHA: github.Ptr(repo.refs["refs/heads/"+base])},
+		Draft:               github.Ptr(draft),
+		Merged:              github.Ptr(false),
+		Mergeable:           github.Ptr(true),
+		MergeableState:      github.Ptr("clean"),
+		MaintainerCanModify: github.Ptr(false),
+	}
+	stored.issue.PullRequestLinks = &github.PullRequestLinks{
+		URL:     stored.pull.URL,
+		HTMLURL: stored.pull.HTMLURL,
+	}
+	s.syncPullRequest(repo, stored)
+	return stored, nil
+}
+
+// syncPullRequest updates the pull request of i from its issue and from the
+// branches of repo.
+func (s *Server) syncPullRequest(repo *repository, i *issue) {
+	p, is := i.pull, i.issue
+	p.Number = is.Number
+	p.Title = is.Title
+	p.Body = is.Body
+	p.State = is.State
+	p.User = is.User
+	p.Labels = is.Labels
+	p.Assignees = is.Assignees
+	p.Comments = is.Comments
+	p.CreatedAt = is.CreatedAt
+	p.UpdatedAt = is.UpdatedAt
+	p.ClosedAt = is.ClosedAt
+
+	owner := repo.repo.GetOwner()
+	for _, b := range []*github.PullRequestBranch{p.Head, p.Base} {
+		b.Label = github.Ptr(owner.GetLogin() + ":" + b.GetRef())
+		b.Repo = repo.repo
+		b.User = owner
+		// Once merged or closed, the branches may have moved on or been
+		// deleted, so their last known SHAs are kept.
+		if sha, ok := repo.refs["refs/heads/"+b.GetRef()]; ok && p.GetState() == "open" {
+			b.SHA = github.Ptr(sha)
+		}
+	}
+}
+
+// pathPullRequest returns the pull request of repo identified by the "number"
+// path wildcard of r.
+func (s *Server) pathPullRequest(r *http.Request, repo *repository) (*issue, error) {
+	i, err := repo.pathIssue(r, "number")
+	if err != nil {
+		return nil, err
+	}
+	if i.pull == nil {
+		return nil, errNotFound
+	}
+	s.syncPullRequest(repo, i)
+	return i, nil
+}
+
+func (s *Server) listPullRequests(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+
+	q := r.URL.Query()
+	state := cmp.Or(q.Get("state"), "open")
+	head := q.Get("head")
+	if _, branch, ok := strings.Cut(head, ":"); ok {
+		head = branch
+	}
+	base := q.Get("base")
+
+	var pulls []*github.PullRequest
+	for _, i := range repo.issues {
+		if i.pull == nil {
+			continue
+		}
+		s.syncPullRequest(repo, i)
+		p := i.pull
+		if state != "all" && p.GetState() != state {
+			continue
+		}
+		if head != "" && p.GetHead().GetRef() != head {
+			continue
+		}
+		if base != "" && p.GetBase().GetRef() != base {
+			continue
+		}
+		pulls = append(pulls, p)
+	}
+	sortByCreated(pulls, q.Get("direction"), (*github.PullRequest).GetNumber)
+	return writeJSON(w, http.StatusOK, paginate(w, r, s.URL, pulls))
+}
+
+func (s *Server) createPullRequest(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	req := new(github.NewPullRequest)
+	if err := decode(r, req); err != nil {
+		return err
+	}
+	head := req.GetHead()
+	if _, branch, ok := strings.Cut(head, ":"); ok {
+		head = branch
+	}
+
+	i, err := s.addPullRequest(repo, &github.Issue{Title: req.Title, Body: req.Body}, head, req.GetBase(), req.GetDraft())
+	if err != nil {
+		return err
+	}
+	if req.MaintainerCanModify != nil {
+		i.pull.MaintainerCanModify = req.MaintainerCanModify
+	}
+	return writeJSON(w, http.StatusCreated, i.pull)
+}
+
+func (s *Server) getPullRequest(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	i, err := s.pathPullRequest(r, repo)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, i.pull)
+}
+
+func (s *Server) editPullRequest(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	i, err := s.pathPullRequest(r, repo)
+	if err != nil {
+		return err
+	}
+	var req struct {
+		Title               *string `json:"title"`
+		Body                *string `json:"body"`
+		State               *string `json:"state"`
+		Base                *string `json:"base"`
+		MaintainerCanModify *bool   `json:"maintainer_can_modify"`
+	}
+	if err := decode(r, &req); err != nil {
+		return err
+	}
+	if req.State != nil && *req.State != "open" && *req.State != "closed" {
+		return validationFailed("PullRequest", "state", "invalid")
+	}
+	if req.State != nil && *req.State == "open" && i.pull.GetMerged() {
+		return unprocessable("Cannot reopen a merged pull request")
+	}
+	if req.Base != nil {
+		if _, ok := repo.refs["refs/heads/"+*req.Base]; !ok {
+			return validationFailed("PullRequest", "base", "invalid")
+		}
+		i.pull.Base.Ref = req.Base
+	}
+	if req.MaintainerCanModify != nil {
+		i.pull.MaintainerCanModify = req.MaintainerCanModify
+	}
+
+	s.updateIssue(i, req.Title, req.Body, req.State)
+	s.syncPullRequest(repo, i)
+	return writeJSON(w, http.StatusOK, i.pull)
+}
+
+func (s *Server) isPullRequestMerged(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	i, err := s.pathPullRequest(r, repo)
+	if err != nil {
+		return err
+	}
+	if !i.pull.GetMerged() {
+		return errNotFound
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) mergePullRequest(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	i, err := s.pathPullRequest(r, repo)
+	if err != nil {
+		return err
+	}
+	var req struct {
+		CommitTitle   string  `json:"commit_title"`
+		CommitMessage *string `json:"commit_message"`
+		SHA           string  `json:"sha"`
+		MergeMethod   string  `json:"merge_method"`
+	}
+	if err := decode(r, &req); err != nil {
+		return err
+	}
+
+	p := i.pull
+	switch {
+	case req.MergeMethod != "" && req.MergeMethod != "merge" && req.MergeMethod != "squash" && req.MergeMethod != "rebase":
+		return validationFailed("PullRequest", "merge_method", "invalid")
+	case p.GetState() != "open" || p.GetDraft():
+		return &apiError{status: http.StatusMethodNotAllowed, message: "Pull Request is not mergeable"}
+	case req.SHA != "" && req.SHA != p.GetHead().GetSHA():
+		return &apiError{status: http.StatusConflict, message: "Head branch was modified. Review and try the merge again."}
+	}
+
+	// The files of the head branch are copied onto the base branch.
+	baseRef := "refs/heads/" + p.GetBase().GetRef()
+	tree := maps.Clone(repo.trees[repo.refs[baseRef]])
+	maps.Copy(tree, repo.trees[p.GetHead().GetSHA()])
+	sha := s.newCommitSHA()
+	repo.trees[sha] = tree
+	repo.refs[baseRef] = sha
+
+	p.Merged = github.Ptr(true)
+	p.MergedAt = timestamp()
+	p.MergedBy = s.user(s.Login)
+	p.MergeCommitSHA = github.Ptr(sha)
+	s.updateIssue(i, nil, nil, github.Ptr("closed"))
+	s.syncPullRequest(repo, i)
+
+	return writeJSON(w, http.StatusOK, &github.PullRequestMergeResult{
+		SHA:     github.Ptr(sha),
+		Merged:  github.Ptr(true),
+		Message: github.Ptr("Pull Request successfully merged"),
+	})
+}
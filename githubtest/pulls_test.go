@@ -0,0 +1,129 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v75/github"
+)
+
+func TestServer_pullRequests(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	seeded := s.AddPullRequest("o", "r", &github.PullRequest{
+		Title: github.Ptr("seeded"),
+		Head:  &github.PullRequestBranch{Ref: github.Ptr("feature")},
+	})
+	if seeded.GetNumber() != 1 || seeded.GetBase().GetRef() != "main" || seeded.GetHead().GetLabel() != "o:feature" {
+		t.Errorf("AddPullRequest returned %+v", seeded)
+	}
+	if _, _, err := client.Git.GetRef(ctx, "o", "r", "heads/feature"); err != nil {
+		t.Errorf("head branch of AddPullRequest was not created: %v", err)
+	}
+
+	s.SetRef("o", "r", "refs/heads/other", "")
+	created, _, err := client.PullRequests.Create(ctx, "o", "r", &github.NewPullRequest{
+		Title: github.Ptr("created"),
+		Head:  github.Ptr("other"),
+		Base:  github.Ptr("main"),
+		Draft: github.Ptr(true),
+	})
+	if err != nil {
+		t.Fatalf("PullRequests.Create returned error: %v", err)
+	}
+	if created.GetNumber() != 2 || !created.GetDraft() || created.GetState() != "open" {
+		t.Errorf("PullRequests.Create returned %+v", created)
+	}
+	_, _, err = client.PullRequests.Create(ctx, "o", "r", &github.NewPullRequest{
+		Title: github.Ptr("missing"),
+		Head:  github.Ptr("missing"),
+		Base:  github.Ptr("main"),
+	})
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+
+	pulls, _, err := client.PullRequests.List(ctx, "o", "r", &github.PullRequestListOptions{Head: "o:other"})
+	if err != nil {
+		t.Fatalf("PullRequests.List returned error: %v", err)
+	}
+	if len(pulls) != 1 || pulls[0].GetNumber() != 2 {
+		t.Errorf("PullRequests.List returned %v pull requests, want #2", len(pulls))
+	}
+
+	// Pull requests are issues too.
+	issue, _, err := client.Issues.Get(ctx, "o", "r", 2)
+	if err != nil {
+		t.Fatalf("Issues.Get returned error: %v", err)
+	}
+	if !issue.IsPullRequest() {
+		t.Errorf("Issues.Get returned %+v, want a pull request", issue)
+	}
+
+	// Draft pull requests cannot be merged.
+	_, _, err = client.PullRequests.Merge(ctx, "o", "r", 2, "", nil)
+	wantStatus(t, err, http.StatusMethodNotAllowed)
+
+	edited, _, err := client.PullRequests.Edit(ctx, "o", "r", 1, &github.PullRequest{Title: github.Ptr("edited")})
+	if err != nil {
+		t.Fatalf("PullRequests.Edit returned error: %v", err)
+	}
+	if edited.GetTitle() != "edited" {
+		t.Errorf("PullRequests.Edit returned title %q, want %q", edited.GetTitle(), "edited")
+	}
+}
+
+func TestServer_mergePullRequest(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	s.SetFile("o", "r", "", "README.md", []byte("readme"))
+	s.AddPullRequest("o", "r", &github.PullRequest{
+		Title: github.Ptr("add file"),
+		Head:  &github.PullRequestBranch{Ref: github.Ptr("feature")},
+	})
+	headSHA := s.SetFile("o", "r", "feature", "new.txt", []byte("new"))
+
+	_, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", &github.PullRequestOptions{SHA: "0000"})
+	wantStatus(t, err, http.StatusConflict)
+
+	if merged, _, err := client.PullRequests.IsMerged(ctx, "o", "r", 1); err != nil || merged {
+		t.Errorf("PullRequests.IsMerged = %v, %v, want false", merged, err)
+	}
+
+	result, _, err := client.PullRequests.Merge(ctx, "o", "r", 1, "", &github.PullRequestOptions{SHA: headSHA})
+	if err != nil {
+		t.Fatalf("PullRequests.Merge returned error: %v", err)
+	}
+	if !result.GetMerged() {
+		t.Errorf("PullRequests.Merge returned %+v", result)
+	}
+
+	pr, _, err := client.PullRequests.Get(ctx, "o", "r", 1)
+	if err != nil {
+		t.Fatalf("PullRequests.Get returned error: %v", err)
+	}
+	if !pr.GetMerged() || pr.GetState() != "closed" || pr.GetMergeCommitSHA() != result.GetSHA() || pr.GetHead().GetSHA() != headSHA {
+		t.Errorf("PullRequests.Get after merge returned %+v", pr)
+	}
+	if merged, _, err := client.PullRequests.IsMerged(ctx, "o", "r", 1); err != nil || !merged {
+		t.Errorf("PullRequests.IsMerged = %v, %v, want true", merged, err)
+	}
+
+	for _, name := range []string{"README.md", "new.txt"} {
+		if _, _, _, err := client.Repositories.GetContents(ctx, "o", "r", name, nil); err != nil {
+			t.Errorf("GetContents(%v) after merge returned error: %v", name, err)
+		}
+	}
+
+	_, _, err = client.PullRequests.Merge(ctx, "o", "r", 1, "", nil)
+	wantStatus(t, err, http.StatusMethodNotAllowed)
+	_, _, err = client.PullRequests.Edit(ctx, "o", "r", 1, &github.PullRequest{State: github.Ptr("open")})
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,403 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/google/go-github/v75/github"
+)
+
+// redacted replaces secrets in recorded interactions.
+const redacted = "REDACTED"
+
+// RecorderMode is the mode of a Recorder.
+type RecorderMode int
+
+const (
+	// ModeReplay serves requests from the recorded interactions, without
+	// sending them.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests and records the interactions.
+	ModeRecord
+)
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  *RecordedRequest  `json:"request"`
+	Response *RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded HTTP request.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	RecordedBody
+
+	// Operation and OperationID identify the API operation of the request,
+	// such as "GET /repos/{owner}/{repo}/contents/{path}" and
+	// "repos/get-content", if known. See github.Operation. They are not
+	// used to match requests when replaying.
+	Operation   string `json:"operation,omitempty"`
+	OperationID string `json:"operation_id,omitempty"`
+}
+
+// RecordedResponse is a recorded HTTP response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	RecordedBody
+}
+
+// RecordedBody is the body of a recorded request or response. Bodies that
+// are not valid UTF-8 are encoded in base64.
+type RecordedBody struct {
+	Body         string `json:"body,omitempty"`
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// newRecordedBody returns the recorded form of body.
+func newRecordedBody(body []byte) RecordedBody {
+	if utf8.Valid(body) {
+		return RecordedBody{Body: string(body)}
+	}
+	return RecordedBody{Body: base64.StdEncoding.EncodeToString(body), BodyEncoding: "base64"}
+}
+
+// Bytes returns the decoded body.
+func (b RecordedBody) Bytes() ([]byte, error) {
+	if b.BodyEncoding == "base64" {
+		return base64.StdEncoding.DecodeString(b.Body)
+	}
+	return []byte(b.Body), nil
+}
+
+// Recorder is an http.RoundTripper that records requests and their
+// responses to a cassette file, and replays them later without network
+// access. This allows tests against the live GitHub API to run offline and
+// deterministically.
+//
+// Secrets are scrubbed before interactions are stored: the Authorization,
+// Cookie and similar request headers, the Set-Cookie response header, the
+// client_secret and token query parameters, the signatures of the signed URLs
+// that archive and log downloads redirect to, in request URLs and Location
+// response headers alike, JSON fields such as "token" and "client_secret",
+// and anything that looks like a GitHub token. Additional scrubbing can be
+// done with Scrub.
+//
+// In ModeReplay, each request is matched against the unused interactions by
+// method, URL and body, in the order they were recorded, so that repeated
+// requests get their responses in order.
+type Recorder struct {
+	// Mode is the mode of the recorder.
+	Mode RecorderMode
+
+	// Transport is the underlying HTTP transport used to send requests in
+	// ModeRecord. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// Scrub, if set, is called on every interaction after the built-in
+	// scrubbing, before it is recorded or matched. It can redact additional
+	// secrets or normalize values that change between runs. When matching a
+	// request in ModeReplay, the Response of the interaction is nil.
+	Scrub func(*Interaction)
+
+	path string
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// NewRecorder returns a Recorder for the cassette file at path. In
+// ModeReplay, the cassette is loaded from path. In ModeRecord, it is written
+// to path by Save.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{Mode: mode, path: path}
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &r.interactions); err != nil {
+		return nil, fmt.Errorf("githubtest: parsing cassette %v: %w", path, err)
+	}
+	r.used = make([]bool, len(r.interactions))
+	return r, nil
+}
+
+// Client returns an *http.Client that uses the Recorder.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Interactions returns the recorded interactions.
+func (r *Recorder) Interactions() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Interaction(nil), r.interactions...)
+}
+
+// Save writes the recorded interactions to the cassette file, creating its
+// directory if needed. In ModeReplay, Save does nothing.
+func (r *Recorder) Save() error {
+	if r.Mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	recorded := &RecordedRequest{
+		Method:       req.Method,
+		URL:          req.URL.String(),
+		Header:       req.Header.Clone(),
+		RecordedBody: newRecordedBody(body),
+	}
+	if op := github.OperationFromContext(req.Context()); op != nil {
+		recorded.Operation = op.Name()
+		recorded.OperationID = op.ID
+	}
+
+	if r.Mode == ModeRecord {
+		return r.record(req, body, recorded)
+	}
+	return r.replay(req, recorded)
+}
+
+// record sends req, whose body has already been read into body, and records
+// it along with its response.
+func (r *Recorder) record(req *http.Request, body []byte, recorded *RecordedRequest) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	resp, err := r.transport().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	in := &Interaction{
+		Request: recorded,
+		Response: &RecordedResponse{
+			StatusCode:   resp.StatusCode,
+			Header:       resp.Header.Clone(),
+			RecordedBody: newRecordedBody(respBody),
+		},
+	}
+	r.scrub(in)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = append(r.interactions, in)
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching
+// recorded.
+func (r *Recorder) replay(req *http.Request, recorded *RecordedRequest) (*http.Response, error) {
+	in := &Interaction{Request: recorded}
+	r.scrub(in)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, candidate := range r.interactions {
+		if r.used[i] || !matches(candidate.Request, in.Request) {
+			continue
+		}
+		body, err := candidate.Response.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		r.used[i] = true
+		status := candidate.Response.StatusCode
+		return &http.Response{
+			Status:        fmt.Sprintf("%v %v", status, http.StatusText(status)),
+			StatusCode:    status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        candidate.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("githubtest: no recorded response for %v %v", recorded.Method, in.Request.URL)
+}
+
+// matches reports whether the recorded request a matches b.
+func matches(a, b *RecordedRequest) bool {
+	return a.Method == b.Method && a.URL == b.URL && a.RecordedBody == b.RecordedBody
+}
+
+func (r *Recorder) transport() http.RoundTripper {
+	if r.Transport != nil {
+		return r.Transport
+	}
+	return http.DefaultTransport
+}
+
+// secretHeaders are the request headers that are redacted.
+var secretHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization", "X-GitHub-OTP"}
+
+// secretResponseHeaders are the response headers that are redacted.
+var secretResponseHeaders = []string{"Set-Cookie"}
+
+// secretParams are the query parameters and JSON fields that are redacted.
+var secretParams = []string{"access_token", "client_secret", "refresh_token", "token"}
+
+// signedURLParams are the query parameters of signed URLs, such as those of
+// Amazon S3 and Azure Blob Storage, that are redacted.
+var signedURLParams = []string{"X-Amz-Credential", "X-Amz-Security-Token", "X-Amz-Signature", "sig", "jwt"}
+
+// tokenPattern matches GitHub tokens.
+var tokenPattern = regexp.MustCompile(`\b(gh[opsur]_[A-Za-z0-9]{20,}|github_pat_[A-Za-z0-9_]{20,})\b`)
+
+// signedURLParamPattern matches the signedURLParams of URLs in text, such as
+// in the body of a redirect, capturing their names.
+var signedURLParamPattern = regexp.MustCompile(`([?&;](?:` + strings.Join(signedURLParams, "|") + `)=)[^&"'<>\s]+`)
+
+// scrub redacts the secrets of in, then calls r.Scrub.
+func (r *Recorder) scrub(in *Interaction) {
+	if req := in.Request; req != nil {
+		for _, h := range secretHeaders {
+			if req.Header.Get(h) != "" {
+				req.Header.Set(h, redacted)
+			}
+		}
+		req.URL = scrubURL(req.URL)
+		req.RecordedBody = scrubBody(req.RecordedBody)
+	}
+	if resp := in.Response; resp != nil {
+		for _, h := range secretResponseHeaders {
+			if resp.Header.Get(h) != "" {
+				resp.Header.Set(h, redacted)
+			}
+		}
+		// Redirects, such as to download an archive, point to signed URLs.
+		if loc := resp.Header.Get("Location"); loc != "" {
+			resp.Header.Set("Location", scrubURL(loc))
+		}
+		resp.RecordedBody = scrubBody(resp.RecordedBody)
+	}
+	if r.Scrub != nil {
+		r.Scrub(in)
+	}
+}
+
+// scrubURL redacts the secret query parameters of rawURL, like sanitizeURL
+// does for the client_secret parameter in the github package. Since requests
+// are matched after scrubbing, a redirect to a scrubbed Location is still
+// replayed.
+func scrubURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return tokenPattern.ReplaceAllString(rawURL, redacted)
+	}
+	params := u.Query()
+	changed := false
+	for _, p := range slices.Concat(secretParams, signedURLParams) {
+		if params.Get(p) != "" {
+			params.Set(p, redacted)
+			changed = true
+		}
+	}
+	if changed {
+		u.RawQuery = params.Encode()
+	}
+	return tokenPattern.ReplaceAllString(u.String(), redacted)
+}
+
+// scrubBody redacts the secret fields of a JSON body, and GitHub tokens and
+// the signatures of signed URLs in any text body.
+func scrubBody(b RecordedBody) RecordedBody {
+	if b.BodyEncoding != "" || b.Body == "" {
+		return b
+	}
+	var v any
+	if err := json.Unmarshal([]byte(b.Body), &v); err == nil && scrubJSON(v) {
+		if data, err := json.Marshal(v); err == nil {
+			b.Body = string(data)
+		}
+	}
+	b.Body = tokenPattern.ReplaceAllString(b.Body, redacted)
+	b.Body = signedURLParamPattern.ReplaceAllString(b.Body, "${1}"+redacted)
+	return b
+}
+
+// scrubJSON redacts the secret fields of the decoded JSON value v in place,
+// and reports whether any were found.
+func scrubJSON(v any) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]any:
+		for k, field := range v {
+			if s, ok := field.(string); ok && s != "" && isSecretParam(k) {
+				v[k] = redacted
+				changed = true
+				continue
+			}
+			changed = scrubJSON(field) || changed
+		}
+	case []any:
+		for _, elem := range v {
+			changed = scrubJSON(elem) || changed
+		}
+	}
+	return changed
+}
+
+// isSecretParam reports whether name is a secret query parameter or JSON
+// field.
+func isSecretParam(name string) bool {
+	for _, p := range secretParams {
+		if strings.EqualFold(name, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v75/github"
+)
+
+// repository is the state of a repository.
+type repository struct {
+	repo *github.Repository
+
+	nextNumber int
+	issues     []*issue // In creation order.
+	comments   []*issueComment
+	labels     []*github.Label
+	checkRuns  []*github.CheckRun
+
+	refs  map[string]string            // Full ref name to commit SHA.
+	trees map[string]map[string][]byte // Commit SHA to file contents by path.
+}
+
+// url returns the API URL of the repository, followed by path.
+func (repo *repository) url(path string) string {
+	return repo.repo.GetURL() + path
+}
+
+// AddRepository adds a repository owned by owner and returns it. Only the
+// Name of repo is required. Its default branch, "main" unless set, is created
+// with an initial commit that has no files.
+//
+// AddRepository panics if the repository already exists.
+func (s *Server) AddRepository(owner string, repo *github.Repository) *github.Repository {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, err := s.addRepository(owner, repo)
+	if err != nil {
+		panic(fmt.Sprintf("githubtest: AddRepository: %v", err))
+	}
+	return clone(stored.repo)
+}
+
+func (s *Server) addRepository(owner string, repo *github.Repository) (*repository, error) {
+	if repo.GetName() == "" {
+		return nil, validationFailed("Repository", "name", "missing_field")
+	}
+	fullName := owner + "/" + repo.GetName()
+	key := strings.ToLower(fullName)
+	if _, ok := s.repos[key]; ok {
+		return nil, validationFailed("Repository", "name", "already_exists")
+	}
+
+	r := clone(repo)
+	r.ID = github.Ptr(s.newID())
+	r.FullName = github.Ptr(fullName)
+	r.Owner = s.user(owner)
+	if r.DefaultBranch == nil {
+		r.DefaultBranch = github.Ptr("main")
+	}
+	if r.Private == nil {
+		r.Private = github.Ptr(false)
+	}
+	if r.Visibility == nil {
+		r.Visibility = github.Ptr("public")
+		if r.GetPrivate() {
+			r.Visibility = github.Ptr("private")
+		}
+	}
+	r.URL = github.Ptr(s.URL + "/repos/" + fullName)
+	r.HTMLURL = github.Ptr(s.URL + "/" + fullName)
+	r.CreatedAt = timestamp()
+	r.UpdatedAt = r.CreatedAt
+	r.PushedAt = r.CreatedAt
+
+	stored := &repository{
+		repo:  r,
+		refs:  make(map[string]string),
+		trees: make(map[string]map[string][]byte),
+	}
+	sha := s.newCommitSHA()
+	stored.trees[sha] = map[string][]byte{}
+	stored.refs["refs/heads/"+r.GetDefaultBranch()] = sha
+
+	s.repos[key] = stored
+	s.order = append(s.order, stored)
+	return stored, nil
+}
+
+// repository returns the repository identified by the "owner" and "repo"
+// path wildcards of r.
+func (s *Server) repository(r *http.Request) (*repository, error) {
+	repo, ok := s.repos[strings.ToLower(r.PathValue("owner")+"/"+r.PathValue("repo"))]
+	if !ok {
+		return nil, errNotFound
+	}
+	return repo, nil
+}
+
+// mustRepository returns the given repository, and panics if it does not
+// exist. It is used by the seeding methods.
+func (s *Server) mustRepository(method, owner, name string) *repository {
+	repo, ok := s.repos[strings.ToLower(owner+"/"+name)]
+	if !ok {
+		panic(fmt.Sprintf("githubtest: %v: repository %v/%v does not exist", method, owner, name))
+	}
+	return repo
+}
+
+func (s *Server) getRepository(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, repo.repo)
+}
+
+func (s *Server) deleteRepository(w http.ResponseWriter, r *http.Request) error {
+	repo, err := s.repository(r)
+	if err != nil {
+		return err
+	}
+	delete(s.repos, strings.ToLower(repo.repo.GetFullName()))
+	s.order = slices.DeleteFunc(s.order, func(o *repository) bool { return o == repo })
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) createRepository(w http.ResponseWriter, r *http.Request) error {
+	owner := r.PathValue("owner")
+	if owner == "" {
+		owner = s.Login
+	}
+	body := new(github.Repository)
+	if err := decode(r, body); err != nil {
+		return err
+	}
+	repo, err := s.addRepository(owner, &github.Repository{
+		Name:          body.Name,
+		Description:   body.Description,
+		Homepage:      body.Homepage,
+		Private:       body.Private,
+		Visibility:    body.Visibility,
+		DefaultBranch: body.DefaultBranch,
+	})
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusCreated, repo.repo)
+}
+
+func (s *Server) listRepositories(w http.ResponseWriter, r *http.Request) error {
+	owner := r.PathValue("owner")
+	if owner == "" {
+		owner = s.Login
+	}
+	var repos []*github.Repository
+	for _, repo := range s.order {
+		if strings.EqualFold(repo.repo.GetOwner().GetLogin(), owner) {
+			repos = append(repos, repo.repo)
+		}
+	}
+	return writeJSON(w, http.StatusOK, paginate(w, r, s.URL, repos))
+}
@@ -0,0 +1,431 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package githubtest provides an in-memory fake GitHub API server for testing
+// code that uses go-github.
+//
+// The fake server keeps state, so that resources created through the API can
+// be read back and modified later. It implements a subset of the REST API
+// covering repositories, issues, issue comments, labels, pull requests, Git
+// references, repository contents and check runs, along with page-based
+// pagination and rate limit headers.
+//
+// Usage:
+//
+//	func TestSomething(t *testing.T) {
+//		srv := githubtest.NewServer()
+//		t.Cleanup(srv.Close)
+//
+//		srv.AddRepository("o", &github.Repository{Name: github.Ptr("r")})
+//		srv.AddIssue("o", "r", &github.Issue{Title: github.Ptr("bug")})
+//
+//		client := srv.Client()
+//		issues, _, err := client.Issues.ListByRepo(ctx, "o", "r", nil)
+//		...
+//	}
+//
+// Requests to endpoints that are not implemented fail with 404 Not Found.
+//
+// The package also provides Recorder, an http.RoundTripper that records
+// interactions with the live GitHub API to a cassette file and replays them
+// in later test runs.
+package githubtest
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v75/github"
+)
+
+const (
+	// defaultRateLimit is the default number of requests allowed per rate
+	// limit window, as for authenticated users.
+	defaultRateLimit = 5000
+
+	// rateLimitWindow is the duration of a rate limit window.
+	rateLimitWindow = time.Hour
+
+	// defaultPerPage and maxPerPage are the default and maximum page sizes of
+	// list endpoints.
+	defaultPerPage = 30
+	maxPerPage     = 100
+
+	// docsURL is the documentation URL included in error responses.
+	docsURL = "https://docs.github.com/rest"
+)
+
+// Server is an in-memory fake GitHub API server.
+//
+// Its methods are safe for concurrent use. Requests are handled one at a time.
+type Server struct {
+	// URL is the base URL of the server, of the form http://ipaddr:port with
+	// no trailing slash.
+	URL string
+
+	// Login is the login of the authenticated user, used as the author of
+	// created resources and as the owner of repositories created with
+	// POST /user/repos. It defaults to "octocat" and must not be modified
+	// while requests are being served.
+	Login string
+
+	srv *httptest.Server
+	mux *http.ServeMux
+
+	mu      sync.Mutex
+	nextID  int64
+	commits int
+	users   map[string]*github.User
+	repos   map[string]*repository // Keyed by lowercase "owner/name".
+	order   []*repository          // Repositories in creation order.
+
+	rateLimit     int
+	rateRemaining int
+	rateReset     time.Time
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		Login:         "octocat",
+		mux:           http.NewServeMux(),
+		users:         make(map[string]*github.User),
+		repos:         make(map[string]*repository),
+		rateLimit:     defaultRateLimit,
+		rateRemaining: defaultRateLimit,
+		rateReset:     now().Add(rateLimitWindow),
+	}
+	s.routes()
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server and blocks until all outstanding requests on
+// this server have completed.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a *github.Client configured to send requests to the server.
+func (s *Server) Client() *github.Client {
+	client := github.NewClient(s.srv.Client())
+	u, _ := url.Parse(s.URL + "/")
+	client.BaseURL = u
+	client.UploadURL = u
+	return client
+}
+
+// SetRateLimit sets the state of the core rate limit. Every request, except
+// those to GET /rate_limit, consumes one request. When no requests remain,
+// requests fail with 403 Forbidden until reset.
+func (s *Server) SetRateLimit(limit, remaining int, reset time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimit = limit
+	s.rateRemaining = remaining
+	s.rateReset = reset.Truncate(time.Second)
+}
+
+// handlerFunc handles a request with the server lock held. If it returns an
+// error, it must not have written a response.
+type handlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// handle registers h for pattern.
+func (s *Server) handle(pattern string, h handlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		if err := h(w, r); err != nil {
+			writeError(w, err)
+		}
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path != "/rate_limit" {
+		if !s.consumeRateLimit(w) {
+			writeError(w, &apiError{
+				status:  http.StatusForbidden,
+				message: fmt.Sprintf("API rate limit exceeded for user %v.", s.Login),
+			})
+			return
+		}
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// consumeRateLimit consumes one request from the rate limit and sets the rate
+// limit headers. It reports false if the rate limit is exhausted.
+func (s *Server) consumeRateLimit(w http.ResponseWriter) bool {
+	if t := now(); !t.Before(s.rateReset) {
+		s.rateRemaining = s.rateLimit
+		s.rateReset = t.Add(rateLimitWindow)
+	}
+	ok := s.rateRemaining > 0
+	if ok {
+		s.rateRemaining--
+	}
+
+	h := w.Header()
+	h.Set("X-RateLimit-Limit", strconv.Itoa(s.rateLimit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(s.rateRemaining))
+	h.Set("X-RateLimit-Used", strconv.Itoa(s.rateLimit-s.rateRemaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(s.rateReset.Unix(), 10))
+	h.Set("X-RateLimit-Resource", "core")
+	return ok
+}
+
+func (s *Server) routes() {
+	s.handle("/", func(http.ResponseWriter, *http.Request) error { return errNotFound })
+
+	s.handle("GET /rate_limit", s.getRateLimit)
+	s.handle("GET /user", s.getAuthenticatedUser)
+
+	s.handle("GET /repos/{owner}/{repo}", s.getRepository)
+	s.handle("DELETE /repos/{owner}/{repo}", s.deleteRepository)
+	s.handle("POST /user/repos", s.createRepository)
+	s.handle("POST /orgs/{owner}/repos", s.createRepository)
+	s.handle("GET /user/repos", s.listRepositories)
+	s.handle("GET /users/{owner}/repos", s.listRepositories)
+	s.handle("GET /orgs/{owner}/repos", s.listRepositories)
+
+	s.handle("GET /repos/{owner}/{repo}/issues", s.listIssues)
+	s.handle("POST /repos/{owner}/{repo}/issues", s.createIssue)
+	s.handle("GET /repos/{owner}/{repo}/issues/{number}", s.getIssue)
+	s.handle("PATCH /repos/{owner}/{repo}/issues/{number}", s.editIssue)
+	// Issue comments and issue labels share a pattern, because
+	// /issues/comments/{id} conflicts with /issues/{number}/comments.
+	s.handle("/repos/{owner}/{repo}/issues/{a}/{b}", s.issueSubresource)
+	s.handle("DELETE /repos/{owner}/{repo}/issues/{number}/labels/{name}", s.removeIssueLabel)
+
+	s.handle("GET /repos/{owner}/{repo}/labels", s.listLabels)
+	s.handle("POST /repos/{owner}/{repo}/labels", s.createLabel)
+	s.handle("GET /repos/{owner}/{repo}/labels/{name}", s.getLabel)
+	s.handle("PATCH /repos/{owner}/{repo}/labels/{name}", s.editLabel)
+	s.handle("DELETE /repos/{owner}/{repo}/labels/{name}", s.deleteLabel)
+
+	s.handle("GET /repos/{owner}/{repo}/pulls", s.listPullRequests)
+	s.handle("POST /repos/{owner}/{repo}/pulls", s.createPullRequest)
+	s.handle("GET /repos/{owner}/{repo}/pulls/{number}", s.getPullRequest)
+	s.handle("PATCH /repos/{owner}/{repo}/pulls/{number}", s.editPullRequest)
+	s.handle("GET /repos/{owner}/{repo}/pulls/{number}/merge", s.isPullRequestMerged)
+	s.handle("PUT /repos/{owner}/{repo}/pulls/{number}/merge", s.mergePullRequest)
+
+	s.handle("GET /repos/{owner}/{repo}/git/ref/{ref...}", s.getRef)
+	s.handle("GET /repos/{owner}/{repo}/git/matching-refs/{ref...}", s.listMatchingRefs)
+	s.handle("POST /repos/{owner}/{repo}/git/refs", s.createRef)
+	s.handle("PATCH /repos/{owner}/{repo}/git/refs/{ref...}", s.updateRef)
+	s.handle("DELETE /repos/{owner}/{repo}/git/refs/{ref...}", s.deleteRef)
+
+	s.handle("GET /repos/{owner}/{repo}/contents/{path...}", s.getContents)
+	s.handle("PUT /repos/{owner}/{repo}/contents/{path...}", s.putContents)
+	s.handle("DELETE /repos/{owner}/{repo}/contents/{path...}", s.deleteContents)
+
+	s.handle("POST /repos/{owner}/{repo}/check-runs", s.createCheckRun)
+	s.handle("GET /repos/{owner}/{repo}/check-runs/{id}", s.getCheckRun)
+	s.handle("PATCH /repos/{owner}/{repo}/check-runs/{id}", s.updateCheckRun)
+	// The ref may contain slashes, so it cannot be matched by a single wildcard.
+	s.handle("GET /repos/{owner}/{repo}/commits/{rest...}", s.listCheckRunsForRef)
+}
+
+func (s *Server) getRateLimit(w http.ResponseWriter, _ *http.Request) error {
+	rate := &github.Rate{
+		Limit:     s.rateLimit,
+		Remaining: s.rateRemaining,
+		Used:      s.rateLimit - s.rateRemaining,
+		Reset:     github.Timestamp{Time: s.rateReset},
+		Resource:  "core",
+	}
+	return writeJSON(w, http.StatusOK, map[string]any{
+		"resources": &github.RateLimits{Core: rate},
+		"rate":      rate,
+	})
+}
+
+func (s *Server) getAuthenticatedUser(w http.ResponseWriter, _ *http.Request) error {
+	return writeJSON(w, http.StatusOK, s.user(s.Login))
+}
+
+// user returns the user with the given login, creating it if needed.
+func (s *Server) user(login string) *github.User {
+	key := strings.ToLower(login)
+	if u, ok := s.users[key]; ok {
+		return u
+	}
+	u := &github.User{
+		Login:   github.Ptr(login),
+		ID:      github.Ptr(s.newID()),
+		Type:    github.Ptr("User"),
+		URL:     github.Ptr(s.URL + "/users/" + login),
+		HTMLURL: github.Ptr(s.URL + "/" + login),
+	}
+	s.users[key] = u
+	return u
+}
+
+// newID returns a new unique resource ID.
+func (s *Server) newID() int64 {
+	s.nextID++
+	return s.nextID
+}
+
+// newCommitSHA returns a new unique commit SHA.
+func (s *Server) newCommitSHA() string {
+	s.commits++
+	sum := sha1.Sum([]byte(fmt.Sprintf("commit %v", s.commits)))
+	return hex.EncodeToString(sum[:])
+}
+
+// now returns the current time, with the precision of GitHub timestamps.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// timestamp returns the current time as a *github.Timestamp.
+func timestamp() *github.Timestamp {
+	return &github.Timestamp{Time: now()}
+}
+
+// clone returns a deep copy of v, made by encoding it to JSON and back.
+func clone[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	c := new(T)
+	if err := json.Unmarshal(b, c); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// apiError is an error response of the API.
+type apiError struct {
+	status  int
+	message string
+	errors  []github.Error
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%v %v", e.status, e.message)
+}
+
+var errNotFound = &apiError{status: http.StatusNotFound, message: "Not Found"}
+
+// validationFailed returns a 422 Unprocessable Entity error for an invalid
+// field of resource.
+func validationFailed(resource, field, code string) error {
+	return &apiError{
+		status:  http.StatusUnprocessableEntity,
+		message: "Validation Failed",
+		errors:  []github.Error{{Resource: resource, Field: field, Code: code}},
+	}
+}
+
+// unprocessable returns a 422 Unprocessable Entity error with message.
+func unprocessable(message string) error {
+	return &apiError{status: http.StatusUnprocessableEntity, message: message}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = &apiError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+	_ = writeJSON(w, apiErr.status, &github.ErrorResponse{
+		Message:          apiErr.message,
+		Errors:           apiErr.errors,
+		DocumentationURL: docsURL,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+}
+
+// decode decodes the JSON body of r into v.
+func decode(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return &apiError{status: http.StatusBadRequest, message: "Problems parsing JSON"}
+	}
+	return nil
+}
+
+// pathInt parses the path wildcard name of r as an integer.
+func pathInt(r *http.Request, name string) (int64, error) {
+	n, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil {
+		return 0, errNotFound
+	}
+	return n, nil
+}
+
+// nonNil returns items, or an empty slice if items is nil, so that it is
+// encoded as an empty JSON array.
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
+
+// paginate returns the page of items requested by the "page" and "per_page"
+// query parameters of r, and sets the Link header of w accordingly.
+func paginate[T any](w http.ResponseWriter, r *http.Request, baseURL string, items []T) []T {
+	items = nonNil(items)
+	q := r.URL.Query()
+	page, err := strconv.Atoi(q.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(q.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+	perPage = min(perPage, maxPerPage)
+
+	lastPage := max((len(items)+perPage-1)/perPage, 1)
+	pageURL := func(page int) string {
+		q.Set("page", strconv.Itoa(page))
+		return baseURL + r.URL.Path + "?" + q.Encode()
+	}
+	var links []string
+	if page < lastPage {
+		links = append(links,
+			fmt.Sprintf("<%v>; rel=\"next\"", pageURL(page+1)),
+			fmt.Sprintf("<%v>; rel=\"last\"", pageURL(lastPage)))
+	}
+	if page > 1 {
+		links = append(links,
+			fmt.Sprintf("<%v>; rel=\"first\"", pageURL(1)),
+			fmt.Sprintf("<%v>; rel=\"prev\"", pageURL(min(page-1, lastPage))))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	return items[start:end]
+}
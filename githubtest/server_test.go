@@ -0,0 +1,169 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package githubtest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v75/github"
+)
+
+// newTestServer returns a new Server with the repository o/r, closed at the
+// end of the test.
+func newTestServer(t *testing.T) (*Server, *github.Client) {
+	t.Helper()
+	s := NewServer()
+	t.Cleanup(s.Close)
+	s.AddRepository("o", &github.Repository{Name: github.Ptr("r")})
+	return s, s.Client()
+}
+
+// wantStatus checks that err is a *github.ErrorResponse with the given
+// status code.
+func wantStatus(t *testing.T, err error, status int) {
+	t.Helper()
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("error = %v, want *github.ErrorResponse with status %v", err, status)
+	}
+	if got := errResp.Response.StatusCode; got != status {
+		t.Errorf("status = %v, want %v (%v)", got, status, errResp.Message)
+	}
+}
+
+func TestServer_repositories(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	repo, _, err := client.Repositories.Get(ctx, "O", "R")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if repo.GetFullName() != "o/r" || repo.GetDefaultBranch() != "main" || repo.GetOwner().GetLogin() != "o" {
+		t.Errorf("Repositories.Get returned %+v", repo)
+	}
+
+	created, _, err := client.Repositories.Create(ctx, "", &github.Repository{Name: github.Ptr("mine"), Private: github.Ptr(true)})
+	if err != nil {
+		t.Fatalf("Repositories.Create returned error: %v", err)
+	}
+	if created.GetFullName() != "octocat/mine" || created.GetVisibility() != "private" {
+		t.Errorf("Repositories.Create returned %+v", created)
+	}
+	_, _, err = client.Repositories.Create(ctx, "", &github.Repository{Name: github.Ptr("mine")})
+	wantStatus(t, err, http.StatusUnprocessableEntity)
+
+	repos, _, err := client.Repositories.ListByUser(ctx, "octocat", nil)
+	if err != nil {
+		t.Fatalf("Repositories.ListByUser returned error: %v", err)
+	}
+	if len(repos) != 1 || repos[0].GetName() != "mine" {
+		t.Errorf("Repositories.ListByUser returned %v repositories, want mine", len(repos))
+	}
+
+	if _, err := client.Repositories.Delete(ctx, "octocat", "mine"); err != nil {
+		t.Fatalf("Repositories.Delete returned error: %v", err)
+	}
+	_, _, err = client.Repositories.Get(ctx, "octocat", "mine")
+	wantStatus(t, err, http.StatusNotFound)
+
+	user, _, err := client.Users.Get(ctx, "")
+	if err != nil {
+		t.Fatalf("Users.Get returned error: %v", err)
+	}
+	if user.GetLogin() != s.Login {
+		t.Errorf("Users.Get returned login %v, want %v", user.GetLogin(), s.Login)
+	}
+}
+
+func TestServer_notFound(t *testing.T) {
+	t.Parallel()
+	_, client := newTestServer(t)
+
+	_, _, err := client.Repositories.ListHooks(t.Context(), "o", "r", nil)
+	wantStatus(t, err, http.StatusNotFound)
+}
+
+func TestServer_pagination(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	for i := range 5 {
+		s.AddLabel("o", "r", &github.Label{Name: github.Ptr(fmt.Sprintf("l%v", i))})
+	}
+
+	opts := &github.ListOptions{PerPage: 2}
+	var pages [][]*github.Label
+	for {
+		labels, resp, err := client.Issues.ListLabels(t.Context(), "o", "r", opts)
+		if err != nil {
+			t.Fatalf("ListLabels returned error: %v", err)
+		}
+		pages = append(pages, labels)
+		if len(pages) == 2 && (resp.FirstPage != 1 || resp.PrevPage != 1 || resp.LastPage != 3) {
+			t.Errorf("page 2 Response = %+v, want first, prev and last pages", resp)
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	var sizes []int
+	for _, p := range pages {
+		sizes = append(sizes, len(p))
+	}
+	if want := []int{2, 2, 1}; !cmp.Equal(sizes, want) {
+		t.Errorf("page sizes = %v, want %v", sizes, want)
+	}
+}
+
+func TestServer_rateLimit(t *testing.T) {
+	t.Parallel()
+	s, client := newTestServer(t)
+	ctx := t.Context()
+
+	_, resp, err := client.Repositories.Get(ctx, "o", "r")
+	if err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	if resp.Rate.Limit != 5000 || resp.Rate.Remaining != 4999 || resp.Rate.Used != 1 || resp.Rate.Resource != "core" {
+		t.Errorf("Rate = %+v, want 4999 of 5000 core requests remaining", resp.Rate)
+	}
+
+	reset := time.Now().Add(time.Hour)
+	s.SetRateLimit(10, 1, reset)
+	if _, _, err := client.Repositories.Get(ctx, "o", "r"); err != nil {
+		t.Fatalf("Repositories.Get returned error: %v", err)
+	}
+	_, _, err = client.Repositories.Get(ctx, "o", "r")
+	var rateErr *github.RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("Repositories.Get returned error %v, want *github.RateLimitError", err)
+	}
+	if !rateErr.Rate.Reset.Time.Equal(reset.Truncate(time.Second)) {
+		t.Errorf("RateLimitError.Rate.Reset = %v, want %v", rateErr.Rate.Reset, reset)
+	}
+
+	limits, _, err := s.Client().RateLimit.Get(ctx)
+	if err != nil {
+		t.Fatalf("RateLimit.Get returned error: %v", err)
+	}
+	if limits.GetCore().Remaining != 0 || limits.GetCore().Limit != 10 {
+		t.Errorf("RateLimit.Get returned core %+v, want exhausted rate", limits.GetCore())
+	}
+
+	// A new window starts once the rate limit resets.
+	s.SetRateLimit(10, 0, time.Now().Add(-time.Second))
+	if _, _, err := s.Client().Repositories.Get(ctx, "o", "r"); err != nil {
+		t.Errorf("Repositories.Get after reset returned error: %v", err)
+	}
+}
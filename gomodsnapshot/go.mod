@@ -0,0 +1,14 @@
+module github.com/google/go-github/v75/gomodsnapshot
+
+go 1.24.0
+
+require (
+	github.com/google/go-cmp v0.7.0
+	github.com/google/go-github/v75 v75.0.0
+	golang.org/x/mod v0.30.0
+)
+
+require github.com/google/go-querystring v1.1.0 // indirect
+
+// Use version at HEAD, not the latest published.
+replace github.com/google/go-github/v75 => ../
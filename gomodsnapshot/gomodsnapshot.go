@@ -0,0 +1,340 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package gomodsnapshot builds dependency graph snapshots of Go modules, to
+// be submitted with github.DependencyGraphService.CreateSnapshot.
+//
+// The dependencies of a module are read from its go.mod and go.sum files,
+// or, more precisely, from the output of "go list -m -json all", which
+// reports the versions selected by the go command. Each module is a manifest
+// of the snapshot, named after the path of its go.mod file, so that the
+// modules of a repository holding several of them can be submitted at once.
+//
+// Usage:
+//
+//	m, err := gomodsnapshot.Load(repoRoot, "services/api")
+//	if err != nil {
+//		// Handle error.
+//	}
+//	job := &github.DependencyGraphSnapshotJob{Correlator: github.Ptr("gomodsnapshot services/api"), ID: github.Ptr(runID)}
+//	snapshot, err := gomodsnapshot.Snapshot(job, sha, "refs/heads/main", m)
+//	if err != nil {
+//		// Handle error.
+//	}
+//	_, _, err = client.DependencyGraph.CreateSnapshot(ctx, owner, repo, snapshot)
+package gomodsnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v75/github"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+)
+
+// The relationships and scopes of resolved dependencies.
+const (
+	RelationshipDirect   = "direct"
+	RelationshipIndirect = "indirect"
+
+	ScopeRuntime     = "runtime"
+	ScopeDevelopment = "development"
+)
+
+// Module is a Go module of a repository.
+type Module struct {
+	// Path is the path of the go.mod file of the module, relative to the
+	// root of the repository, such as "go.mod" or "tools/go.mod". It is the
+	// name of the manifest of the module.
+	Path string
+
+	// GoMod is the content of the go.mod file.
+	GoMod []byte
+
+	// GoSum is the content of the go.sum file, if any. It is only used for
+	// modules that declare a Go version older than 1.17, whose go.mod file
+	// does not list the dependencies of their dependencies. It is ignored if
+	// List is set.
+	GoSum []byte
+
+	// List is the output of "go list -m -json all" in the directory of the
+	// module, if any. It is preferred to GoMod and GoSum to find the
+	// dependencies of the module and their versions.
+	List []byte
+
+	// Development lists the paths of the modules that are only needed for
+	// development, in addition to those that provide the tools of the tool
+	// directives of the go.mod file. Other dependencies are needed at
+	// runtime.
+	Development []string
+}
+
+// Load returns the module whose go.mod file is in dir, a directory relative
+// to the root of a repository, reading its go.mod and go.sum files. It does
+// not run the go command, whose output may be added to Module.List.
+func Load(root, dir string) (*Module, error) {
+	dir = filepath.Join(root, dir)
+	goMod, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	goSum, err := os.ReadFile(filepath.Join(dir, "go.sum"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return nil, err
+	}
+	return &Module{
+		Path:  path.Join(filepath.ToSlash(rel), "go.mod"),
+		GoMod: goMod,
+		GoSum: goSum,
+	}, nil
+}
+
+// Snapshot returns a snapshot of the dependencies of modules, at the commit
+// sha of ref, made by job. The manifests of the snapshot are named after the
+// paths of the modules, which must be distinct.
+func Snapshot(job *github.DependencyGraphSnapshotJob, sha, ref string, modules ...*Module) (*github.DependencyGraphSnapshot, error) {
+	manifests := make(map[string]*github.DependencyGraphSnapshotManifest, len(modules))
+	for _, m := range modules {
+		if manifests[m.Path] != nil {
+			return nil, fmt.Errorf("gomodsnapshot: duplicate module %v", m.Path)
+		}
+		manifest, err := Manifest(m)
+		if err != nil {
+			return nil, err
+		}
+		manifests[m.Path] = manifest
+	}
+	return &github.DependencyGraphSnapshot{
+		Sha: github.Ptr(sha),
+		Ref: github.Ptr(ref),
+		Job: job,
+		Detector: &github.DependencyGraphSnapshotDetector{
+			Name:    github.Ptr("go-github/gomodsnapshot"),
+			Version: github.Ptr(github.Version),
+			URL:     github.Ptr("https://github.com/google/go-github"),
+		},
+		Scanned:   &github.Timestamp{Time: time.Now()},
+		Manifests: manifests,
+	}, nil
+}
+
+// dependency is a resolved dependency of a module.
+type dependency struct {
+	path, version string
+	indirect      bool
+}
+
+// Manifest returns the manifest of the dependencies of m. The dependencies
+// are keyed by module path, and replaced modules are reported as their
+// replacements. Modules replaced by a directory are part of the repository,
+// and are not reported.
+func Manifest(m *Module) (*github.DependencyGraphSnapshotManifest, error) {
+	mf, err := modfile.Parse(m.Path, m.GoMod, nil)
+	if err != nil {
+		return nil, fmt.Errorf("gomodsnapshot: %w", err)
+	}
+	if mf.Module == nil {
+		return nil, fmt.Errorf("gomodsnapshot: %v: missing module directive", m.Path)
+	}
+
+	var deps map[string]*dependency
+	if m.List != nil {
+		deps, err = listDependencies(mf, m.List)
+	} else {
+		deps, err = modDependencies(mf, m.GoSum)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("gomodsnapshot: %v: %w", m.Path, err)
+	}
+	delete(deps, mf.Module.Mod.Path)
+
+	development := make(map[string]bool)
+	for _, p := range m.Development {
+		development[p] = true
+	}
+	for _, t := range mf.Tool {
+		if p := providingModule(deps, t.Path); p != "" {
+			development[p] = true
+		}
+	}
+
+	resolved := make(map[string]*github.DependencyGraphSnapshotResolvedDependency, len(deps))
+	for p, d := range deps {
+		if d.version == "" {
+			continue
+		}
+		relationship, scope := RelationshipDirect, ScopeRuntime
+		if d.indirect {
+			relationship = RelationshipIndirect
+		}
+		if development[p] {
+			scope = ScopeDevelopment
+		}
+		resolved[p] = &github.DependencyGraphSnapshotResolvedDependency{
+			PackageURL:   github.Ptr(PackageURL(d.path, d.version)),
+			Relationship: github.Ptr(relationship),
+			Scope:        github.Ptr(scope),
+		}
+	}
+	return &github.DependencyGraphSnapshotManifest{
+		Name:     github.Ptr(m.Path),
+		File:     &github.DependencyGraphSnapshotManifestFile{SourceLocation: github.Ptr(m.Path)},
+		Resolved: resolved,
+	}, nil
+}
+
+// listDependencies returns the dependencies listed by "go list -m -json
+// all". Only the modules required by mf without an "// indirect" comment are
+// direct dependencies: go list does not mark the modules missing from the
+// go.mod files of Go versions older than 1.17 as indirect.
+func listDependencies(mf *modfile.File, list []byte) (map[string]*dependency, error) {
+	type module struct {
+		Path     string
+		Version  string
+		Main     bool
+		Indirect bool
+		Replace  *module
+	}
+	deps := make(map[string]*dependency)
+	dec := json.NewDecoder(bytes.NewReader(list))
+	for {
+		var m module
+		if err := dec.Decode(&m); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if m.Main {
+			continue
+		}
+		d := &dependency{path: m.Path, version: m.Version, indirect: m.Indirect || !isRequired(mf, m.Path)}
+		if m.Replace != nil {
+			// A replacement without a version is a directory.
+			d.path, d.version = m.Replace.Path, m.Replace.Version
+		}
+		deps[m.Path] = d
+	}
+	return deps, nil
+}
+
+// modDependencies returns the dependencies required by a go.mod file, along
+// with the modules of goSum that are missing from it if it declares a Go
+// version older than 1.17.
+func modDependencies(mf *modfile.File, goSum []byte) (map[string]*dependency, error) {
+	deps := make(map[string]*dependency)
+	for _, r := range mf.Require {
+		deps[r.Mod.Path] = &dependency{path: r.Mod.Path, version: r.Mod.Version, indirect: r.Indirect}
+	}
+	if mf.Go != nil && semver.Compare("v"+mf.Go.Version, "v1.17") >= 0 {
+		goSum = nil
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(goSum))
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("malformed go.sum line %q", sc.Text())
+		}
+		p, v := fields[0], fields[1]
+		// Only the go.mod file of some modules is needed, to load the
+		// module graph.
+		if strings.HasSuffix(v, "/go.mod") {
+			continue
+		}
+		if d := deps[p]; d == nil {
+			deps[p] = &dependency{path: p, version: v, indirect: true}
+		} else if !isRequired(mf, p) && semver.Compare(v, d.version) > 0 {
+			d.version = v
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	for p, d := range deps {
+		d.path, d.version = replace(mf, p, d.version)
+	}
+	return deps, nil
+}
+
+func isRequired(mf *modfile.File, p string) bool {
+	for _, r := range mf.Require {
+		if r.Mod.Path == p {
+			return true
+		}
+	}
+	return false
+}
+
+// replace returns the replacement of a version of a module by the replace
+// directives of mf, with an empty version for a directory.
+func replace(mf *modfile.File, p, version string) (string, string) {
+	var match *modfile.Replace
+	for _, r := range mf.Replace {
+		// A replacement of a specific version takes precedence.
+		if r.Old.Path == p && (r.Old.Version == version || (r.Old.Version == "" && match == nil)) {
+			match = r
+		}
+	}
+	if match == nil {
+		return p, version
+	}
+	return match.New.Path, match.New.Version
+}
+
+// providingModule returns the path of the module of deps providing a
+// package, which is the one with the longest matching path.
+func providingModule(deps map[string]*dependency, pkg string) string {
+	best := ""
+	for p := range deps {
+		if (pkg == p || strings.HasPrefix(pkg, p+"/")) && len(p) > len(best) {
+			best = p
+		}
+	}
+	return best
+}
+
+// PackageURL returns the package URL of a version of a Go module, such as
+// "pkg:golang/github.com/google/go-github/v75@v75.0.0".
+func PackageURL(modulePath, version string) string {
+	segs := strings.Split(modulePath, "/")
+	for i, s := range segs {
+		segs[i] = escape(s)
+	}
+	return "pkg:golang/" + strings.Join(segs, "/") + "@" + escape(version)
+}
+
+// escape percent-encodes the characters of s other than letters, digits and
+// ".-_~", as package URLs require.
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.IndexByte(".-_~", c) >= 0 {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
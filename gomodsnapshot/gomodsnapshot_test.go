@@ -0,0 +1,248 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gomodsnapshot
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v75/github"
+)
+
+// dep returns a resolved dependency, for comparisons.
+func dep(purl, relationship, scope string) *github.DependencyGraphSnapshotResolvedDependency {
+	return &github.DependencyGraphSnapshotResolvedDependency{
+		PackageURL:   github.Ptr(purl),
+		Relationship: github.Ptr(relationship),
+		Scope:        github.Ptr(scope),
+	}
+}
+
+const testGoMod = `module example.com/mono/api
+
+go 1.24.0
+
+require (
+	github.com/google/go-cmp v0.7.0
+	example.com/mono/lib v0.0.0
+	github.com/old/name v1.0.0
+)
+
+require (
+	github.com/google/go-querystring v1.1.0 // indirect
+	golang.org/x/tools v0.30.0 // indirect
+	github.com/Masterminds/semver v1.5.0+incompatible // indirect
+)
+
+tool golang.org/x/tools/cmd/stringer
+
+replace example.com/mono/lib => ../lib
+
+replace github.com/old/name => github.com/new/name v1.2.0
+`
+
+func TestManifest(t *testing.T) {
+	t.Parallel()
+	m := &Module{
+		Path:        "api/go.mod",
+		GoMod:       []byte(testGoMod),
+		GoSum:       []byte("github.com/unused/mod v1.0.0 h1:x=\n"),
+		Development: []string{"github.com/google/go-cmp"},
+	}
+	got, err := Manifest(m)
+	if err != nil {
+		t.Fatalf("Manifest returned error: %v", err)
+	}
+	want := &github.DependencyGraphSnapshotManifest{
+		Name: github.Ptr("api/go.mod"),
+		File: &github.DependencyGraphSnapshotManifestFile{SourceLocation: github.Ptr("api/go.mod")},
+		Resolved: map[string]*github.DependencyGraphSnapshotResolvedDependency{
+			"github.com/google/go-cmp":         dep("pkg:golang/github.com/google/go-cmp@v0.7.0", "direct", "development"),
+			"github.com/old/name":              dep("pkg:golang/github.com/new/name@v1.2.0", "direct", "runtime"),
+			"github.com/google/go-querystring": dep("pkg:golang/github.com/google/go-querystring@v1.1.0", "indirect", "runtime"),
+			"golang.org/x/tools":               dep("pkg:golang/golang.org/x/tools@v0.30.0", "indirect", "development"),
+			"github.com/Masterminds/semver":    dep("pkg:golang/github.com/Masterminds/semver@v1.5.0%2Bincompatible", "indirect", "runtime"),
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Manifest returned diff: %v", cmp.Diff(want, got))
+	}
+}
+
+func TestManifest_goSum(t *testing.T) {
+	t.Parallel()
+	m := &Module{
+		Path: "go.mod",
+		GoMod: []byte(`module example.com/old
+go 1.16
+require github.com/a/a v1.0.0
+`),
+		GoSum: []byte(`github.com/a/a v1.0.0 h1:a=
+github.com/a/a v1.0.0/go.mod h1:a=
+github.com/b/b v1.1.0 h1:b=
+github.com/b/b v1.1.0/go.mod h1:b=
+github.com/b/b v1.0.0 h1:b=
+github.com/c/c v1.0.0/go.mod h1:c=
+`),
+	}
+	got, err := Manifest(m)
+	if err != nil {
+		t.Fatalf("Manifest returned error: %v", err)
+	}
+	want := map[string]*github.DependencyGraphSnapshotResolvedDependency{
+		"github.com/a/a": dep("pkg:golang/github.com/a/a@v1.0.0", "direct", "runtime"),
+		"github.com/b/b": dep("pkg:golang/github.com/b/b@v1.1.0", "indirect", "runtime"),
+	}
+	if !cmp.Equal(got.Resolved, want) {
+		t.Errorf("Manifest returned diff: %v", cmp.Diff(want, got.Resolved))
+	}
+
+	m.GoSum = []byte("github.com/a/a v1.0.0\n")
+	if _, err := Manifest(m); err == nil {
+		t.Error("Manifest returned nil error for a malformed go.sum")
+	}
+}
+
+func TestManifest_list(t *testing.T) {
+	t.Parallel()
+	m := &Module{
+		Path:  "go.mod",
+		GoMod: []byte(testGoMod),
+		List: []byte(`{"Path": "example.com/mono/api", "Main": true, "Dir": "/src/api"}
+{"Path": "example.com/mono/lib", "Version": "v0.0.0", "Replace": {"Path": "../lib", "Dir": "/src/lib"}}
+{"Path": "github.com/google/go-cmp", "Version": "v0.7.0"}
+{"Path": "github.com/old/name", "Version": "v1.0.0", "Replace": {"Path": "github.com/new/name", "Version": "v1.2.0"}}
+{"Path": "golang.org/x/sync", "Version": "v0.10.0", "Indirect": true}
+{"Path": "golang.org/x/tools", "Version": "v0.31.0", "Indirect": true}
+`),
+	}
+	got, err := Manifest(m)
+	if err != nil {
+		t.Fatalf("Manifest returned error: %v", err)
+	}
+	want := map[string]*github.DependencyGraphSnapshotResolvedDependency{
+		"github.com/google/go-cmp": dep("pkg:golang/github.com/google/go-cmp@v0.7.0", "direct", "runtime"),
+		"github.com/old/name":      dep("pkg:golang/github.com/new/name@v1.2.0", "direct", "runtime"),
+		"golang.org/x/sync":        dep("pkg:golang/golang.org/x/sync@v0.10.0", "indirect", "runtime"),
+		"golang.org/x/tools":       dep("pkg:golang/golang.org/x/tools@v0.31.0", "indirect", "development"),
+	}
+	if !cmp.Equal(got.Resolved, want) {
+		t.Errorf("Manifest returned diff: %v", cmp.Diff(want, got.Resolved))
+	}
+
+	m.List = []byte("{")
+	if _, err := Manifest(m); err == nil {
+		t.Error("Manifest returned nil error for a malformed list")
+	}
+
+	// Before Go 1.17, go.mod files do not list all the dependencies, and
+	// go list does not mark the missing ones as indirect.
+	m = &Module{
+		Path: "go.mod",
+		GoMod: []byte(`module example.com/old
+go 1.16
+require (
+	github.com/a/a v1.0.0
+	github.com/c/c v1.0.0 // indirect
+)
+`),
+		List: []byte(`{"Path": "example.com/old", "Main": true, "Dir": "/src/old"}
+{"Path": "github.com/a/a", "Version": "v1.0.0"}
+{"Path": "github.com/b/b", "Version": "v1.1.0"}
+{"Path": "github.com/c/c", "Version": "v1.0.0", "Indirect": true}
+`),
+	}
+	got, err = Manifest(m)
+	if err != nil {
+		t.Fatalf("Manifest returned error: %v", err)
+	}
+	want = map[string]*github.DependencyGraphSnapshotResolvedDependency{
+		"github.com/a/a": dep("pkg:golang/github.com/a/a@v1.0.0", "direct", "runtime"),
+		"github.com/b/b": dep("pkg:golang/github.com/b/b@v1.1.0", "indirect", "runtime"),
+		"github.com/c/c": dep("pkg:golang/github.com/c/c@v1.0.0", "indirect", "runtime"),
+	}
+	if !cmp.Equal(got.Resolved, want) {
+		t.Errorf("Manifest returned diff: %v", cmp.Diff(want, got.Resolved))
+	}
+}
+
+func TestManifest_invalid(t *testing.T) {
+	t.Parallel()
+	for _, goMod := range []string{"modul x", "go 1.24\n"} {
+		if _, err := Manifest(&Module{Path: "go.mod", GoMod: []byte(goMod)}); err == nil {
+			t.Errorf("Manifest(%q) returned nil error", goMod)
+		}
+	}
+}
+
+func TestLoadAndSnapshot(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	for name, content := range map[string]string{
+		"go.mod":     "module example.com/root\n\ngo 1.24\n\nrequire github.com/a/a v1.0.0\n",
+		"go.sum":     "github.com/a/a v1.0.0 h1:a=\n",
+		"lib/go.mod": "module example.com/root/lib\n\ngo 1.24\n",
+	} {
+		p := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rootMod, err := Load(root, ".")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if rootMod.Path != "go.mod" || len(rootMod.GoSum) == 0 {
+		t.Errorf("Load returned %+v, want go.mod with go.sum", rootMod)
+	}
+	lib, err := Load(root, "lib")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if lib.Path != "lib/go.mod" || lib.GoSum != nil {
+		t.Errorf("Load returned %+v, want lib/go.mod without go.sum", lib)
+	}
+	if _, err := Load(root, "missing"); err == nil {
+		t.Error("Load returned nil error for a missing module")
+	}
+
+	job := &github.DependencyGraphSnapshotJob{Correlator: github.Ptr("c"), ID: github.Ptr("1")}
+	s, err := Snapshot(job, "sha", "refs/heads/main", rootMod, lib)
+	if err != nil {
+		t.Fatalf("Snapshot returned error: %v", err)
+	}
+	if s.GetSha() != "sha" || s.GetRef() != "refs/heads/main" || s.Job != job || s.Scanned == nil ||
+		s.GetDetector().GetName() != "go-github/gomodsnapshot" || s.GetDetector().GetVersion() != github.Version {
+		t.Errorf("Snapshot returned %+v", s)
+	}
+	if len(s.Manifests) != 2 || len(s.Manifests["go.mod"].Resolved) != 1 || len(s.Manifests["lib/go.mod"].Resolved) != 0 {
+		t.Errorf("Snapshot returned manifests %+v", s.Manifests)
+	}
+
+	if _, err := Snapshot(job, "sha", "ref", lib, lib); err == nil || !strings.Contains(err.Error(), "duplicate") {
+		t.Errorf("Snapshot returned error %v, want duplicate module", err)
+	}
+}
+
+func TestPackageURL(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct{ path, version, want string }{
+		{"github.com/google/go-github/v75", "v75.0.0", "pkg:golang/github.com/google/go-github/v75@v75.0.0"},
+		{"github.com/Masterminds/semver", "v1.5.0+incompatible", "pkg:golang/github.com/Masterminds/semver@v1.5.0%2Bincompatible"},
+		{"example.com/a b", "v0.0.0-20250101000000-abcdef123456", "pkg:golang/example.com/a%20b@v0.0.0-20250101000000-abcdef123456"},
+	} {
+		if got := PackageURL(tt.path, tt.version); got != tt.want {
+			t.Errorf("PackageURL(%q, %q) = %q, want %q", tt.path, tt.version, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package otelobserver provides a github.Observer that records a span for
+// each request sent by a github.Client, along with request latency and rate
+// limit metrics, with OpenTelemetry.
+//
+// Usage:
+//
+//	obs, err := otelobserver.New()
+//	if err != nil {
+//		// Handle error.
+//	}
+//	client := github.NewClient(nil)
+//	client.Observer = obs
+package otelobserver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-github/v75/github"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// scope is the instrumentation scope of the spans and metrics.
+const scope = "github.com/google/go-github/v75/observability/otelobserver"
+
+// Attribute keys of the spans and metrics, in addition to the HTTP semantic
+// conventions.
+const (
+	// RateLimitCategoryKey is the rate limit category of a request, such as
+	// "core" or "search".
+	RateLimitCategoryKey = attribute.Key("github.rate_limit.category")
+
+	// RateLimitRemainingKey is the remaining rate limit budget after a
+	// request.
+	RateLimitRemainingKey = attribute.Key("github.rate_limit.remaining")
+
+	// OperationIDKey is the ID of the API operation of a request in
+	// GitHub's OpenAPI description, such as "repos/get-content".
+	OperationIDKey = attribute.Key("github.operation.id")
+
+	// CacheStatusKey is how the client's cache was used for a request.
+	CacheStatusKey = attribute.Key("github.cache.status")
+)
+
+// Observer is a github.Observer recording requests with OpenTelemetry.
+//
+// For each request, it records a client span named after the method and the
+// route, such as "GET /repos/{owner}/{repo}/issues", and a measurement of the
+// "http.client.request.duration" histogram. It also records the remaining
+// budget and the limit of each rate limit category in the
+// "github.rate_limit.remaining" and "github.rate_limit.limit" gauges.
+type Observer struct {
+	tracer    trace.Tracer
+	duration  metric.Float64Histogram
+	remaining metric.Int64Gauge
+	limit     metric.Int64Gauge
+}
+
+// Option configures an Observer.
+type Option func(*options)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the TracerProvider used to create spans. It
+// defaults to the global TracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *options) { o.tracerProvider = tp }
+}
+
+// WithMeterProvider sets the MeterProvider used to record metrics. It
+// defaults to the global MeterProvider.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(o *options) { o.meterProvider = mp }
+}
+
+// New returns a new Observer.
+func New(opts ...Option) (*Observer, error) {
+	o := &options{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	meter := o.meterProvider.Meter(scope)
+	duration, err := meter.Float64Histogram("http.client.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of GitHub API requests."),
+		metric.WithExplicitBucketBoundaries(0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10))
+	if err != nil {
+		return nil, err
+	}
+	remaining, err := meter.Int64Gauge("github.rate_limit.remaining",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Remaining GitHub API rate limit budget."))
+	if err != nil {
+		return nil, err
+	}
+	limit, err := meter.Int64Gauge("github.rate_limit.limit",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("GitHub API rate limit."))
+	if err != nil {
+		return nil, err
+	}
+	return &Observer{
+		tracer:    o.tracerProvider.Tracer(scope),
+		duration:  duration,
+		remaining: remaining,
+		limit:     limit,
+	}, nil
+}
+
+// StartRequest starts the span of a request.
+func (o *Observer) StartRequest(ctx context.Context, r *github.ObservedRequest) context.Context {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", r.Method),
+		attribute.String("url.full", r.URL.String()),
+		attribute.String("server.address", r.URL.Hostname()),
+		RateLimitCategoryKey.String(r.Category.String()),
+	}
+	if r.Route != "" {
+		attrs = append(attrs, attribute.String("http.route", r.Route))
+	}
+	if r.OperationID != "" {
+		attrs = append(attrs, OperationIDKey.String(r.OperationID))
+	}
+	ctx, _ = o.tracer.Start(ctx, spanName(r),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...))
+	return ctx
+}
+
+// EndRequest ends the span of a request and records its metrics.
+func (o *Observer) EndRequest(ctx context.Context, r *github.ObservedRequest) {
+	span := trace.SpanFromContext(ctx)
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", r.Method),
+		RateLimitCategoryKey.String(r.Category.String()),
+	}
+	if r.Route != "" {
+		attrs = append(attrs, attribute.String("http.route", r.Route))
+	}
+	if r.StatusCode != 0 {
+		attrs = append(attrs, attribute.Int("http.response.status_code", r.StatusCode))
+	}
+	if r.Err != nil {
+		attrs = append(attrs, attribute.String("error.type", errorType(r)))
+		span.RecordError(r.Err)
+		span.SetStatus(codes.Error, r.Err.Error())
+	}
+	o.duration.Record(ctx, r.Duration.Seconds(), metric.WithAttributes(attrs...))
+
+	if r.StatusCode != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", r.StatusCode))
+	}
+	if r.CacheStatus != github.CacheStatusNone {
+		span.SetAttributes(CacheStatusKey.String(string(r.CacheStatus)))
+	}
+	if r.Rate.Limit > 0 {
+		span.SetAttributes(RateLimitRemainingKey.Int(r.Rate.Remaining))
+		category := metric.WithAttributes(RateLimitCategoryKey.String(r.Category.String()))
+		o.remaining.Record(ctx, int64(r.Rate.Remaining), category)
+		o.limit.Record(ctx, int64(r.Rate.Limit), category)
+	}
+	span.End()
+}
+
+// spanName returns the name of the span of a request, following the HTTP
+// semantic conventions.
+func spanName(r *github.ObservedRequest) string {
+	if r.Route == "" {
+		return r.Method
+	}
+	return r.Method + " " + r.Route
+}
+
+// errorType returns the type of the error of a request, which is its status
+// code if it has one.
+func errorType(r *github.ObservedRequest) string {
+	if r.StatusCode >= 400 {
+		return strconv.Itoa(r.StatusCode)
+	}
+	return "_OTHER"
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package otelobserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v75/github"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestObserver(t *testing.T) {
+	t.Parallel()
+	spans := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	obs, err := New(WithTracerProvider(tp), WithMeterProvider(mp))
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	var spanInRequest bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/o/r/issues/1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("X-Ratelimit-Limit", "5000")
+		w.Header().Set("X-Ratelimit-Remaining", "4999")
+		w.Header().Set("X-Ratelimit-Resource", "core")
+		fmt.Fprint(w, `[]`)
+	}))
+	t.Cleanup(srv.Close)
+	client := github.NewClient(&http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			spanInRequest = trace.SpanFromContext(req.Context()).SpanContext().IsValid()
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	})
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+	client.Observer = obs
+
+	ctx := context.Background()
+	if _, _, err := client.Issues.ListByRepo(ctx, "o", "r", nil); err != nil {
+		t.Fatalf("ListByRepo returned error: %v", err)
+	}
+	if !spanInRequest {
+		t.Error("The request was not sent with the context of the span")
+	}
+	if _, _, err := client.Issues.Get(ctx, "o", "r", 1); err == nil {
+		t.Fatal("Get returned nil error")
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("Recorded %v spans, want 2", len(ended))
+	}
+	if got := ended[0].Name(); got != "GET /repos/{owner}/{repo}/issues" {
+		t.Errorf("Span name = %q, want GET /repos/{owner}/{repo}/issues", got)
+	}
+	if ended[0].SpanKind() != trace.SpanKindClient {
+		t.Errorf("Span kind = %v, want client", ended[0].SpanKind())
+	}
+	want := map[attribute.Key]attribute.Value{
+		"http.request.method":       attribute.StringValue("GET"),
+		"http.route":                attribute.StringValue("/repos/{owner}/{repo}/issues"),
+		"http.response.status_code": attribute.IntValue(200),
+		RateLimitCategoryKey:        attribute.StringValue("core"),
+		RateLimitRemainingKey:       attribute.IntValue(4999),
+	}
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range ended[0].Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	for k, v := range want {
+		if attrs[k] != v {
+			t.Errorf("Span attribute %v = %v, want %v", k, attrs[k].Emit(), v.Emit())
+		}
+	}
+	if got := ended[1].Status().Code; got != codes.Error {
+		t.Errorf("Span status of failed request = %v, want error", got)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatalf("Collect returned error: %v", err)
+	}
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	hist, ok := metrics["http.client.request.duration"].(metricdata.Histogram[float64])
+	if !ok || len(hist.DataPoints) != 2 {
+		t.Errorf("http.client.request.duration = %v, want a histogram with 2 data points", metrics["http.client.request.duration"])
+	}
+	remaining, ok := metrics["github.rate_limit.remaining"].(metricdata.Gauge[int64])
+	if !ok || len(remaining.DataPoints) != 1 || remaining.DataPoints[0].Value != 4999 {
+		t.Errorf("github.rate_limit.remaining = %v, want 4999", metrics["github.rate_limit.remaining"])
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r)
+}
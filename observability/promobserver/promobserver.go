@@ -0,0 +1,96 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package promobserver provides a github.Observer that records request
+// latency and rate limit metrics for the requests sent by a github.Client,
+// with Prometheus.
+//
+// Usage:
+//
+//	obs, err := promobserver.New(prometheus.DefaultRegisterer)
+//	if err != nil {
+//		// Handle error.
+//	}
+//	client := github.NewClient(nil)
+//	client.Observer = obs
+package promobserver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-github/v75/github"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Observer is a github.Observer recording metrics with Prometheus.
+//
+// It records the following metrics:
+//
+//   - github_request_duration_seconds, a histogram of the duration of
+//     requests, by method, route, status code and rate limit category. The
+//     status code is "error" for requests that received no response.
+//   - github_rate_limit_remaining, a gauge of the remaining budget of each
+//     rate limit category, as of the latest response.
+//   - github_rate_limit_limit, a gauge of the limit of each rate limit
+//     category, as of the latest response.
+//   - github_rate_limit_reset_timestamp_seconds, a gauge of the time at which
+//     each rate limit category resets, as of the latest response.
+type Observer struct {
+	duration  *prometheus.HistogramVec
+	remaining *prometheus.GaugeVec
+	limit     *prometheus.GaugeVec
+	reset     *prometheus.GaugeVec
+}
+
+// New returns a new Observer, whose metrics are registered with reg.
+func New(reg prometheus.Registerer) (*Observer, error) {
+	o := &Observer{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "github_request_duration_seconds",
+			Help:    "Duration of GitHub API requests.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "code", "category"}),
+		remaining: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "github_rate_limit_remaining",
+			Help: "Remaining GitHub API rate limit budget.",
+		}, []string{"category"}),
+		limit: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "github_rate_limit_limit",
+			Help: "GitHub API rate limit.",
+		}, []string{"category"}),
+		reset: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "github_rate_limit_reset_timestamp_seconds",
+			Help: "Time at which the GitHub API rate limit resets.",
+		}, []string{"category"}),
+	}
+	for _, c := range []prometheus.Collector{o.duration, o.remaining, o.limit, o.reset} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return o, nil
+}
+
+// StartRequest returns ctx unchanged.
+func (o *Observer) StartRequest(ctx context.Context, _ *github.ObservedRequest) context.Context {
+	return ctx
+}
+
+// EndRequest records the metrics of a request.
+func (o *Observer) EndRequest(_ context.Context, r *github.ObservedRequest) {
+	code := "error"
+	if r.StatusCode != 0 {
+		code = strconv.Itoa(r.StatusCode)
+	}
+	category := r.Category.String()
+	o.duration.WithLabelValues(r.Method, r.Route, code, category).Observe(r.Duration.Seconds())
+
+	if r.Rate.Limit > 0 {
+		o.remaining.WithLabelValues(category).Set(float64(r.Rate.Remaining))
+		o.limit.WithLabelValues(category).Set(float64(r.Rate.Limit))
+		o.reset.WithLabelValues(category).Set(float64(r.Rate.Reset.Unix()))
+	}
+}
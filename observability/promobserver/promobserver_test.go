@@ -0,0 +1,103 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package promobserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v75/github"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestObserver(t *testing.T) {
+	t.Parallel()
+	reg := prometheus.NewRegistry()
+	obs, err := New(reg)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/search/issues" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("X-Ratelimit-Limit", "5000")
+		w.Header().Set("X-Ratelimit-Remaining", "4999")
+		w.Header().Set("X-Ratelimit-Reset", "1735689600")
+		w.Header().Set("X-Ratelimit-Resource", "core")
+		fmt.Fprint(w, `[]`)
+	}))
+	t.Cleanup(srv.Close)
+	client := github.NewClient(srv.Client())
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+	client.Observer = obs
+
+	ctx := context.Background()
+	if _, _, err := client.Issues.ListByRepo(ctx, "o", "r", nil); err != nil {
+		t.Fatalf("ListByRepo returned error: %v", err)
+	}
+	if _, _, err := client.Search.Issues(ctx, "q", nil); err == nil {
+		t.Fatal("Search.Issues returned nil error")
+	}
+
+	if got := testutil.CollectAndCount(reg, "github_request_duration_seconds"); got != 2 {
+		t.Errorf("github_request_duration_seconds has %v series, want 2", got)
+	}
+	const want = `
+# HELP github_rate_limit_remaining Remaining GitHub API rate limit budget.
+# TYPE github_rate_limit_remaining gauge
+github_rate_limit_remaining{category="core"} 4999
+# HELP github_rate_limit_reset_timestamp_seconds Time at which the GitHub API rate limit resets.
+# TYPE github_rate_limit_reset_timestamp_seconds gauge
+github_rate_limit_reset_timestamp_seconds{category="core"} 1.7356896e+09
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "github_rate_limit_remaining", "github_rate_limit_reset_timestamp_seconds"); err != nil {
+		t.Error(err)
+	}
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather returned error: %v", err)
+	}
+	var labels []string
+	for _, f := range families {
+		if f.GetName() != "github_request_duration_seconds" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			var l []string
+			for _, lp := range m.GetLabel() {
+				l = append(l, lp.GetName()+"="+lp.GetValue())
+			}
+			labels = append(labels, strings.Join(l, ","))
+		}
+	}
+	wantLabels := []string{
+		"category=core,code=200,method=GET,route=/repos/{owner}/{repo}/issues",
+		"category=search,code=404,method=GET,route=/search/issues",
+	}
+	if strings.Join(labels, " ") != strings.Join(wantLabels, " ") {
+		t.Errorf("github_request_duration_seconds labels = %v, want %v", labels, wantLabels)
+	}
+}
+
+func TestNew_alreadyRegistered(t *testing.T) {
+	t.Parallel()
+	reg := prometheus.NewRegistry()
+	if _, err := New(reg); err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	if _, err := New(reg); err == nil {
+		t.Error("New returned nil error for metrics that are already registered")
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sarif provides the types of the SARIF 2.1.0 format used to upload
+// code scanning results with github.CodeScanningService.UploadSarif, and
+// encodes them as that method expects.
+//
+// Only the parts of the format that GitHub code scanning uses are provided.
+// See https://docs.github.com/code-security/code-scanning/integrating-with-code-scanning/sarif-support-for-code-scanning
+//
+// Usage:
+//
+//	log := sarif.NewLog(&sarif.Run{
+//		Tool: sarif.Tool{Driver: sarif.ToolComponent{
+//			Name:  "mylinter",
+//			Rules: []*sarif.ReportingDescriptor{{ID: "ML001", ShortDescription: &sarif.MultiformatMessageString{Text: "Unchecked error"}}},
+//		}},
+//		Results: []*sarif.Result{{
+//			RuleID:    "ML001",
+//			Level:     sarif.LevelError,
+//			Message:   sarif.Message{Text: "The error returned by Close is not checked."},
+//			Locations: []*sarif.Location{sarif.NewLocation("main.go", 42)},
+//		}},
+//	})
+//	encoded, err := sarif.Encode(log)
+//	if err != nil {
+//		// Handle error.
+//	}
+//	id, _, err := client.CodeScanning.UploadSarif(ctx, owner, repo, &github.SarifAnalysis{
+//		CommitSHA: github.Ptr(sha),
+//		Ref:       github.Ptr("refs/heads/main"),
+//		Sarif:     github.Ptr(encoded),
+//	})
+package sarif
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// Version is the version of the SARIF format of the types of this
+	// package.
+	Version = "2.1.0"
+
+	// Schema is the URI of the JSON schema of the SARIF format.
+	Schema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	// MaxEncodedSize is the maximum size of an encoded log that GitHub
+	// accepts, after compression.
+	MaxEncodedSize = 10 << 20
+)
+
+// The levels of a Result or a ReportingConfiguration.
+const (
+	LevelNone    = "none"
+	LevelNote    = "note"
+	LevelWarning = "warning"
+	LevelError   = "error"
+)
+
+// ErrTooLarge is returned by Encode when the compressed log exceeds
+// MaxEncodedSize.
+var ErrTooLarge = errors.New("sarif: compressed log exceeds the maximum size of 10 MB")
+
+// PropertyBag holds the custom properties of an object, such as the "tags"
+// and "security-severity" properties of rules.
+type PropertyBag map[string]any
+
+// Log is the top-level object of a SARIF file.
+type Log struct {
+	Version string `json:"version"`
+	Schema  string `json:"$schema,omitempty"`
+	Runs    []*Run `json:"runs"`
+}
+
+// Run is a single invocation of an analysis tool.
+type Run struct {
+	Tool    Tool      `json:"tool"`
+	Results []*Result `json:"results"`
+
+	// AutomationDetails identifies the analysis, and its ID is used as the
+	// category of the analysis by GitHub.
+	AutomationDetails *RunAutomationDetails `json:"automationDetails,omitempty"`
+
+	// OriginalURIBaseIDs maps the URIBaseID of artifact locations, such as
+	// "%SRCROOT%", to their location.
+	OriginalURIBaseIDs map[string]*ArtifactLocation `json:"originalUriBaseIds,omitempty"`
+
+	Properties PropertyBag `json:"properties,omitempty"`
+}
+
+// RunAutomationDetails identifies a run, such as "lint/go/" for its
+// category followed by a run ID.
+type RunAutomationDetails struct {
+	ID string `json:"id,omitempty"`
+}
+
+// Tool is the analysis tool of a run.
+type Tool struct {
+	Driver     ToolComponent    `json:"driver"`
+	Extensions []*ToolComponent `json:"extensions,omitempty"`
+}
+
+// ToolComponent is a component of an analysis tool, with the rules it
+// checks.
+type ToolComponent struct {
+	Name            string                 `json:"name"`
+	Version         string                 `json:"version,omitempty"`
+	SemanticVersion string                 `json:"semanticVersion,omitempty"`
+	InformationURI  string                 `json:"informationUri,omitempty"`
+	Rules           []*ReportingDescriptor `json:"rules,omitempty"`
+}
+
+// ReportingDescriptor describes a rule of an analysis tool.
+type ReportingDescriptor struct {
+	ID                   string                    `json:"id"`
+	Name                 string                    `json:"name,omitempty"`
+	ShortDescription     *MultiformatMessageString `json:"shortDescription,omitempty"`
+	FullDescription      *MultiformatMessageString `json:"fullDescription,omitempty"`
+	Help                 *MultiformatMessageString `json:"help,omitempty"`
+	HelpURI              string                    `json:"helpUri,omitempty"`
+	DefaultConfiguration *ReportingConfiguration   `json:"defaultConfiguration,omitempty"`
+	Properties           PropertyBag               `json:"properties,omitempty"`
+}
+
+// ReportingConfiguration is the default configuration of a rule.
+type ReportingConfiguration struct {
+	// Level is the default level of the results of the rule, one of
+	// LevelNone, LevelNote, LevelWarning or LevelError.
+	Level string `json:"level,omitempty"`
+}
+
+// Result is a result of an analysis, such as a problem found in the code.
+type Result struct {
+	RuleID    string `json:"ruleId,omitempty"`
+	RuleIndex *int   `json:"ruleIndex,omitempty"`
+
+	// Level is the level of the result, one of LevelNone, LevelNote,
+	// LevelWarning or LevelError. Defaults to the level of the rule.
+	Level string `json:"level,omitempty"`
+
+	Message          Message     `json:"message"`
+	Locations        []*Location `json:"locations,omitempty"`
+	RelatedLocations []*Location `json:"relatedLocations,omitempty"`
+
+	// PartialFingerprints are used by GitHub to track the result across
+	// runs, such as with the "primaryLocationLineHash" key. GitHub computes
+	// them when they are missing and the source is available.
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+	Fingerprints        map[string]string `json:"fingerprints,omitempty"`
+
+	Properties PropertyBag `json:"properties,omitempty"`
+}
+
+// Message is a message of a result or a location.
+type Message struct {
+	Text      string   `json:"text,omitempty"`
+	Markdown  string   `json:"markdown,omitempty"`
+	ID        string   `json:"id,omitempty"`
+	Arguments []string `json:"arguments,omitempty"`
+}
+
+// MultiformatMessageString is a message in plain text, and optionally in
+// Markdown.
+type MultiformatMessageString struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+// Location is a location in the code.
+type Location struct {
+	ID               *int              `json:"id,omitempty"`
+	PhysicalLocation *PhysicalLocation `json:"physicalLocation,omitempty"`
+	Message          *Message          `json:"message,omitempty"`
+}
+
+// PhysicalLocation is a region of a file.
+type PhysicalLocation struct {
+	ArtifactLocation *ArtifactLocation `json:"artifactLocation,omitempty"`
+	Region           *Region           `json:"region,omitempty"`
+}
+
+// ArtifactLocation is the location of a file.
+type ArtifactLocation struct {
+	// URI is the path of the file, relative to the root of the repository
+	// unless URIBaseID is set.
+	URI       string `json:"uri,omitempty"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+	Index     *int   `json:"index,omitempty"`
+}
+
+// Region is a region of a file. Lines and columns start at 1.
+type Region struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// NewLog returns a log of the given runs, with the current Version and
+// Schema.
+func NewLog(runs ...*Run) *Log {
+	return &Log{Version: Version, Schema: Schema, Runs: runs}
+}
+
+// NewLocation returns the location of a line of a file, whose path is
+// relative to the root of the repository.
+func NewLocation(path string, line int) *Location {
+	return &Location{PhysicalLocation: &PhysicalLocation{
+		ArtifactLocation: &ArtifactLocation{URI: path},
+		Region:           &Region{StartLine: line},
+	}}
+}
+
+// Encode returns log as the value of github.SarifAnalysis.Sarif: encoded
+// as JSON, compressed with gzip, and encoded in base64.
+//
+// It returns ErrTooLarge if the compressed log exceeds the size accepted by
+// GitHub.
+func Encode(log *Log) (string, error) {
+	// SARIF requires an array of results, even if empty.
+	runs := make([]*Run, len(log.Runs))
+	for i, r := range log.Runs {
+		if r != nil && r.Results == nil {
+			c := *r
+			c.Results = []*Result{}
+			r = &c
+		}
+		runs[i] = r
+	}
+	l := *log
+	l.Runs = runs
+	data, err := json.Marshal(&l)
+	if err != nil {
+		return "", err
+	}
+	return EncodeJSON(data)
+}
+
+// EncodeJSON returns the SARIF file data, such as one written by an
+// analysis tool, as the value of github.SarifAnalysis.Sarif. See Encode.
+func EncodeJSON(data []byte) (string, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	if buf.Len() > MaxEncodedSize {
+		return "", ErrTooLarge
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Decode decodes a log encoded by Encode.
+func Decode(s string) (*Log, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("sarif: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("sarif: %w", err)
+	}
+	data, err = io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("sarif: %w", err)
+	}
+	log := new(Log)
+	if err := json.Unmarshal(data, log); err != nil {
+		return nil, fmt.Errorf("sarif: %w", err)
+	}
+	return log, nil
+}
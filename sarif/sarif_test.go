@@ -0,0 +1,110 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sarif
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func decodeJSON(t *testing.T, s string) string {
+	t.Helper()
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+	log := NewLog(&Run{
+		Tool: Tool{Driver: ToolComponent{
+			Name:  "lint",
+			Rules: []*ReportingDescriptor{{ID: "L1", DefaultConfiguration: &ReportingConfiguration{Level: LevelWarning}}},
+		}},
+		Results: []*Result{{
+			RuleID:    "L1",
+			Level:     LevelError,
+			Message:   Message{Text: "bad"},
+			Locations: []*Location{NewLocation("a/b.go", 3)},
+		}},
+		AutomationDetails: &RunAutomationDetails{ID: "lint/"},
+	})
+
+	s, err := Encode(log)
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+	want := `{"version":"2.1.0","$schema":"https://json.schemastore.org/sarif-2.1.0.json","runs":[{"tool":{"driver":{"name":"lint","rules":[{"id":"L1","defaultConfiguration":{"level":"warning"}}]}},"results":[{"ruleId":"L1","level":"error","message":{"text":"bad"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"a/b.go"},"region":{"startLine":3}}}]}],"automationDetails":{"id":"lint/"}}]}`
+	if got := decodeJSON(t, s); got != want {
+		t.Errorf("Encode encoded %v, want %v", got, want)
+	}
+
+	got, err := Decode(s)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if !cmp.Equal(got, log) {
+		t.Errorf("Decode returned diff: %v", cmp.Diff(log, got))
+	}
+}
+
+func TestEncode_emptyResults(t *testing.T) {
+	t.Parallel()
+	run := &Run{Tool: Tool{Driver: ToolComponent{Name: "lint"}}}
+	s, err := Encode(NewLog(run))
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+	want := `{"version":"2.1.0","$schema":"https://json.schemastore.org/sarif-2.1.0.json","runs":[{"tool":{"driver":{"name":"lint"}},"results":[]}]}`
+	if got := decodeJSON(t, s); got != want {
+		t.Errorf("Encode encoded %v, want %v", got, want)
+	}
+	if run.Results != nil {
+		t.Error("Encode modified the run")
+	}
+}
+
+func TestEncodeJSON_tooLarge(t *testing.T) {
+	t.Parallel()
+	data := make([]byte, MaxEncodedSize+1)
+	rand.Read(data)
+	if _, err := EncodeJSON(data); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("EncodeJSON returned error %v, want ErrTooLarge", err)
+	}
+}
+
+func TestDecode_invalid(t *testing.T) {
+	t.Parallel()
+	for _, s := range []string{"!", base64.StdEncoding.EncodeToString([]byte("not gzip"))} {
+		if _, err := Decode(s); err == nil {
+			t.Errorf("Decode(%q) returned nil error", s)
+		}
+	}
+	s, err := EncodeJSON([]byte("{"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Decode(s); err == nil {
+		t.Error("Decode returned nil error for invalid JSON")
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/google/go-github/v75/github"
+)
+
+const (
+	// maxResults is the maximum number of results that GitHub returns for a
+	// search query.
+	maxResults = 1000
+
+	// perPage is the maximum page size of the search endpoints.
+	perPage = 100
+
+	// maxAbuseRetries is the number of times a request is retried after a
+	// secondary rate limit error.
+	maxAbuseRetries = 5
+
+	defaultAbuseRetryAfter = time.Minute
+
+	// rangeTimeLayout formats the bounds of the ranges by which queries are
+	// partitioned, always including the time of day.
+	rangeTimeLayout = "2006-01-02T15:04:05-07:00"
+)
+
+// dateQualifiers are the qualifiers by which a query may be partitioned.
+var dateQualifiers = map[string]bool{
+	"created":        true,
+	"updated":        true,
+	"closed":         true,
+	"merged":         true,
+	"pushed":         true,
+	"author-date":    true,
+	"committer-date": true,
+}
+
+// ErrTooManyResults is returned by the All functions, after all other
+// results, when more than 1000 results were created or updated within a
+// single second, so that some of them could not be returned.
+var ErrTooManyResults = errors.New("search: more than 1000 results in a single second")
+
+// AllOptions specifies optional parameters to the All functions.
+type AllOptions struct {
+	// Qualifier is the date qualifier by which the query is partitioned,
+	// such as "created" or "updated". It must be valid for the kind of the
+	// query, and the query must not already use it. Defaults to "created",
+	// or "author-date" for commits.
+	Qualifier string
+
+	// Since and Until bound the dates searched, Since inclusively and Until
+	// exclusively. Since defaults to GitHub's launch in 2007, and Until to
+	// the current time.
+	Since, Until time.Time
+
+	// TextMatch retrieves text match metadata with the results.
+	TextMatch bool
+}
+
+// AllIssues returns an iterator over all the issues and pull requests
+// matching q, which must be of KindIssues.
+//
+// GitHub returns at most 1000 results for a search query. To get past that
+// limit, the query is partitioned into date ranges of the qualifier given by
+// opts, which are bisected until each one has at most 1000 results.
+// Results are yielded as they are received, from the oldest date range to
+// the newest, and results seen in an earlier range or page are skipped.
+//
+// Requests wait for the primary rate limit of the search category to reset
+// when it is exceeded, and are retried after secondary rate limit errors.
+// Unless the client has a Throttle, they are also paced to spread the
+// remaining budget of the search category until it resets. Note that GitHub
+// may report incomplete results for a query that timed out, which are not
+// retried.
+//
+// Partitioning by a date that changes during the search, such as
+// "updated", may miss results that move from a range not yet searched to
+// one already searched.
+//
+// If an error occurs, it is yielded as the final value and iteration stops.
+func AllIssues(ctx context.Context, client *github.Client, q *Query, opts *AllOptions) iter.Seq2[*github.Issue, error] {
+	return all(ctx, client, q, KindIssues, opts, "created", (*github.Issue).GetID,
+		func(ctx context.Context, query string, so *github.SearchOptions) ([]*github.Issue, int, error) {
+			r, _, err := client.Search.Issues(ctx, query, so)
+			if err != nil {
+				return nil, 0, err
+			}
+			return r.Issues, r.GetTotal(), nil
+		})
+}
+
+// AllRepositories returns an iterator over all the repositories matching q,
+// which must be of KindRepositories. See AllIssues for details.
+func AllRepositories(ctx context.Context, client *github.Client, q *Query, opts *AllOptions) iter.Seq2[*github.Repository, error] {
+	return all(ctx, client, q, KindRepositories, opts, "created", (*github.Repository).GetID,
+		func(ctx context.Context, query string, so *github.SearchOptions) ([]*github.Repository, int, error) {
+			r, _, err := client.Search.Repositories(ctx, query, so)
+			if err != nil {
+				return nil, 0, err
+			}
+			return r.Repositories, r.GetTotal(), nil
+		})
+}
+
+// AllCommits returns an iterator over all the commits matching q, which must
+// be of KindCommits. See AllIssues for details.
+func AllCommits(ctx context.Context, client *github.Client, q *Query, opts *AllOptions) iter.Seq2[*github.CommitResult, error] {
+	return all(ctx, client, q, KindCommits, opts, "author-date", (*github.CommitResult).GetSHA,
+		func(ctx context.Context, query string, so *github.SearchOptions) ([]*github.CommitResult, int, error) {
+			r, _, err := client.Search.Commits(ctx, query, so)
+			if err != nil {
+				return nil, 0, err
+			}
+			return r.Commits, r.GetTotal(), nil
+		})
+}
+
+// AllUsers returns an iterator over all the users matching q, which must be
+// of KindUsers. See AllIssues for details.
+func AllUsers(ctx context.Context, client *github.Client, q *Query, opts *AllOptions) iter.Seq2[*github.User, error] {
+	return all(ctx, client, q, KindUsers, opts, "created", (*github.User).GetID,
+		func(ctx context.Context, query string, so *github.SearchOptions) ([]*github.User, int, error) {
+			r, _, err := client.Search.Users(ctx, query, so)
+			if err != nil {
+				return nil, 0, err
+			}
+			return r.Users, r.GetTotal(), nil
+		})
+}
+
+// searchPage returns a page of the results of a query and their total
+// count.
+type searchPage[T any] func(ctx context.Context, query string, opts *github.SearchOptions) ([]T, int, error)
+
+// dateRange is a range of dates, from since inclusively to until
+// exclusively, with a precision of a second.
+type dateRange struct {
+	since, until time.Time
+}
+
+// value returns r as the value of a date qualifier.
+func (r dateRange) value() Range {
+	return Range(r.since.UTC().Format(rangeTimeLayout) + ".." + r.until.Add(-time.Second).UTC().Format(rangeTimeLayout))
+}
+
+// split returns the halves of r, or false if r is a single second.
+func (r dateRange) split() (dateRange, dateRange, bool) {
+	d := r.until.Sub(r.since)
+	if d <= time.Second {
+		return r, r, false
+	}
+	mid := r.since.Add(d / 2).Truncate(time.Second)
+	return dateRange{r.since, mid}, dateRange{mid, r.until}, true
+}
+
+func all[T any, K comparable](ctx context.Context, client *github.Client, q *Query, kind Kind, opts *AllOptions, qualifier string, key func(T) K, search searchPage[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if opts == nil {
+			opts = &AllOptions{}
+		}
+		if opts.Qualifier != "" {
+			qualifier = opts.Qualifier
+		}
+		if err := checkAll(q, kind, qualifier); err != nil {
+			yield(zero, err)
+			return
+		}
+		since, until := opts.Since, opts.Until
+		if since.IsZero() {
+			since = time.Date(2007, time.October, 1, 0, 0, 0, 0, time.UTC)
+		}
+		if until.IsZero() {
+			until = time.Now()
+		}
+		since = since.Truncate(time.Second)
+		until = until.Add(time.Second - 1).Truncate(time.Second)
+
+		ctx = context.WithValue(ctx, github.SleepUntilPrimaryRateLimitResetWhenRateLimited, true)
+		s := &searcher[T]{client: client, search: search}
+		seen := make(map[K]bool)
+		truncated := false
+		// pending is a stack of the ranges left to search, with the oldest
+		// on top.
+		pending := []dateRange{{since, until}}
+		for len(pending) > 0 && since.Before(until) {
+			r := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+			query := q.Clone().Qualifier(qualifier, string(r.value())).String()
+			so := &github.SearchOptions{TextMatch: opts.TextMatch, ListOptions: github.ListOptions{PerPage: perPage, Page: 1}}
+
+			items, total, err := s.do(ctx, query, so)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if total > maxResults {
+				if older, newer, ok := r.split(); ok {
+					pending = append(pending, newer, older)
+					continue
+				}
+				truncated = true
+			}
+
+			for {
+				for _, item := range items {
+					k := key(item)
+					if seen[k] {
+						continue
+					}
+					seen[k] = true
+					if !yield(item, nil) {
+						return
+					}
+				}
+				if len(items) < perPage || so.Page*perPage >= min(total, maxResults) {
+					break
+				}
+				so.Page++
+				if items, total, err = s.do(ctx, query, so); err != nil {
+					yield(zero, err)
+					return
+				}
+			}
+		}
+		if truncated {
+			yield(zero, ErrTooManyResults)
+		}
+	}
+}
+
+// checkAll returns an error if q cannot be partitioned by qualifier.
+func checkAll(q *Query, kind Kind, qualifier string) error {
+	if q.Kind() != kind {
+		return fmt.Errorf("search: query of kind %v, want %v", q.Kind(), kind)
+	}
+	if err := q.Err(); err != nil {
+		return err
+	}
+	if !dateQualifiers[qualifier] || !qualifiers[qualifier].has(kind) {
+		return fmt.Errorf("search: cannot partition %v searches by %q", kind, qualifier)
+	}
+	if q.Has(qualifier) {
+		return fmt.Errorf("search: query already uses qualifier %q, use AllOptions.Since and Until instead", qualifier)
+	}
+	return nil
+}
+
+// searcher sends the requests of a search, respecting the rate limits of the
+// search category.
+type searcher[T any] struct {
+	client *github.Client
+	search searchPage[T]
+}
+
+// do returns a page of the results of query.
+func (s *searcher[T]) do(ctx context.Context, query string, opts *github.SearchOptions) ([]T, int, error) {
+	for attempt := 0; ; attempt++ {
+		wait := time.Duration(0)
+		if s.client.Throttle == nil {
+			wait = s.client.RateBudget(github.SearchCategory).Interval
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, 0, err
+		}
+
+		items, total, err := s.search(ctx, query, opts)
+		var abuse *github.AbuseRateLimitError
+		if !errors.As(err, &abuse) || attempt >= maxAbuseRetries {
+			return items, total, err
+		}
+		wait = defaultAbuseRetryAfter
+		if abuse.RetryAfter != nil {
+			wait = *abuse.RetryAfter
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, 0, err
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package search
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v75/github"
+)
+
+var testSince = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeIssueSearch serves issue searches over issues, which are created at
+// the given times, capping results at 1000 like GitHub.
+type fakeIssueSearch struct {
+	t        *testing.T
+	created  []time.Time
+	requests atomic.Int32
+	// abuse is the number of requests to fail with a secondary rate limit.
+	abuse atomic.Int32
+}
+
+func (f *fakeIssueSearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests.Add(1)
+	if f.abuse.Add(-1) >= 0 {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message":"You have exceeded a secondary rate limit","documentation_url":"https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`))
+		return
+	}
+	q, err := Parse(KindIssues, r.URL.Query().Get("q"))
+	if err != nil || q.Err() != nil || len(q.Values("created")) != 1 {
+		f.t.Errorf("Invalid query %q", r.URL.Query().Get("q"))
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		return
+	}
+	lo, hi, _ := strings.Cut(q.Values("created")[0], "..")
+	since, err1 := time.Parse(rangeTimeLayout, lo)
+	until, err2 := time.Parse(rangeTimeLayout, hi)
+	if err1 != nil || err2 != nil {
+		f.t.Errorf("Invalid range %q", q.Values("created")[0])
+	}
+
+	var ids []int64
+	for i, c := range f.created {
+		if !c.Before(since) && !c.After(until) {
+			ids = append(ids, int64(i+1))
+		}
+	}
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if page*perPage > maxResults {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		return
+	}
+	result := &github.IssuesSearchResult{Total: github.Ptr(len(ids))}
+	for _, id := range ids[min((page-1)*perPage, len(ids)):min(page*perPage, len(ids))] {
+		result.Issues = append(result.Issues, &github.Issue{ID: github.Ptr(id)})
+	}
+	// The first issue is in every page, and must be yielded once.
+	result.Issues = append(result.Issues, &github.Issue{ID: github.Ptr(int64(1))})
+	json.NewEncoder(w).Encode(result)
+}
+
+func newTestClient(t *testing.T, h http.Handler) *github.Client {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(srv.URL + "/")
+	return client
+}
+
+func TestAllIssues(t *testing.T) {
+	t.Parallel()
+	f := &fakeIssueSearch{t: t}
+	// 2500 issues, one per minute, plus 50 in the same second.
+	for i := range 2500 {
+		f.created = append(f.created, testSince.Add(time.Duration(i)*time.Minute))
+	}
+	for range 50 {
+		f.created = append(f.created, testSince.Add(time.Hour+time.Second))
+	}
+	f.abuse.Store(2)
+	client := newTestClient(t, f)
+
+	opts := &AllOptions{Since: testSince, Until: testSince.Add(30 * 24 * time.Hour)}
+	seen := make(map[int64]bool)
+	for issue, err := range AllIssues(t.Context(), client, Issues().Repo("o/r"), opts) {
+		if err != nil {
+			t.Fatalf("AllIssues returned error: %v", err)
+		}
+		if seen[issue.GetID()] {
+			t.Errorf("AllIssues yielded issue %v twice", issue.GetID())
+		}
+		seen[issue.GetID()] = true
+	}
+	if len(seen) != len(f.created) {
+		t.Errorf("AllIssues yielded %v issues, want %v", len(seen), len(f.created))
+	}
+	if n := f.requests.Load(); n > 60 {
+		t.Errorf("AllIssues sent %v requests, want at most 60", n)
+	}
+}
+
+func TestAllIssues_tooManyResults(t *testing.T) {
+	t.Parallel()
+	f := &fakeIssueSearch{t: t}
+	for range 1001 {
+		f.created = append(f.created, testSince)
+	}
+	f.created = append(f.created, testSince.Add(time.Hour))
+	client := newTestClient(t, f)
+
+	opts := &AllOptions{Since: testSince, Until: testSince.Add(2 * time.Hour)}
+	var n int
+	var gotErr error
+	for _, err := range AllIssues(t.Context(), client, Issues(), opts) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		n++
+	}
+	if !errors.Is(gotErr, ErrTooManyResults) {
+		t.Errorf("AllIssues returned error %v, want ErrTooManyResults", gotErr)
+	}
+	if n != maxResults+1 {
+		t.Errorf("AllIssues yielded %v issues, want %v", n, maxResults+1)
+	}
+}
+
+func TestAllIssues_stop(t *testing.T) {
+	t.Parallel()
+	f := &fakeIssueSearch{t: t}
+	for i := range 300 {
+		f.created = append(f.created, testSince.Add(time.Duration(i)*time.Second))
+	}
+	client := newTestClient(t, f)
+
+	opts := &AllOptions{Since: testSince, Until: testSince.Add(time.Hour)}
+	n := 0
+	for range AllIssues(t.Context(), client, Issues(), opts) {
+		n++
+		if n == 5 {
+			break
+		}
+	}
+	if got := f.requests.Load(); got != 1 {
+		t.Errorf("AllIssues sent %v requests after stopping, want 1", got)
+	}
+}
+
+func TestAll_invalid(t *testing.T) {
+	t.Parallel()
+	client := github.NewClient(nil)
+	tests := []struct {
+		q    *Query
+		opts *AllOptions
+		want string
+	}{
+		{Repositories(), nil, "query of kind repositories, want issues"},
+		{Issues().Stars(AtLeast(1)), nil, `qualifier "stars" is not valid`},
+		{Issues().CreatedAfter(testSince), nil, `already uses qualifier "created"`},
+		{Issues(), &AllOptions{Qualifier: "label"}, `cannot partition issues searches by "label"`},
+		{Issues(), &AllOptions{Qualifier: "pushed"}, `cannot partition issues searches by "pushed"`},
+	}
+	for _, tt := range tests {
+		for _, err := range AllIssues(t.Context(), client, tt.q, tt.opts) {
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("AllIssues(%v) returned error %v, want %v", tt.q, err, tt.want)
+			}
+		}
+	}
+}
+
+func TestAllRepositories(t *testing.T) {
+	t.Parallel()
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/repositories" {
+			t.Errorf("Request path = %v, want /search/repositories", r.URL.Path)
+		}
+		if q := r.URL.Query().Get("q"); !strings.HasPrefix(q, "topic:go pushed:") {
+			t.Errorf("Query = %q, want it to be partitioned by pushed", q)
+		}
+		w.Write([]byte(`{"total_count":2,"items":[{"id":1},{"id":2}]}`))
+	}))
+
+	opts := &AllOptions{Qualifier: "pushed", Since: testSince, Until: testSince.Add(time.Hour)}
+	var ids []int64
+	for repo, err := range AllRepositories(t.Context(), client, Repositories().Topic("go"), opts) {
+		if err != nil {
+			t.Fatalf("AllRepositories returned error: %v", err)
+		}
+		ids = append(ids, repo.GetID())
+	}
+	if len(ids) != 2 {
+		t.Errorf("AllRepositories yielded %v, want 2 repositories", ids)
+	}
+}
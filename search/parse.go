@@ -0,0 +1,141 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package search
+
+import (
+	"errors"
+	"strings"
+)
+
+// errNotOperand is returned by Parse for a "NOT" that does not precede a
+// keyword or a qualifier, such as in "NOT (a OR b)".
+var errNotOperand = errors.New("search: NOT must be followed by a keyword or qualifier")
+
+// Parse parses a query string of the given kind, such as
+// `repo:o/r is:pr -label:"help wanted" crash`.
+//
+// Words of the form name:value are qualifiers, even if the name is not a
+// known qualifier, such as "sort:updated-desc". Other words are keywords, and
+// quoted words are exact phrases. A qualifier is negated by a leading "-",
+// and a keyword by a preceding "NOT". A "NOT" before a negated qualifier
+// cancels its negation. The operators "AND" and "OR" and parentheses are
+// kept as terms of the query.
+//
+// Parse only returns an error for malformed query strings, such as one with
+// an unterminated quote, or a "NOT" that is not followed by a keyword or a
+// qualifier. Terms that are not valid for the kind of the query, including
+// unknown qualifiers, are reported by the Err method of the query. They are
+// kept as written in the string of the query.
+func Parse(kind Kind, s string) (*Query, error) {
+	q := New(kind)
+	for {
+		s = strings.TrimLeft(s, " \t\r\n")
+		if s == "" {
+			break
+		}
+		if s[0] == '(' || s[0] == ')' {
+			if q.not {
+				return nil, errNotOperand
+			}
+			q.terms = append(q.terms, Term{Value: s[:1], Operator: true})
+			s = s[1:]
+			continue
+		}
+
+		raw, rest, err := nextWord(s)
+		if err != nil {
+			return nil, err
+		}
+		s = rest
+
+		switch raw {
+		case "AND", "OR", "NOT":
+			if q.not {
+				return nil, errNotOperand
+			}
+			if raw == "NOT" {
+				q.not = true
+			} else {
+				q.terms = append(q.terms, Term{Value: raw, Operator: true})
+			}
+			continue
+		}
+
+		word := raw
+		negated := strings.HasPrefix(word, "-")
+		if negated {
+			word = word[1:]
+		}
+		// URLs, such as "https://github.com", are keywords.
+		if name, value, ok := strings.Cut(word, ":"); ok && isQualifierName(name) && !strings.HasPrefix(value, "//") {
+			q.not = q.not != negated
+			q.add(Term{Qualifier: name, Value: unquote(value)})
+			continue
+		}
+		q.add(Term{Value: unquote(raw)})
+	}
+	if q.not {
+		return nil, errNotOperand
+	}
+	return q, nil
+}
+
+// isQualifierName reports whether name can be the name of a qualifier.
+func isQualifierName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		switch c := name[i]; {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', c == '-', c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// nextWord returns the word at the start of s, up to the first space or
+// closing parenthesis that is not quoted, and the rest of s.
+func nextWord(s string) (word, rest string, err error) {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quoted && c == '\\' && i+1 < len(s) && s[i+1] == '"':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case !quoted && (c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == ')'):
+			return s[:i], s[i:], nil
+		}
+	}
+	if quoted {
+		return "", "", errors.New("search: unterminated quote in query")
+	}
+	return s, "", nil
+}
+
+// unquote removes the quotes of a word, and unescapes the quotes within
+// them.
+func unquote(word string) string {
+	if !strings.Contains(word, `"`) {
+		return word
+	}
+	var b strings.Builder
+	quoted := false
+	for i := 0; i < len(word); i++ {
+		switch c := word[i]; {
+		case quoted && c == '\\' && i+1 < len(word) && word[i+1] == '"':
+			b.WriteByte('"')
+			i++
+		case c == '"':
+			quoted = !quoted
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package search
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		kind  Kind
+		s     string
+		terms []Term
+		want  string // The string of the parsed query, if it differs from s.
+	}{
+		{
+			kind: KindIssues,
+			s:    `repo:o/r is:pr -label:"help wanted" crash`,
+			terms: []Term{
+				{Qualifier: "repo", Value: "o/r"},
+				{Qualifier: "is", Value: "pr"},
+				{Qualifier: "label", Value: "help wanted", Negated: true},
+				{Value: "crash"},
+			},
+		},
+		{
+			kind: KindIssues,
+			s:    `"out of memory" NOT flaky  label:bug`,
+			terms: []Term{
+				{Value: "out of memory"},
+				{Value: "flaky", Negated: true},
+				{Qualifier: "label", Value: "bug"},
+			},
+			want: `"out of memory" NOT flaky label:bug`,
+		},
+		{
+			kind: KindIssues,
+			s:    `is:open (label:bug OR label:p1) AND author:"a\"b"`,
+			terms: []Term{
+				{Qualifier: "is", Value: "open"},
+				{Value: "(", Operator: true},
+				{Qualifier: "label", Value: "bug"},
+				{Value: "OR", Operator: true},
+				{Qualifier: "label", Value: "p1"},
+				{Value: ")", Operator: true},
+				{Value: "AND", Operator: true},
+				{Qualifier: "author", Value: `a"b`},
+			},
+		},
+		{
+			kind: KindRepositories,
+			s:    "language:c++ stars:>=100 leveldb http://x -foo",
+			terms: []Term{
+				{Qualifier: "language", Value: "c++"},
+				{Qualifier: "stars", Value: ">=100"},
+				{Value: "leveldb"},
+				{Value: "http://x"},
+				{Value: "-foo"},
+			},
+			want: `language:c++ stars:>=100 leveldb "http://x" -foo`,
+		},
+		{
+			kind: KindIssues,
+			s:    "is:pr user-review-requested:@me sort:updated-desc",
+			terms: []Term{
+				{Qualifier: "is", Value: "pr"},
+				{Qualifier: "user-review-requested", Value: "@me"},
+				{Qualifier: "sort", Value: "updated-desc"},
+			},
+		},
+		{
+			kind: KindCommits,
+			s:    "NOT -author:bot NOT author:me",
+			terms: []Term{
+				{Qualifier: "author", Value: "bot"},
+				{Qualifier: "author", Value: "me", Negated: true},
+			},
+			want: "author:bot -author:me",
+		},
+		{
+			kind: KindIssues,
+			s:    "NOT -label:bug",
+			terms: []Term{
+				{Qualifier: "label", Value: "bug"},
+			},
+			want: "label:bug",
+		},
+		{
+			kind: KindUsers,
+			s:    "",
+		},
+	}
+	for _, tt := range tests {
+		q, err := Parse(tt.kind, tt.s)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", tt.s, err)
+			continue
+		}
+		if err := q.Err(); err != nil {
+			t.Errorf("Parse(%q).Err() = %v", tt.s, err)
+		}
+		if q.Kind() != tt.kind {
+			t.Errorf("Parse(%q).Kind() = %v, want %v", tt.s, q.Kind(), tt.kind)
+		}
+		if !cmp.Equal(q.Terms(), tt.terms) {
+			t.Errorf("Parse(%q) terms diff: %v", tt.s, cmp.Diff(tt.terms, q.Terms()))
+		}
+		want := tt.want
+		if want == "" {
+			want = tt.s
+		}
+		if got := q.String(); got != want {
+			t.Errorf("Parse(%q).String() = %q, want %q", tt.s, got, want)
+		}
+		// The string of the query is parsed back to the same terms.
+		q, err = Parse(tt.kind, q.String())
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", want, err)
+			continue
+		}
+		if !cmp.Equal(q.Terms(), tt.terms) {
+			t.Errorf("Parse(%q) terms diff: %v", want, cmp.Diff(tt.terms, q.Terms()))
+		}
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	t.Parallel()
+	if _, err := Parse(KindIssues, `label:"help wanted`); err == nil {
+		t.Error("Parse returned nil error for an unterminated quote")
+	}
+	for _, s := range []string{"NOT (a OR b)", "a NOT", "NOT AND x", "NOT NOT x", "(a NOT)"} {
+		if _, err := Parse(KindIssues, s); err == nil {
+			t.Errorf("Parse(%q) returned nil error", s)
+		}
+	}
+
+	q, err := Parse(KindCode, "foo stars:>1")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if q.Err() == nil {
+		t.Error("Err returned nil for a qualifier not valid in code searches")
+	}
+	if got, want := q.String(), "foo stars:>1"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+
+	// Unknown qualifiers are reported, and kept as written.
+	q, err = Parse(KindIssues, "is:issue -linked-to:o/r#1 crash")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if q.Err() == nil {
+		t.Error("Err returned nil for an unknown qualifier")
+	}
+	if got := q.Terms()[1]; got != (Term{Qualifier: "linked-to", Value: "o/r#1", Negated: true}) {
+		t.Errorf("Terms()[1] = %+v, want negated qualifier linked-to", got)
+	}
+	if got, want := q.String(), "is:issue -linked-to:o/r#1 crash"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,540 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package search builds and parses queries for the methods of
+// github.SearchService.
+//
+// A Query is made of keywords and qualifiers, such as "is:pr" or
+// "label:bug". It escapes the values it is given and checks that its
+// qualifiers are valid for its kind of search:
+//
+//	q := search.Issues().
+//		Repo("octocat/hello-world").
+//		Is("pr").
+//		Label("help wanted").
+//		CreatedAfter(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)).
+//		Not().Author("dependabot[bot]")
+//	if err := q.Err(); err != nil {
+//		// Handle error.
+//	}
+//	result, _, err := client.Search.Issues(ctx, q.String(), nil)
+//
+// Existing query strings can be parsed with Parse, then inspected and
+// modified.
+package search
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind is a kind of search, which determines the qualifiers that a query may
+// use.
+type Kind int
+
+const (
+	// KindIssues is the kind of the queries of SearchService.Issues, for
+	// issues and pull requests.
+	KindIssues Kind = iota
+	// KindRepositories is the kind of the queries of
+	// SearchService.Repositories.
+	KindRepositories
+	// KindCode is the kind of the queries of SearchService.Code.
+	KindCode
+	// KindCommits is the kind of the queries of SearchService.Commits.
+	KindCommits
+	// KindUsers is the kind of the queries of SearchService.Users.
+	KindUsers
+)
+
+var kindNames = [...]string{
+	KindIssues:       "issues",
+	KindRepositories: "repositories",
+	KindCode:         "code",
+	KindCommits:      "commits",
+	KindUsers:        "users",
+}
+
+// String returns the name of the kind, as used in the path of its search
+// endpoint, such as "issues".
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return "Kind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return kindNames[k]
+}
+
+// kinds is a set of kinds of search.
+type kinds uint8
+
+func kindsOf(ks ...Kind) kinds {
+	var s kinds
+	for _, k := range ks {
+		s |= 1 << k
+	}
+	return s
+}
+
+func (s kinds) has(k Kind) bool {
+	return k >= 0 && s&(1<<k) != 0
+}
+
+// qualifiers are the kinds of search in which each qualifier is valid.
+var qualifiers = map[string]kinds{
+	"archived":              kindsOf(KindIssues, KindRepositories),
+	"assignee":              kindsOf(KindIssues),
+	"author":                kindsOf(KindIssues, KindCommits),
+	"author-date":           kindsOf(KindCommits),
+	"author-email":          kindsOf(KindCommits),
+	"author-name":           kindsOf(KindCommits),
+	"base":                  kindsOf(KindIssues),
+	"closed":                kindsOf(KindIssues),
+	"commenter":             kindsOf(KindIssues),
+	"comments":              kindsOf(KindIssues),
+	"committer":             kindsOf(KindCommits),
+	"committer-date":        kindsOf(KindCommits),
+	"committer-email":       kindsOf(KindCommits),
+	"committer-name":        kindsOf(KindCommits),
+	"created":               kindsOf(KindIssues, KindRepositories, KindUsers),
+	"draft":                 kindsOf(KindIssues),
+	"extension":             kindsOf(KindCode),
+	"filename":              kindsOf(KindCode),
+	"followers":             kindsOf(KindRepositories, KindUsers),
+	"fork":                  kindsOf(KindRepositories, KindCode),
+	"forks":                 kindsOf(KindRepositories),
+	"fullname":              kindsOf(KindUsers),
+	"good-first-issues":     kindsOf(KindRepositories),
+	"hash":                  kindsOf(KindCommits),
+	"head":                  kindsOf(KindIssues),
+	"help-wanted-issues":    kindsOf(KindRepositories),
+	"in":                    kindsOf(KindIssues, KindRepositories, KindCode, KindUsers),
+	"interactions":          kindsOf(KindIssues),
+	"involves":              kindsOf(KindIssues),
+	"is":                    kindsOf(KindIssues, KindRepositories, KindCommits, KindUsers),
+	"label":                 kindsOf(KindIssues),
+	"language":              kindsOf(KindIssues, KindRepositories, KindCode, KindUsers),
+	"license":               kindsOf(KindRepositories),
+	"linked":                kindsOf(KindIssues),
+	"location":              kindsOf(KindUsers),
+	"mentions":              kindsOf(KindIssues),
+	"merge":                 kindsOf(KindCommits),
+	"merged":                kindsOf(KindIssues),
+	"milestone":             kindsOf(KindIssues),
+	"mirror":                kindsOf(KindRepositories),
+	"no":                    kindsOf(KindIssues),
+	"org":                   kindsOf(KindIssues, KindRepositories, KindCode, KindCommits),
+	"parent":                kindsOf(KindCommits),
+	"path":                  kindsOf(KindCode),
+	"project":               kindsOf(KindIssues),
+	"pushed":                kindsOf(KindRepositories),
+	"reactions":             kindsOf(KindIssues),
+	"reason":                kindsOf(KindIssues),
+	"repo":                  kindsOf(KindIssues, KindRepositories, KindCode, KindCommits),
+	"repos":                 kindsOf(KindUsers),
+	"review":                kindsOf(KindIssues),
+	"review-requested":      kindsOf(KindIssues),
+	"reviewed-by":           kindsOf(KindIssues),
+	"sha":                   kindsOf(KindIssues),
+	"size":                  kindsOf(KindRepositories, KindCode),
+	"sort":                  kindsOf(KindIssues, KindRepositories, KindCommits),
+	"sponsorable":           kindsOf(KindUsers),
+	"stars":                 kindsOf(KindRepositories),
+	"state":                 kindsOf(KindIssues),
+	"status":                kindsOf(KindIssues),
+	"team":                  kindsOf(KindIssues),
+	"team-review-requested": kindsOf(KindIssues),
+	"template":              kindsOf(KindRepositories),
+	"topic":                 kindsOf(KindRepositories),
+	"topics":                kindsOf(KindRepositories),
+	"tree":                  kindsOf(KindCommits),
+	"type":                  kindsOf(KindIssues, KindUsers),
+	"updated":               kindsOf(KindIssues),
+	"user":                  kindsOf(KindIssues, KindRepositories, KindCode, KindCommits),
+	"user-review-requested": kindsOf(KindIssues),
+}
+
+// Term is a keyword or a qualifier of a query.
+type Term struct {
+	// Qualifier is the name of the qualifier, such as "label", or empty for
+	// a keyword.
+	Qualifier string
+
+	// Value is the unescaped value of the qualifier, such as "help wanted",
+	// or the keyword.
+	Value string
+
+	// Negated reports whether the term excludes the results it matches, as
+	// in "-label:bug" or "NOT bug".
+	Negated bool
+
+	// Operator reports whether the term is a boolean operator or a
+	// parenthesis, such as "OR", which is written as is.
+	Operator bool
+}
+
+// String returns the term as written in a query, such as
+// `-label:"help wanted"`.
+func (t Term) String() string {
+	switch {
+	case t.Operator:
+		return t.Value
+	case t.Qualifier != "":
+		s := t.Qualifier + ":" + quote(t.Value, false)
+		if t.Negated {
+			return "-" + s
+		}
+		return s
+	case t.Negated:
+		return "NOT " + quote(t.Value, true)
+	default:
+		return quote(t.Value, true)
+	}
+}
+
+// quote returns v, quoted if it would not otherwise be read back as a single
+// value. Keywords are also quoted if they could be read as a qualifier or an
+// operator.
+func quote(v string, keyword bool) string {
+	needed := v == "" || strings.ContainsAny(v, " \t\r\n\"()")
+	if keyword {
+		needed = needed || strings.Contains(v, ":") || isOperator(v)
+	}
+	if !needed {
+		return v
+	}
+	return `"` + strings.ReplaceAll(v, `"`, `\"`) + `"`
+}
+
+func isOperator(v string) bool {
+	return v == "AND" || v == "OR" || v == "NOT"
+}
+
+// Query is a search query of a given kind. The zero value is not usable; use
+// New, or one of Issues, Repositories, Code, Commits or Users.
+//
+// The methods adding terms to a query modify it and return it, so that they
+// can be chained. An invalid term, such as a qualifier that is not valid for
+// the kind of the query, is added nonetheless, and is reported by Err.
+type Query struct {
+	kind  Kind
+	terms []Term
+	not   bool
+	err   error
+}
+
+// New returns an empty query of the given kind.
+func New(kind Kind) *Query {
+	return &Query{kind: kind}
+}
+
+// Issues returns an empty query for SearchService.Issues.
+func Issues() *Query { return New(KindIssues) }
+
+// Repositories returns an empty query for SearchService.Repositories.
+func Repositories() *Query { return New(KindRepositories) }
+
+// Code returns an empty query for SearchService.Code.
+func Code() *Query { return New(KindCode) }
+
+// Commits returns an empty query for SearchService.Commits.
+func Commits() *Query { return New(KindCommits) }
+
+// Users returns an empty query for SearchService.Users.
+func Users() *Query { return New(KindUsers) }
+
+// Kind returns the kind of q.
+func (q *Query) Kind() Kind {
+	return q.kind
+}
+
+// Err returns the first invalid term added to q, if any.
+func (q *Query) Err() error {
+	return q.err
+}
+
+// String returns q as a query string, with its terms separated by spaces.
+func (q *Query) String() string {
+	var b strings.Builder
+	for i, t := range q.terms {
+		if i > 0 && !(t.Operator && t.Value == ")") && !(q.terms[i-1].Operator && q.terms[i-1].Value == "(") {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t.String())
+	}
+	return b.String()
+}
+
+// Terms returns the terms of q, in order.
+func (q *Query) Terms() []Term {
+	return slices.Clone(q.terms)
+}
+
+// Keywords returns the keywords of q that are not negated.
+func (q *Query) Keywords() []string {
+	return q.Values("")
+}
+
+// Values returns the values of the given qualifier in q that are not
+// negated, such as ["bug", "help wanted"] for "label".
+func (q *Query) Values(qualifier string) []string {
+	var values []string
+	for _, t := range q.terms {
+		if t.Qualifier == qualifier && !t.Negated && !t.Operator {
+			values = append(values, t.Value)
+		}
+	}
+	return values
+}
+
+// Has reports whether q has the given qualifier, negated or not.
+func (q *Query) Has(qualifier string) bool {
+	return slices.ContainsFunc(q.terms, func(t Term) bool { return t.Qualifier == qualifier })
+}
+
+// Clone returns a copy of q.
+func (q *Query) Clone() *Query {
+	c := *q
+	c.terms = slices.Clone(q.terms)
+	return &c
+}
+
+// Not negates the next term added to q.
+func (q *Query) Not() *Query {
+	q.not = true
+	return q
+}
+
+// Keyword adds keywords to q. Keywords containing spaces are searched for
+// as exact phrases.
+func (q *Query) Keyword(keywords ...string) *Query {
+	for _, k := range keywords {
+		q.add(Term{Value: k})
+	}
+	return q
+}
+
+// Qualifier adds the qualifier name with the given value to q.
+func (q *Query) Qualifier(name, value string) *Query {
+	q.add(Term{Qualifier: name, Value: value})
+	return q
+}
+
+// Remove removes the terms with the given qualifier from q, negated or not.
+func (q *Query) Remove(qualifier string) *Query {
+	q.terms = slices.DeleteFunc(q.terms, func(t Term) bool { return t.Qualifier == qualifier })
+	return q
+}
+
+// Set replaces the terms with the given qualifier in q with a single one
+// with the given value.
+func (q *Query) Set(qualifier, value string) *Query {
+	return q.Remove(qualifier).Qualifier(qualifier, value)
+}
+
+func (q *Query) add(t Term) {
+	t.Negated, q.not = q.not, false
+	if err := q.check(t); err != nil && q.err == nil {
+		q.err = err
+	}
+	q.terms = append(q.terms, t)
+}
+
+// check returns an error if t is not a valid term of q.
+func (q *Query) check(t Term) error {
+	if t.Qualifier == "" {
+		if t.Value == "" {
+			return errors.New("search: empty keyword")
+		}
+		return nil
+	}
+	ks, ok := qualifiers[t.Qualifier]
+	if !ok {
+		return fmt.Errorf("search: unknown qualifier %q", t.Qualifier)
+	}
+	if !ks.has(q.kind) {
+		return fmt.Errorf("search: qualifier %q is not valid in %v searches", t.Qualifier, q.kind)
+	}
+	if t.Value == "" {
+		return fmt.Errorf("search: empty value for qualifier %q", t.Qualifier)
+	}
+	return nil
+}
+
+// Range is the value of a qualifier matching numbers or dates, such as
+// ">=100" or "2025-01-01..2025-06-30". Use AtLeast, AtMost, GreaterThan,
+// LessThan, Between or Exactly to create one.
+type Range string
+
+// Bound is the type of the bounds of a Range. Times are written as dates in
+// UTC, or with their time of day if it is not midnight.
+type Bound interface {
+	int | int64 | time.Time
+}
+
+// AtLeast returns the Range of the values greater than or equal to v.
+func AtLeast[T Bound](v T) Range { return Range(">=" + formatBound(v)) }
+
+// AtMost returns the Range of the values less than or equal to v.
+func AtMost[T Bound](v T) Range { return Range("<=" + formatBound(v)) }
+
+// GreaterThan returns the Range of the values greater than v.
+func GreaterThan[T Bound](v T) Range { return Range(">" + formatBound(v)) }
+
+// LessThan returns the Range of the values less than v.
+func LessThan[T Bound](v T) Range { return Range("<" + formatBound(v)) }
+
+// Between returns the Range of the values between lo and hi, inclusive.
+func Between[T Bound](lo, hi T) Range { return Range(formatBound(lo) + ".." + formatBound(hi)) }
+
+// Exactly returns the Range of the single value v.
+func Exactly[T Bound](v T) Range { return Range(formatBound(v)) }
+
+func formatBound[T Bound](v T) string {
+	switch v := any(v).(type) {
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case time.Time:
+		v = v.UTC()
+		if v.Equal(v.Truncate(24 * time.Hour)) {
+			return v.Format(time.DateOnly)
+		}
+		return v.Format("2006-01-02T15:04:05-07:00")
+	}
+	panic("unreachable")
+}
+
+// Repo restricts q to a repository, given as "owner/name".
+func (q *Query) Repo(nameWithOwner string) *Query { return q.Qualifier("repo", nameWithOwner) }
+
+// Org restricts q to the repositories of an organization.
+func (q *Query) Org(org string) *Query { return q.Qualifier("org", org) }
+
+// User restricts q to the repositories of a user.
+func (q *Query) User(user string) *Query { return q.Qualifier("user", user) }
+
+// Is adds the qualifier "is", such as "pr", "open" or "public".
+func (q *Query) Is(v string) *Query { return q.Qualifier("is", v) }
+
+// In restricts the fields that keywords match, such as "title" or "body".
+func (q *Query) In(field string) *Query { return q.Qualifier("in", field) }
+
+// Type adds the qualifier "type", such as "pr" for issues, or "org" for
+// users.
+func (q *Query) Type(v string) *Query { return q.Qualifier("type", v) }
+
+// State restricts q to issues in a state, "open" or "closed".
+func (q *Query) State(state string) *Query { return q.Qualifier("state", state) }
+
+// Language restricts q to a programming language.
+func (q *Query) Language(language string) *Query { return q.Qualifier("language", language) }
+
+// Author restricts q to issues or commits by a user.
+func (q *Query) Author(login string) *Query { return q.Qualifier("author", login) }
+
+// Committer restricts q to commits committed by a user.
+func (q *Query) Committer(login string) *Query { return q.Qualifier("committer", login) }
+
+// Assignee restricts q to issues assigned to a user.
+func (q *Query) Assignee(login string) *Query { return q.Qualifier("assignee", login) }
+
+// Mentions restricts q to issues mentioning a user.
+func (q *Query) Mentions(login string) *Query { return q.Qualifier("mentions", login) }
+
+// Commenter restricts q to issues commented on by a user.
+func (q *Query) Commenter(login string) *Query { return q.Qualifier("commenter", login) }
+
+// Involves restricts q to issues involving a user in any way.
+func (q *Query) Involves(login string) *Query { return q.Qualifier("involves", login) }
+
+// Label restricts q to issues with a label.
+func (q *Query) Label(label string) *Query { return q.Qualifier("label", label) }
+
+// Milestone restricts q to issues in a milestone.
+func (q *Query) Milestone(milestone string) *Query { return q.Qualifier("milestone", milestone) }
+
+// No restricts q to issues missing some metadata, such as "label" or
+// "assignee".
+func (q *Query) No(field string) *Query { return q.Qualifier("no", field) }
+
+// Topic restricts q to repositories with a topic.
+func (q *Query) Topic(topic string) *Query { return q.Qualifier("topic", topic) }
+
+// License restricts q to repositories with a license, given as its keyword,
+// such as "mit".
+func (q *Query) License(license string) *Query { return q.Qualifier("license", license) }
+
+// Path restricts q to code under a path.
+func (q *Query) Path(path string) *Query { return q.Qualifier("path", path) }
+
+// Filename restricts q to code in files with a name.
+func (q *Query) Filename(name string) *Query { return q.Qualifier("filename", name) }
+
+// Extension restricts q to code in files with an extension.
+func (q *Query) Extension(ext string) *Query { return q.Qualifier("extension", ext) }
+
+// Location restricts q to users in a location.
+func (q *Query) Location(location string) *Query { return q.Qualifier("location", location) }
+
+// Hash restricts q to commits with a hash.
+func (q *Query) Hash(sha string) *Query { return q.Qualifier("hash", sha) }
+
+// Created restricts q to results created in a range of dates.
+func (q *Query) Created(r Range) *Query { return q.Qualifier("created", string(r)) }
+
+// CreatedAfter restricts q to results created after t.
+func (q *Query) CreatedAfter(t time.Time) *Query { return q.Created(GreaterThan(t)) }
+
+// CreatedBefore restricts q to results created before t.
+func (q *Query) CreatedBefore(t time.Time) *Query { return q.Created(LessThan(t)) }
+
+// Updated restricts q to issues updated in a range of dates.
+func (q *Query) Updated(r Range) *Query { return q.Qualifier("updated", string(r)) }
+
+// UpdatedAfter restricts q to issues updated after t.
+func (q *Query) UpdatedAfter(t time.Time) *Query { return q.Updated(GreaterThan(t)) }
+
+// UpdatedBefore restricts q to issues updated before t.
+func (q *Query) UpdatedBefore(t time.Time) *Query { return q.Updated(LessThan(t)) }
+
+// Closed restricts q to issues closed in a range of dates.
+func (q *Query) Closed(r Range) *Query { return q.Qualifier("closed", string(r)) }
+
+// Merged restricts q to pull requests merged in a range of dates.
+func (q *Query) Merged(r Range) *Query { return q.Qualifier("merged", string(r)) }
+
+// Pushed restricts q to repositories pushed to in a range of dates.
+func (q *Query) Pushed(r Range) *Query { return q.Qualifier("pushed", string(r)) }
+
+// AuthorDate restricts q to commits authored in a range of dates.
+func (q *Query) AuthorDate(r Range) *Query { return q.Qualifier("author-date", string(r)) }
+
+// CommitterDate restricts q to commits committed in a range of dates.
+func (q *Query) CommitterDate(r Range) *Query { return q.Qualifier("committer-date", string(r)) }
+
+// Comments restricts q to issues with a number of comments.
+func (q *Query) Comments(r Range) *Query { return q.Qualifier("comments", string(r)) }
+
+// Stars restricts q to repositories with a number of stars.
+func (q *Query) Stars(r Range) *Query { return q.Qualifier("stars", string(r)) }
+
+// Forks restricts q to repositories with a number of forks.
+func (q *Query) Forks(r Range) *Query { return q.Qualifier("forks", string(r)) }
+
+// Size restricts q to repositories or files of a size, in kilobytes for
+// repositories and bytes for files.
+func (q *Query) Size(r Range) *Query { return q.Qualifier("size", string(r)) }
+
+// Followers restricts q to users or repositories with a number of
+// followers.
+func (q *Query) Followers(r Range) *Query { return q.Qualifier("followers", string(r)) }
+
+// Repos restricts q to users with a number of repositories.
+func (q *Query) Repos(r Range) *Query { return q.Qualifier("repos", string(r)) }
@@ -0,0 +1,125 @@
+// Copyright 2025 The go-github AUTHORS. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package search
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestQuery_String(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		q    *Query
+		want string
+	}{
+		{
+			q:    Issues().Repo("o/r").Is("pr").Label("bug").CreatedAfter(since).Not().Author("bot"),
+			want: "repo:o/r is:pr label:bug created:>2025-01-02 -author:bot",
+		},
+		{
+			q:    Issues().Keyword("crash", "out of memory").Label("help wanted").Not().Keyword("flaky"),
+			want: `crash "out of memory" label:"help wanted" NOT flaky`,
+		},
+		{
+			q:    Issues().Keyword(`say "hi"`, "a:b", "OR").Milestone("v1 (beta)"),
+			want: `"say \"hi\"" "a:b" "OR" milestone:"v1 (beta)"`,
+		},
+		{
+			q:    Repositories().Language("c++").Stars(AtLeast(100)).Forks(Between(1, 10)).Size(AtMost(int64(1000))),
+			want: "language:c++ stars:>=100 forks:1..10 size:<=1000",
+		},
+		{
+			q:    Commits().AuthorDate(Between(since, since.Add(36*time.Hour))).Hash("abc"),
+			want: "author-date:2025-01-02..2025-01-03T12:00:00+00:00 hash:abc",
+		},
+		{
+			q:    Users().Location("São Paulo").Followers(GreaterThan(10)).Repos(LessThan(5)).Created(Exactly(since)),
+			want: `location:"São Paulo" followers:>10 repos:<5 created:2025-01-02`,
+		},
+		{
+			q:    Code().Keyword("Copy").Path("src/").Extension("go").Filename("main.go"),
+			want: "Copy path:src/ extension:go filename:main.go",
+		},
+	}
+	for _, tt := range tests {
+		if err := tt.q.Err(); err != nil {
+			t.Errorf("%v: Err returned %v", tt.want, err)
+		}
+		if got := tt.q.String(); got != tt.want {
+			t.Errorf("String = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestQuery_Err(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		q    *Query
+		want string
+	}{
+		{Repositories().Label("bug"), `qualifier "label" is not valid in repositories searches`},
+		{Code().Stars(AtLeast(1)), `qualifier "stars" is not valid in code searches`},
+		{Issues().Qualifier("nope", "x"), `unknown qualifier "nope"`},
+		{Issues().Label(""), `empty value for qualifier "label"`},
+		{Issues().Keyword(""), "empty keyword"},
+		{Issues().Label("a").Org("").Stars(AtLeast(1)), `empty value for qualifier "org"`},
+	}
+	for _, tt := range tests {
+		err := tt.q.Err()
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: Err = %v, want %v", tt.q, err, tt.want)
+		}
+	}
+}
+
+func TestQuery_modify(t *testing.T) {
+	t.Parallel()
+	q := Issues().Label("bug").Not().Label("wontfix").Keyword("crash").Label("help wanted")
+
+	if got, want := q.Values("label"), []string{"bug", "help wanted"}; !cmp.Equal(got, want) {
+		t.Errorf("Values = %v, want %v", got, want)
+	}
+	if got, want := q.Keywords(), []string{"crash"}; !cmp.Equal(got, want) {
+		t.Errorf("Keywords = %v, want %v", got, want)
+	}
+	if !q.Has("label") || q.Has("author") {
+		t.Errorf("Has returned wrong results for %v", q)
+	}
+
+	c := q.Clone().Set("label", "p1").Not().State("closed")
+	if got, want := c.String(), "crash label:p1 -state:closed"; got != want {
+		t.Errorf("modified clone = %q, want %q", got, want)
+	}
+	if got, want := q.String(), `label:bug -label:wontfix crash label:"help wanted"`; got != want {
+		t.Errorf("original = %q, want %q", got, want)
+	}
+
+	terms := q.Terms()
+	terms[0].Value = "changed"
+	if q.Values("label")[0] != "bug" {
+		t.Error("Terms returned the terms of the query instead of a copy")
+	}
+}
+
+func TestKind_String(t *testing.T) {
+	t.Parallel()
+	for k, want := range map[Kind]string{
+		KindIssues:    "issues",
+		KindCode:      "code",
+		KindUsers:     "users",
+		Kind(-1):      "Kind(-1)",
+		KindUsers + 1: "Kind(5)",
+	} {
+		if got := k.String(); got != want {
+			t.Errorf("Kind(%d).String() = %q, want %q", int(k), got, want)
+		}
+	}
+}
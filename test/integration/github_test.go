@@ -16,19 +16,63 @@ import (
 	"testing"
 
 	"github.com/google/go-github/v75/github"
+	"github.com/google/go-github/v75/githubtest"
 )
 
+// recorder records or replays the requests of client when the
+// GITHUB_CASSETTE environment variable names a cassette file.
+var recorder *githubtest.Recorder
+
 // client is a github.Client with the default http.Client. It is authorized if auth is true.
 // auth indicates whether tests are being run with an OAuth token
 // that is defined in the GITHUB_AUTH_TOKEN environment variable.
+//
+// If GITHUB_CASSETTE is set, the requests are recorded to that file when an
+// OAuth token is defined, and replayed from it otherwise.
 var client, auth = sync.OnceValues(func() (*github.Client, bool) {
 	token := os.Getenv("GITHUB_AUTH_TOKEN")
+	if cassette := os.Getenv("GITHUB_CASSETTE"); cassette != "" {
+		mode := githubtest.ModeReplay
+		if token != "" {
+			mode = githubtest.ModeRecord
+		}
+		r, err := githubtest.NewRecorder(cassette, mode)
+		if err != nil {
+			panic(fmt.Sprintf("loading cassette: %v", err))
+		}
+		recorder = r
+		if token == "" {
+			return github.NewClient(r.Client()), true
+		}
+		return github.NewClient(r.Client()).WithAuthToken(token), true
+	}
 	if token == "" {
 		return github.NewClient(nil), false
 	}
 	return github.NewClient(nil).WithAuthToken(token), true
 })()
 
+// random generates the random names used by tests. It is seeded with a
+// constant when a cassette is used, so that recorded requests are replayed
+// with the same names.
+var random = sync.OnceValue(func() *rand.Rand {
+	if os.Getenv("GITHUB_CASSETTE") != "" {
+		return rand.New(rand.NewSource(1))
+	}
+	return rand.New(rand.NewSource(rand.Int63()))
+})()
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if recorder != nil {
+		if err := recorder.Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "saving cassette: %v\n", err)
+			code = 1
+		}
+	}
+	os.Exit(code)
+}
+
 func skipIfMissingAuth(t *testing.T) {
 	if !auth {
 		t.Skipf("No OAuth token - skipping portions of %v\n", t.Name())
@@ -53,7 +97,7 @@ func createRandomTestRepository(t *testing.T, owner string, autoinit bool) *gith
 	// create random repo name that does not currently exist
 	var repoName string
 	for {
-		repoName = fmt.Sprintf("test-%v", rand.Int())
+		repoName = fmt.Sprintf("test-%v", random.Int())
 		_, resp, err := client.Repositories.Get(t.Context(), owner, repoName)
 		if err != nil {
 			if resp.StatusCode == http.StatusNotFound {
@@ -9,7 +9,6 @@ package integration
 
 import (
 	"fmt"
-	"math/rand"
 	"testing"
 
 	"github.com/google/go-github/v75/github"
@@ -64,7 +63,7 @@ func TestUsers_Update(t *testing.T) {
 	}
 
 	// update location to test value
-	testLoc := fmt.Sprintf("test-%v", rand.Int())
+	testLoc := fmt.Sprintf("test-%v", random.Int())
 	u.Location = &testLoc
 
 	_, _, err = client.Users.Edit(t.Context(), u)
@@ -102,7 +101,7 @@ func TestUsers_Emails(t *testing.T) {
 	var email string
 EmailLoop:
 	for {
-		email = fmt.Sprintf("test-%v@example.com", rand.Int())
+		email = fmt.Sprintf("test-%v@example.com", random.Int())
 		for _, e := range emails {
 			if e.Email != nil && *e.Email == email {
 				continue EmailLoop
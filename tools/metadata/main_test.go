@@ -74,6 +74,7 @@ func TestUpdateOpenAPI(t *testing.T) {
 			Paths: openapi3.NewPaths(
 				openapi3.WithPath("/a/{a_id}", &openapi3.PathItem{
 					Get: &openapi3.Operation{
+						OperationID: "a/get",
 						ExternalDocs: &openapi3.ExternalDocs{
 							URL: "https://docs.github.com/rest/reference/a",
 						},
@@ -84,6 +85,7 @@ func TestUpdateOpenAPI(t *testing.T) {
 			Paths: openapi3.NewPaths(
 				openapi3.WithPath("/a/b/{a_id}", &openapi3.PathItem{
 					Get: &openapi3.Operation{
+						OperationID: "a/get-b",
 						ExternalDocs: &openapi3.ExternalDocs{
 							URL: "https://docs.github.com/rest/reference/a",
 						},
@@ -94,6 +96,7 @@ func TestUpdateOpenAPI(t *testing.T) {
 			Paths: openapi3.NewPaths(
 				openapi3.WithPath("/a/b/{a_id}", &openapi3.PathItem{
 					Get: &openapi3.Operation{
+						OperationID: "a/get-b",
 						ExternalDocs: &openapi3.ExternalDocs{
 							URL: "https://docs.github.com/rest/reference/a",
 						},
@@ -104,6 +107,7 @@ func TestUpdateOpenAPI(t *testing.T) {
 			Paths: openapi3.NewPaths(
 				openapi3.WithPath("/a/b/{a_id}", &openapi3.PathItem{
 					Get: &openapi3.Operation{
+						OperationID: "a/get-b",
 						ExternalDocs: &openapi3.ExternalDocs{
 							URL: "https://docs.github.com/rest/reference/a",
 						},
@@ -114,6 +118,7 @@ func TestUpdateOpenAPI(t *testing.T) {
 			Paths: openapi3.NewPaths(
 				openapi3.WithPath("/a/b/{a_id}", &openapi3.PathItem{
 					Get: &openapi3.Operation{
+						OperationID: "a/get-b",
 						ExternalDocs: &openapi3.ExternalDocs{
 							URL: "https://docs.github.com/rest/reference/a",
 						},
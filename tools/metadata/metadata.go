@@ -30,12 +30,13 @@ import (
 
 type operation struct {
 	Name             string   `yaml:"name,omitempty" json:"name,omitempty"`
+	OperationID      string   `yaml:"operation_id,omitempty" json:"operation_id,omitempty"`
 	DocumentationURL string   `yaml:"documentation_url,omitempty" json:"documentation_url,omitempty"`
 	OpenAPIFiles     []string `yaml:"openapi_files,omitempty" json:"openapi_files,omitempty"`
 }
 
 func (o *operation) equal(other *operation) bool {
-	if o.Name != other.Name || o.DocumentationURL != other.DocumentationURL {
+	if o.Name != other.Name || o.OperationID != other.OperationID || o.DocumentationURL != other.DocumentationURL {
 		return false
 	}
 	if len(o.OpenAPIFiles) != len(other.OpenAPIFiles) {
@@ -52,6 +53,7 @@ func (o *operation) equal(other *operation) bool {
 func (o *operation) clone() *operation {
 	return &operation{
 		Name:             o.Name,
+		OperationID:      o.OperationID,
 		DocumentationURL: o.DocumentationURL,
 		OpenAPIFiles:     append([]string{}, o.OpenAPIFiles...),
 	}
@@ -146,6 +148,9 @@ func (m *operationsFile) resolve() {
 			continue
 		}
 		override = override.clone()
+		if override.OperationID != "" {
+			m.resolvedOps[override.Name].OperationID = override.OperationID
+		}
 		if override.DocumentationURL != "" {
 			m.resolvedOps[override.Name].DocumentationURL = override.DocumentationURL
 		}
@@ -212,13 +217,14 @@ func loadOperationsFile(filename string) (*operationsFile, error) {
 	return &opsFile, nil
 }
 
-func addOperation(ops []*operation, filename, opName, docURL string) []*operation {
+func addOperation(ops []*operation, filename, opName, opID, docURL string) []*operation {
 	for _, op := range ops {
 		if opName != op.Name {
 			continue
 		}
 		if len(op.OpenAPIFiles) == 0 {
 			op.OpenAPIFiles = append(op.OpenAPIFiles, filename)
+			op.OperationID = opID
 			op.DocumentationURL = docURL
 			return ops
 		}
@@ -237,6 +243,7 @@ func addOperation(ops []*operation, filename, opName, docURL string) []*operatio
 	}
 	return append(ops, &operation{
 		Name:             opName,
+		OperationID:      opID,
 		OpenAPIFiles:     []string{filename},
 		DocumentationURL: docURL,
 	})